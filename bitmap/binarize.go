@@ -0,0 +1,204 @@
+package bitmap
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// Binarize converts img into a binary image with ColorModel.
+// It uses the fixed threshold, so it works well on images that are not photographs.
+func Binarize(img image.Image) *Image {
+	bounds := img.Bounds()
+	ret := New(bounds)
+	draw.Draw(ret, bounds, img, bounds.Min, draw.Src)
+	return ret
+}
+
+// BinarizeOtsu converts img into a binary image.
+// The threshold is determined from the histogram of the whole image by Otsu's method.
+func BinarizeOtsu(img image.Image) *Image {
+	bounds := img.Bounds()
+	lum := luminances(img)
+	threshold := otsuThreshold(lum)
+	ret := New(bounds)
+	width := bounds.Dx()
+	for i, l := range lum {
+		ret.SetBinary(bounds.Min.X+i%width, bounds.Min.Y+i/width, Color(l <= threshold))
+	}
+	return ret
+}
+
+// BinarizeHybrid converts img into a binary image.
+// The threshold is determined locally for each block of 8x8 pixels,
+// so it works well on unevenly lit images.
+// It falls back to BinarizeOtsu if img is too small.
+func BinarizeHybrid(img image.Image) *Image {
+	// based on https://github.com/zxing/zxing/blob/99e9b34f5afc21fdaeead283d5ed0bc1314cbec1/core/src/main/java/com/google/zxing/common/HybridBinarizer.java
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width < minimumDimension || height < minimumDimension {
+		return BinarizeOtsu(img)
+	}
+
+	lum := luminances(img)
+	subWidth := (width + blockSize - 1) / blockSize
+	subHeight := (height + blockSize - 1) / blockSize
+	blackPoints := calculateBlackPoints(lum, subWidth, subHeight, width, height)
+
+	ret := New(bounds)
+	maxYOffset := height - blockSize
+	maxXOffset := width - blockSize
+	for y := 0; y < subHeight; y++ {
+		yOffset := min(y*blockSize, maxYOffset)
+		top := clamp(y, 2, subHeight-3)
+		for x := 0; x < subWidth; x++ {
+			xOffset := min(x*blockSize, maxXOffset)
+			left := clamp(x, 2, subWidth-3)
+			sum := 0
+			for z := -2; z <= 2; z++ {
+				row := blackPoints[top+z]
+				sum += row[left-2] + row[left-1] + row[left] + row[left+1] + row[left+2]
+			}
+			threshold := sum / 25
+
+			for yy := yOffset; yy < yOffset+blockSize; yy++ {
+				for xx := xOffset; xx < xOffset+blockSize; xx++ {
+					if int(lum[yy*width+xx]) <= threshold {
+						ret.SetBinary(bounds.Min.X+xx, bounds.Min.Y+yy, Black)
+					}
+				}
+			}
+		}
+	}
+	return ret
+}
+
+const (
+	blockSize = 8
+
+	// minimumDimension is the minimum size of images that BinarizeHybrid handles.
+	minimumDimension = blockSize * 5
+
+	// minDynamicRange is the minimum difference between the darkest and the lightest pixels
+	// for a block to be considered to have both black and white.
+	minDynamicRange = 24
+)
+
+// calculateBlackPoints calculates the black point for each block of pixels.
+func calculateBlackPoints(lum []uint8, subWidth, subHeight, width, height int) [][]int {
+	maxYOffset := height - blockSize
+	maxXOffset := width - blockSize
+	blackPoints := make([][]int, subHeight)
+	for y := range blackPoints {
+		blackPoints[y] = make([]int, subWidth)
+		yOffset := min(y*blockSize, maxYOffset)
+		for x := 0; x < subWidth; x++ {
+			xOffset := min(x*blockSize, maxXOffset)
+			sum := 0
+			minLum, maxLum := 0xff, 0
+			for yy := yOffset; yy < yOffset+blockSize; yy++ {
+				for xx := xOffset; xx < xOffset+blockSize; xx++ {
+					l := int(lum[yy*width+xx])
+					sum += l
+					if l < minLum {
+						minLum = l
+					}
+					if l > maxLum {
+						maxLum = l
+					}
+				}
+			}
+
+			average := sum / (blockSize * blockSize)
+			if maxLum-minLum <= minDynamicRange {
+				// The block has low contrast, so it is probably all white or all black.
+				// Assume it's white, unless the neighbors suggest it's black.
+				average = minLum / 2
+				if y > 0 && x > 0 {
+					neighbor := (blackPoints[y-1][x] + 2*blackPoints[y][x-1] + blackPoints[y-1][x-1]) / 4
+					if minLum < neighbor {
+						average = neighbor
+					}
+				}
+			}
+			blackPoints[y][x] = average
+		}
+	}
+	return blackPoints
+}
+
+// otsuThreshold returns the threshold that maximizes the between-class variance.
+func otsuThreshold(lum []uint8) uint8 {
+	var histogram [256]int
+	for _, l := range lum {
+		histogram[l]++
+	}
+
+	var sum float64
+	for i, n := range histogram {
+		sum += float64(i * n)
+	}
+
+	var sumBackground float64
+	var weightBackground int
+	var threshold uint8
+	var maxVariance float64
+	for i, n := range histogram {
+		weightBackground += n
+		if weightBackground == 0 {
+			continue
+		}
+		weightForeground := len(lum) - weightBackground
+		if weightForeground == 0 {
+			break
+		}
+		sumBackground += float64(i * n)
+		meanBackground := sumBackground / float64(weightBackground)
+		meanForeground := (sum - sumBackground) / float64(weightForeground)
+		d := meanBackground - meanForeground
+		variance := float64(weightBackground) * float64(weightForeground) * d * d
+		if variance > maxVariance {
+			maxVariance = variance
+			threshold = uint8(i)
+		}
+	}
+	return threshold
+}
+
+// luminances returns the luminance of each pixel of img in row-major order.
+func luminances(img image.Image) []uint8 {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	lum := make([]uint8, 0, width*height)
+	if gray, ok := img.(*image.Gray); ok {
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			offset := gray.PixOffset(bounds.Min.X, y)
+			lum = append(lum, gray.Pix[offset:offset+width]...)
+		}
+		return lum
+	}
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			lum = append(lum, color.GrayModel.Convert(img.At(x, y)).(color.Gray).Y)
+		}
+	}
+	return lum
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func clamp(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
@@ -1 +1,113 @@
 package bitmap
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+// stripes returns the image of vertical stripes with 4 pixels width.
+// dark and light are the luminance of the stripes at (x, y).
+func stripes(w, h int, dark, light func(x, y int) uint8) *image.Gray {
+	img := image.NewGray(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if (x/4)%2 == 0 {
+				img.SetGray(x, y, color.Gray{Y: dark(x, y)})
+			} else {
+				img.SetGray(x, y, color.Gray{Y: light(x, y)})
+			}
+		}
+	}
+	return img
+}
+
+func checkStripes(t *testing.T, img *Image) {
+	t.Helper()
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			want := Color((x/4)%2 == 0)
+			if got := img.BinaryAt(x, y); got != want {
+				t.Errorf("(%d, %d): got %v, want %v", x, y, got, want)
+				return
+			}
+		}
+	}
+}
+
+func TestBinarizeOtsu(t *testing.T) {
+	// light gray on white: the fixed threshold makes all pixels white.
+	img := stripes(64, 64, func(x, y int) uint8 { return 0xa0 }, func(x, y int) uint8 { return 0xf0 })
+	checkStripes(t, BinarizeOtsu(img))
+}
+
+func TestBinarizeHybrid(t *testing.T) {
+	// the left side is dark and the right side is light.
+	// no global threshold can separate the stripes.
+	img := stripes(128, 64, func(x, y int) uint8 {
+		return uint8(x)
+	}, func(x, y int) uint8 {
+		return uint8(x) + 100
+	})
+	checkStripes(t, BinarizeHybrid(img))
+}
+
+func TestBinarizeHybrid_Small(t *testing.T) {
+	// fall back to Otsu's method.
+	img := stripes(16, 16, func(x, y int) uint8 { return 0xa0 }, func(x, y int) uint8 { return 0xf0 })
+	checkStripes(t, BinarizeHybrid(img))
+}
+
+func TestBinarize(t *testing.T) {
+	img := stripes(16, 16, func(x, y int) uint8 { return 0x20 }, func(x, y int) uint8 { return 0xe0 })
+	checkStripes(t, Binarize(img))
+}
+
+func TestTransform_Apply(t *testing.T) {
+	// #..
+	// ##.
+	img := New(image.Rect(10, 20, 13, 22))
+	img.SetBinary(10, 20, Black)
+	img.SetBinary(10, 21, Black)
+	img.SetBinary(11, 21, Black)
+
+	tests := []struct {
+		t    Transform
+		want []string
+	}{
+		{Transform{}, []string{"#..", "##."}},
+		{Transform{Rotate: 1}, []string{"##", "#.", ".."}},
+		{Transform{Rotate: 2}, []string{".##", "..#"}},
+		{Transform{Rotate: 3}, []string{"..", ".#", "##"}},
+		{Transform{Rotate: -1}, []string{"..", ".#", "##"}},
+		{Transform{Mirror: true}, []string{"..#", ".##"}},
+		{Transform{Rotate: 1, Mirror: true}, []string{"..", "#.", "##"}},
+		{Transform{Invert: true}, []string{".##", "..#"}},
+	}
+	for _, tt := range tests {
+		got := tt.t.Apply(img)
+		bounds := got.Bounds()
+		if bounds.Min != (image.Point{}) || bounds.Dx() != len(tt.want[0]) || bounds.Dy() != len(tt.want) {
+			t.Errorf("%+v: unexpected bounds: %v", tt.t, bounds)
+			continue
+		}
+		for y, line := range tt.want {
+			for x, c := range line {
+				if got.BinaryAt(x, y) != Color(c == '#') {
+					t.Errorf("%+v: unexpected color at (%d, %d)", tt.t, x, y)
+				}
+			}
+		}
+	}
+}
+
+func TestTransforms(t *testing.T) {
+	transforms := Transforms()
+	if len(transforms) != 16 {
+		t.Errorf("unexpected number of transforms: %d", len(transforms))
+	}
+	if transforms[0] != (Transform{}) {
+		t.Errorf("the first transform is not the identity: %+v", transforms[0])
+	}
+}
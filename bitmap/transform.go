@@ -0,0 +1,70 @@
+package bitmap
+
+import "image"
+
+// Transform is a combination of the rotation, the mirroring and the inversion of images.
+// The zero value is the identity transform.
+type Transform struct {
+	// Rotate is the number of 90 degree clockwise rotations.
+	Rotate int
+
+	// Mirror flips the image horizontally before the rotation.
+	Mirror bool
+
+	// Invert inverts the colors.
+	Invert bool
+}
+
+// Apply returns the transformed image of img.
+// The top-left corner of the result is (0, 0).
+func (t Transform) Apply(img *Image) *Image {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	rotate := ((t.Rotate % 4) + 4) % 4
+	dw, dh := w, h
+	if rotate%2 == 1 {
+		dw, dh = h, w
+	}
+
+	ret := New(image.Rect(0, 0, dw, dh))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := img.BinaryAt(bounds.Min.X+x, bounds.Min.Y+y)
+			if t.Invert {
+				c = !c
+			}
+			sx := x
+			if t.Mirror {
+				sx = w - 1 - x
+			}
+			var dx, dy int
+			switch rotate {
+			case 0:
+				dx, dy = sx, y
+			case 1:
+				dx, dy = h-1-y, sx
+			case 2:
+				dx, dy = w-1-sx, h-1-y
+			case 3:
+				dx, dy = y, w-1-sx
+			}
+			ret.SetBinary(dx, dy, c)
+		}
+	}
+	return ret
+}
+
+// Transforms returns all transforms of images.
+// The first one is the identity transform,
+// and inverting transforms follow non-inverting ones.
+func Transforms() []Transform {
+	ret := make([]Transform, 0, 16)
+	for _, invert := range []bool{false, true} {
+		for _, mirror := range []bool{false, true} {
+			for rotate := 0; rotate < 4; rotate++ {
+				ret = append(ret, Transform{Rotate: rotate, Mirror: mirror, Invert: invert})
+			}
+		}
+	}
+	return ret
+}
@@ -3,13 +3,16 @@ package main
 import (
 	"bytes"
 	"flag"
+	"image"
 	"image/png"
 	"io"
 	"log"
 	"os"
 
 	"github.com/shogo82148/qrcode"
+	"github.com/shogo82148/qrcode/bitmap"
 	"github.com/shogo82148/qrcode/microqr"
+	"github.com/shogo82148/qrcode/render"
 	"github.com/shogo82148/qrcode/rmqr"
 )
 
@@ -17,23 +20,76 @@ func main() {
 	var micro, rmqr bool
 	var level string
 	var kanji bool
+	var format string
+	var invert, ascii bool
 	flag.BoolVar(&micro, "micro", false, "generates Micro QR Code")
 	flag.BoolVar(&rmqr, "rmqr", false, "generates rMQR Code")
 	flag.StringVar(&level, "level", "", "error correction level")
 	flag.BoolVar(&kanji, "kanji", true, "use kanji mode")
+	flag.StringVar(&format, "format", "png", "output format: png, svg, eps, pdf or text")
+	flag.BoolVar(&invert, "invert", false, "invert the colors of the text output for dark terminals")
+	flag.BoolVar(&ascii, "ascii", false, "use only ASCII characters in the text output")
 	flag.Parse()
 	filename := flag.Arg(0)
 
+	data, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var binimg *bitmap.Image
+	var quietZone int
 	if !micro && !rmqr {
-		encodeQR(level, kanji, filename)
+		binimg = encodeQR(level, kanji, data)
+		quietZone = 4
 	} else if micro {
-		encodeMicroQR(level, kanji, filename)
+		binimg = encodeMicroQR(level, kanji, data)
+		quietZone = 2
 	} else if rmqr {
-		encodeRMQR(level, kanji, filename)
+		binimg = encodeRMQR(level, kanji, data)
+		quietZone = 2
+	}
+
+	var buf bytes.Buffer
+	opts := &render.Options{
+		QuietZone: quietZone,
+		Invert:    invert,
+		ASCII:     ascii,
+	}
+	switch format {
+	case "png":
+		var img image.Image
+		img, err = render.Raster(binimg, opts)
+		if err == nil {
+			err = png.Encode(&buf, img)
+		}
+	case "svg":
+		err = render.SVG(&buf, binimg, opts)
+	case "eps":
+		err = render.EPS(&buf, binimg, opts)
+	case "pdf":
+		err = render.PDF(&buf, binimg, opts)
+	case "text":
+		err = render.Text(&buf, binimg, opts)
+	default:
+		log.Fatalf("unknown format: %s", format)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if filename == "" || filename == "-" {
+		if _, err := buf.WriteTo(os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if err := os.WriteFile(filename, buf.Bytes(), 0o644); err != nil {
+		log.Fatal(err)
 	}
 }
 
-func encodeQR(level string, kanji bool, filename string) {
+func encodeQR(level string, kanji bool, data []byte) *bitmap.Image {
 	var lv qrcode.Level
 	switch level {
 	case "l", "L":
@@ -46,24 +102,18 @@ func encodeQR(level string, kanji bool, filename string) {
 		lv = qrcode.LevelH
 	}
 
-	data, err := io.ReadAll(os.Stdin)
+	qr, err := qrcode.New(data, qrcode.WithLevel(lv), qrcode.WithKanji(kanji))
 	if err != nil {
 		log.Fatal(err)
 	}
-	img, err := qrcode.Encode(data, qrcode.WithLevel(lv), qrcode.WithKanji(kanji))
+	binimg, err := qr.EncodeToBitmap()
 	if err != nil {
 		log.Fatal(err)
 	}
-	var buf bytes.Buffer
-	if err := png.Encode(&buf, img); err != nil {
-		log.Fatal(err)
-	}
-	if err := os.WriteFile(filename, buf.Bytes(), 0o644); err != nil {
-		log.Fatal(err)
-	}
+	return binimg
 }
 
-func encodeMicroQR(level string, kanji bool, filename string) {
+func encodeMicroQR(level string, kanji bool, data []byte) *bitmap.Image {
 	var lv microqr.Level
 	switch level {
 	case "":
@@ -76,24 +126,18 @@ func encodeMicroQR(level string, kanji bool, filename string) {
 		lv = microqr.LevelQ
 	}
 
-	data, err := io.ReadAll(os.Stdin)
+	qr, err := microqr.New(data, microqr.WithLevel(lv), microqr.WithKanji(kanji))
 	if err != nil {
 		log.Fatal(err)
 	}
-	img, err := microqr.Encode(data, microqr.WithLevel(lv), microqr.WithKanji(kanji))
+	binimg, err := qr.EncodeToBitmap()
 	if err != nil {
 		log.Fatal(err)
 	}
-	var buf bytes.Buffer
-	if err := png.Encode(&buf, img); err != nil {
-		log.Fatal(err)
-	}
-	if err := os.WriteFile(filename, buf.Bytes(), 0o644); err != nil {
-		log.Fatal(err)
-	}
+	return binimg
 }
 
-func encodeRMQR(level string, kanji bool, filename string) {
+func encodeRMQR(level string, kanji bool, data []byte) *bitmap.Image {
 	var lv rmqr.Level
 	switch level {
 	case "m", "M":
@@ -102,19 +146,13 @@ func encodeRMQR(level string, kanji bool, filename string) {
 		lv = rmqr.LevelH
 	}
 
-	data, err := io.ReadAll(os.Stdin)
+	qr, err := rmqr.New(data, rmqr.WithLevel(lv), rmqr.WithKanji(kanji))
 	if err != nil {
 		log.Fatal(err)
 	}
-	img, err := rmqr.Encode(data, rmqr.WithLevel(lv), rmqr.WithKanji(kanji))
+	binimg, err := qr.EncodeToBitmap()
 	if err != nil {
 		log.Fatal(err)
 	}
-	var buf bytes.Buffer
-	if err := png.Encode(&buf, img); err != nil {
-		log.Fatal(err)
-	}
-	if err := os.WriteFile(filename, buf.Bytes(), 0o644); err != nil {
-		log.Fatal(err)
-	}
+	return binimg
 }
@@ -3,23 +3,251 @@ package qrcode
 import (
 	"errors"
 	"fmt"
+	"image"
 	"io"
 	"math/bits"
 
 	"github.com/shogo82148/qrcode/bitmap"
 	internalbitmap "github.com/shogo82148/qrcode/internal/bitmap"
 	"github.com/shogo82148/qrcode/internal/bitstream"
+	"github.com/shogo82148/qrcode/internal/detector"
 	"github.com/shogo82148/qrcode/internal/reedsolomon"
 )
 
+// DecodeInfo is the detail of decoding a QR code.
+type DecodeInfo struct {
+	// Transform is the transform that was applied to the image to decode it.
+	Transform bitmap.Transform
+
+	// FormatCopy is the copy of the format information that was used.
+	// 1 is the copy around the top-left finder pattern,
+	// and 2 is the copy split between the top-right and the bottom-left finder patterns.
+	FormatCopy int
+
+	// FormatDistance is the Hamming distance between the format information
+	// and the nearest valid codeword, i.e. the number of bit errors.
+	FormatDistance int
+
+	// Corrected is the number of corrected codewords in each block.
+	Corrected []int
+}
+
+// Decode decodes a QR code from the image.
+// Unlike DecodeBitmap, img can be an arbitrary image such as a photograph.
+func Decode(img image.Image) (*QRCode, error) {
+	var firstErr error
+	for _, binarize := range [...]func(image.Image) *bitmap.Image{bitmap.BinarizeHybrid, bitmap.Binarize} {
+		binimg := binarize(img)
+
+		// try light-on-dark symbols too.
+		for _, t := range [...]bitmap.Transform{{}, {Invert: true}} {
+			qr, err := decode(t.Apply(binimg))
+			if err == nil {
+				return qr, nil
+			}
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return nil, firstErr
+}
+
+func decode(binimg *bitmap.Image) (*QRCode, error) {
+	result, err := detector.DetectQR(binimg)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+	qr, _, err := decodeResult(binimg, result)
+	return qr, err
+}
+
+// decodeResult decodes the detected symbol.
+// If it fails, it samples the symbol again with the dimension that the version information says,
+// because the dimension estimated from the grid may be wrong.
+func decodeResult(binimg *bitmap.Image, result *detector.Result) (*QRCode, *detector.Result, error) {
+	qr, err := DecodeBitmap(result.Bitmap)
+	if err == nil {
+		return qr, result, nil
+	}
+
+	// the version information is available since version 7 (45x45 modules),
+	// and the estimated dimension may be 4 modules smaller than the actual one.
+	dimension := result.Bitmap.Bounds().Dx()
+	if dimension < 41 {
+		return nil, nil, err
+	}
+	samplers, ok := detector.FinderSamplers(binimg, result)
+	if !ok {
+		return nil, nil, err
+	}
+	var rawVersion1, rawVersion2 uint
+	for i := 0; i < 18; i++ {
+		// bottom-left
+		if samplers[2].Module(i/3, i%3-4) {
+			rawVersion1 |= 1 << i
+		}
+		// top-right
+		if samplers[1].Module(i%3-4, i/3) {
+			rawVersion2 |= 1 << i
+		}
+	}
+	version, _, ok := decodeVersionPair(rawVersion1, rawVersion2)
+	if !ok || 17+4*int(version) == dimension {
+		return nil, nil, err
+	}
+	resampled, rerr := detector.ResampleQR(binimg, result, 17+4*int(version))
+	if rerr != nil {
+		return nil, nil, err
+	}
+	qr, rerr = DecodeBitmap(resampled.Bitmap)
+	if rerr != nil {
+		return nil, nil, err
+	}
+	return qr, resampled, nil
+}
+
+// Symbol is a QR code found in an image.
+type Symbol struct {
+	QRCode *QRCode
+
+	// Corners are the corners of the symbol in the image.
+	// The order is top-left, top-right, bottom-right and bottom-left.
+	Corners [4]image.Point
+}
+
+// DecodeAll decodes all QR codes in the image.
+func DecodeAll(img image.Image) []*Symbol {
+	binimg := bitmap.BinarizeHybrid(img)
+	var found []*detector.Result
+	var symbols []*Symbol
+LOOP:
+	for _, result := range detector.DetectAllQR(binimg) {
+		for _, f := range found {
+			if f.Overlaps(result) {
+				continue LOOP
+			}
+		}
+		qr, result, err := decodeResult(binimg, result)
+		if err != nil {
+			continue
+		}
+		found = append(found, result)
+		symbols = append(symbols, &Symbol{
+			QRCode:  qr,
+			Corners: result.Polygon(),
+		})
+	}
+	return symbols
+}
+
+// Joiner joins the symbols in structured append mode.
+// The zero value is ready to use.
+type Joiner struct {
+	symbols []*QRCode
+	parity  byte
+}
+
+// Add adds a symbol in structured append mode.
+// The symbols can be added in any order.
+func (j *Joiner) Add(qr *QRCode) error {
+	if len(qr.Segments) == 0 || qr.Segments[0].Mode != ModeConnected {
+		return errors.New("qrcode: not in structured append mode")
+	}
+	sa := qr.Segments[0].StructuredAppend
+	if sa.Total < 1 || sa.Total > 16 || sa.Index < 0 || sa.Index >= sa.Total {
+		return fmt.Errorf("qrcode: invalid structured append: %d of %d", sa.Index, sa.Total)
+	}
+	if j.symbols == nil {
+		j.symbols = make([]*QRCode, sa.Total)
+		j.parity = sa.Parity
+	} else if len(j.symbols) != sa.Total || j.parity != sa.Parity {
+		return errors.New("qrcode: the symbol belongs to another structured append")
+	}
+	j.symbols[sa.Index] = qr
+	return nil
+}
+
+// Complete reports whether all symbols have been added.
+func (j *Joiner) Complete() bool {
+	if j.symbols == nil {
+		return false
+	}
+	for _, qr := range j.symbols {
+		if qr == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Join returns a QR code that has the segments of all symbols in order.
+// The structured append headers are removed, and only Segments of the result is set.
+// It returns an error if some symbols are missing or the parity doesn't match.
+func (j *Joiner) Join() (*QRCode, error) {
+	if !j.Complete() {
+		return nil, errors.New("qrcode: missing symbols in structured append")
+	}
+
+	var segments []Segment
+	var parity byte
+	for _, qr := range j.symbols {
+		p, err := segmentsParity(qr.Segments[1:])
+		if err != nil {
+			return nil, err
+		}
+		parity ^= p
+		segments = append(segments, qr.Segments[1:]...)
+	}
+	if parity != j.parity {
+		return nil, fmt.Errorf("qrcode: parity mismatch: got %#02x, want %#02x", parity, j.parity)
+	}
+	return &QRCode{
+		Segments: segments,
+	}, nil
+}
+
+// DecodeBitmap decodes a QR code from the bitmap.
+// img must be exactly one pixel per module without quiet zone.
 func DecodeBitmap(img *bitmap.Image) (*QRCode, error) {
+	qr, _, err := DecodeBitmapWithInfo(img)
+	return qr, err
+}
+
+// DecodeBitmapWithInfo is like DecodeBitmap, but it also returns the detail of decoding.
+// It tries the rotated, mirrored and inverted images of img, and reports the used one in DecodeInfo.Transform.
+func DecodeBitmapWithInfo(img *bitmap.Image) (*QRCode, *DecodeInfo, error) {
+	var firstErr error
+	for _, t := range bitmap.Transforms() {
+		qr, info, err := decodeBitmap(t.Apply(img))
+		if err == nil {
+			info.Transform = t
+			return qr, info, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return nil, nil, firstErr
+}
+
+func decodeBitmap(img *bitmap.Image) (*QRCode, *DecodeInfo, error) {
 	bounds := img.Bounds()
 	version := Version((bounds.Dx() - 17) / 4)
+	if bounds.Dx() != bounds.Dy() || bounds.Dx() != 17+4*int(version) || version < 1 || version > 40 {
+		return nil, nil, fmt.Errorf("qrcode: invalid size: %dx%d", bounds.Dx(), bounds.Dy())
+	}
 	binimg := internalbitmap.Import(img)
 
-	level, mask, err := decodeFormat(binimg)
+	if version >= 7 {
+		if v, _, ok := decodeVersion(binimg); ok && v != version {
+			return nil, nil, fmt.Errorf("qrcode: version mismatch: the version information says %d, but the size says %d", v, version)
+		}
+	}
+
+	level, mask, formatCopy, formatDistance, err := decodeFormat(binimg)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	w := 16 + 4*int(version)
 
@@ -70,16 +298,39 @@ func DecodeBitmap(img *bitmap.Image) (*QRCode, error) {
 
 	// error correction
 	var result []byte
-	for _, blk := range blocks {
+	corrected := make([]int, 0, len(blocks))
+	for i, blk := range blocks {
 		data := append(blk.data, blk.correction...)
-		if err := reedsolomon.Decode(data, 2); err != nil {
-			return nil, err
+		n, err := reedsolomon.Decode(data, len(blk.correction))
+		if err != nil || n > blk.maxError {
+			return nil, nil, &TooManyErrorsError{Block: i}
 		}
+		corrected = append(corrected, n)
 		result = append(result, data[:len(blk.data)]...)
 	}
 
 	// decode segments
-	stream := bitstream.NewBuffer(result)
+	segments, err := decodeSegments(version, bitstream.NewBuffer(result))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	qr := &QRCode{
+		Version:  version,
+		Mask:     mask,
+		Level:    level,
+		Segments: segments,
+	}
+	info := &DecodeInfo{
+		FormatCopy:     formatCopy,
+		FormatDistance: formatDistance,
+		Corrected:      corrected,
+	}
+	return qr, info, nil
+}
+
+// decodeSegments reads the segments from the data codewords until the terminator.
+func decodeSegments(version Version, stream *bitstream.Buffer) ([]Segment, error) {
 	segments := make([]Segment, 0)
 LOOP:
 	for {
@@ -112,20 +363,46 @@ LOOP:
 				return nil, err
 			}
 			segments = append(segments, seg)
+		case ModeHanzi:
+			seg, err := decodeHanzi(version, stream)
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, seg)
+		case ModeECI:
+			seg, err := decodeECI(stream)
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, seg)
+		case ModeConnected:
+			seg, err := decodeConnected(stream)
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, seg)
+		case ModeFNC1_1:
+			segments = append(segments, Segment{Mode: ModeFNC1_1})
+		case ModeFNC1_2:
+			seg, err := decodeFNC1Second(stream)
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, seg)
 		case ModeTerminated:
 			break LOOP
+		default:
+			return nil, &InvalidModeError{Mode: Mode(mode)}
 		}
 	}
-
-	return &QRCode{
-		Version:  version,
-		Mask:     mask,
-		Level:    level,
-		Segments: segments,
-	}, nil
+	return segments, nil
 }
 
-func decodeFormat(img *internalbitmap.Image) (Level, Mask, error) {
+// decodeFormat reads both copies of the format information,
+// and returns the one nearest to a valid codeword.
+// formatCopy is 1 for the copy around the top-left finder pattern,
+// and 2 for the copy split between the top-right and the bottom-left finder patterns.
+func decodeFormat(img *internalbitmap.Image) (level Level, mask Mask, formatCopy, distance int, err error) {
 	w := img.Rect.Dx() - 1
 
 	// decode format
@@ -141,24 +418,28 @@ func decodeFormat(img *internalbitmap.Image) (Level, Mask, error) {
 		if img.BinaryAt(w-i, 8) {
 			rawFormat2 |= 1 << i
 		}
-		if img.BinaryAt(8, w-i) {
+		// (8, w-7) is the dark module, not a part of the format information.
+		if i < 7 && img.BinaryAt(8, w-i) {
 			rawFormat2 |= 1 << (14 - i)
 		}
 	}
-	level, mask, ok := decodeFormat0(rawFormat1)
-	if ok {
-		return level, mask, nil
-	}
 
-	level, mask, ok = decodeFormat0(rawFormat2)
-	if ok {
-		return level, mask, nil
+	level, mask, distance = decodeFormat0(rawFormat1)
+	formatCopy = 1
+	if level2, mask2, distance2 := decodeFormat0(rawFormat2); distance2 < distance {
+		level, mask, distance = level2, mask2, distance2
+		formatCopy = 2
 	}
 
-	return 0, 0, errors.New("qrcode: QRCode not found")
+	// the format information can correct up to 3 bit errors.
+	if distance > 3 {
+		return 0, 0, 0, 0, ErrFormatNotFound
+	}
+	return level, mask, formatCopy, distance, nil
 }
 
-func decodeFormat0(raw uint) (Level, Mask, bool) {
+// decodeFormat0 returns the format nearest to raw, and the Hamming distance between them.
+func decodeFormat0(raw uint) (Level, Mask, int) {
 	idx := 0
 	min := bits.OnesCount(encodedFormat[0] ^ raw)
 	for i, pattern := range encodedFormat {
@@ -168,10 +449,57 @@ func decodeFormat0(raw uint) (Level, Mask, bool) {
 			min = count
 		}
 	}
-	if min >= 3 {
+	return Level(idx >> 3), Mask(idx & 0b111), min
+}
+
+// decodeVersion reads the two version information blocks,
+// and returns the version nearest to them and the Hamming distance.
+// It returns false if both of them have more than 3 bit errors.
+func decodeVersion(img *internalbitmap.Image) (Version, int, bool) {
+	w := img.Rect.Dx() - 1
+
+	var rawVersion1, rawVersion2 uint
+	for i := 0; i < 18; i++ {
+		// bottom-left
+		if img.BinaryAt(i/3, w-10+i%3) {
+			rawVersion1 |= 1 << i
+		}
+		// top-right
+		if img.BinaryAt(w-10+i%3, i/3) {
+			rawVersion2 |= 1 << i
+		}
+	}
+
+	return decodeVersionPair(rawVersion1, rawVersion2)
+}
+
+// decodeVersionPair decodes the two copies of the version information,
+// and returns the version nearest to them and the Hamming distance.
+func decodeVersionPair(rawVersion1, rawVersion2 uint) (Version, int, bool) {
+	version1, distance1 := decodeVersion0(rawVersion1)
+	version2, distance2 := decodeVersion0(rawVersion2)
+	if distance2 < distance1 {
+		version1, distance1 = version2, distance2
+	}
+	if distance1 > 3 {
 		return 0, 0, false
 	}
-	return Level(idx >> 3), Mask(idx & 0b111), true
+	return version1, distance1, true
+}
+
+// decodeVersion0 returns the version whose encoded information is nearest to raw,
+// and the Hamming distance between them.
+func decodeVersion0(raw uint) (Version, int) {
+	version := Version(7)
+	min := bits.OnesCount(encodedVersion[version] ^ raw)
+	for i := Version(8); i <= 40; i++ {
+		count := bits.OnesCount(encodedVersion[i] ^ raw)
+		if count < min {
+			version = i
+			min = count
+		}
+	}
+	return version, min
 }
 
 func decodeFromBits(version Version, level Level, buf []byte) []block {
@@ -319,3 +647,122 @@ func decodeKanji(version Version, buf *bitstream.Buffer) (Segment, error) {
 		Data: []byte(data),
 	}, nil
 }
+
+func decodeHanzi(version Version, buf *bitstream.Buffer) (Segment, error) {
+	subset, err := buf.ReadBits(4)
+	if err != nil {
+		return Segment{}, err
+	}
+	if subset != hanziSubsetGB2312 {
+		return Segment{}, fmt.Errorf("qrcode: unsupported hanzi subset: %d", subset)
+	}
+
+	var n int
+	switch {
+	case version <= 0 || version > 40:
+		return Segment{}, fmt.Errorf("qrcode: invalid version: %d", version)
+	case version < 10:
+		n = 8
+	case version < 27:
+		n = 10
+	default:
+		n = 12
+	}
+	length, err := buf.ReadBits(n)
+	if err != nil {
+		return Segment{}, err
+	}
+	data, err := bitstream.DecodeHanzi(buf, int(length))
+	if err != nil {
+		return Segment{}, err
+	}
+
+	return Segment{
+		Mode: ModeHanzi,
+		Data: data,
+	}, nil
+}
+
+func decodeECI(buf *bitstream.Buffer) (Segment, error) {
+	eci, err := buf.ReadBits(8)
+	if err != nil {
+		return Segment{}, err
+	}
+	switch {
+	case eci&0b1000_0000 == 0:
+		// 0xxxxxxx
+	case eci&0b1100_0000 == 0b1000_0000:
+		// 10xxxxxx xxxxxxxx
+		low, err := buf.ReadBits(8)
+		if err != nil {
+			return Segment{}, err
+		}
+		eci = (eci&0b0011_1111)<<8 | low
+	case eci&0b1110_0000 == 0b1100_0000:
+		// 110xxxxx xxxxxxxx xxxxxxxx
+		low, err := buf.ReadBits(16)
+		if err != nil {
+			return Segment{}, err
+		}
+		eci = (eci&0b0001_1111)<<16 | low
+		if eci > uint64(eciMax) {
+			return Segment{}, fmt.Errorf("qrcode: invalid ECI assignment number: %d", eci)
+		}
+	default:
+		return Segment{}, fmt.Errorf("qrcode: invalid ECI designator: %#x", eci)
+	}
+
+	return Segment{
+		Mode: ModeECI,
+		ECI:  ECI(eci),
+	}, nil
+}
+
+func decodeFNC1Second(buf *bitstream.Buffer) (Segment, error) {
+	indicator, err := buf.ReadBits(8)
+	if err != nil {
+		return Segment{}, err
+	}
+
+	var data []byte
+	switch {
+	case indicator < 100:
+		data = []byte{byte('0' + indicator/10), byte('0' + indicator%10)}
+	case 'a'+100 <= indicator && indicator <= 'z'+100, 'A'+100 <= indicator && indicator <= 'Z'+100:
+		data = []byte{byte(indicator - 100)}
+	default:
+		return Segment{}, fmt.Errorf("qrcode: invalid application indicator: %d", indicator)
+	}
+
+	return Segment{
+		Mode: ModeFNC1_2,
+		Data: data,
+	}, nil
+}
+
+func decodeConnected(buf *bitstream.Buffer) (Segment, error) {
+	index, err := buf.ReadBits(4)
+	if err != nil {
+		return Segment{}, err
+	}
+	total, err := buf.ReadBits(4)
+	if err != nil {
+		return Segment{}, err
+	}
+	parity, err := buf.ReadBits(8)
+	if err != nil {
+		return Segment{}, err
+	}
+	if index > total {
+		return Segment{}, fmt.Errorf("qrcode: invalid structured append: %d of %d", index, total+1)
+	}
+
+	return Segment{
+		Mode: ModeConnected,
+		StructuredAppend: StructuredAppend{
+			Index:  int(index),
+			Total:  int(total) + 1,
+			Parity: byte(parity),
+		},
+	}, nil
+}
@@ -1,12 +1,19 @@
 package qrcode
 
 import (
+	"bytes"
 	"image"
+	"image/color"
 	"image/png"
+	"math"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/shogo82148/qrcode/bitmap"
+	internalbitmap "github.com/shogo82148/qrcode/internal/bitmap"
+	"github.com/shogo82148/qrcode/internal/detector"
 )
 
 func TestDecodeV1(t *testing.T) {
@@ -213,3 +220,613 @@ func TestDecode1(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestDecodeBitmapWithInfo_Damaged(t *testing.T) {
+	qr, err := New([]byte("HELLO WORLD"), WithLevel(LevelH), WithKanji(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	binimg, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// scratch the symbol.
+	for y := 9; y < 13; y++ {
+		for x := 9; x < 13; x++ {
+			binimg.SetBinary(x, y, !binimg.BinaryAt(x, y))
+		}
+	}
+
+	got, info, err := DecodeBitmapWithInfo(binimg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got.Segments[0].Data) != "HELLO WORLD" {
+		t.Errorf("unexpected data: got %q, want %q", got.Segments[0].Data, "HELLO WORLD")
+	}
+	var sum int
+	for _, n := range info.Corrected {
+		sum += n
+	}
+	if sum < 2 {
+		t.Errorf("want more than one corrected codeword, got %d", sum)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"version1.png", "Ver1"},
+		{"version2.png", "Version 2"},
+		{"version3.png", "Version 3 QR Code"},
+		{"version4.png", "Version 4 QR Code, up to 50 char"},
+		{"version10.png", "VERSION 10 QR CODE"},
+		{"point.png", "点"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := os.Open("testdata/" + tt.name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Close()
+			img, err := png.Decode(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			qr, err := Decode(img)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := string(qr.Segments[0].Data); got != tt.want {
+				t.Errorf("unexpected data: got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecode_Perspective(t *testing.T) {
+	data := "https://github.com/shogo82148/qrcode"
+	img, err := Encode([]byte(data), WithModuleSize(6), WithLevel(LevelM))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, angle := range []float64{0, 10, 45, 80, 135, 200, 300} {
+		warped := warp(img, angle*math.Pi/180, 0.0005)
+		qr, err := Decode(warped)
+		if err != nil {
+			t.Errorf("angle %v: %v", angle, err)
+			continue
+		}
+		if got := string(qr.Segments[0].Data); got != data {
+			t.Errorf("angle %v: unexpected data: got %q, want %q", angle, got, data)
+		}
+	}
+}
+
+func TestDecode_Rotation(t *testing.T) {
+	tests := []struct {
+		data    string
+		version Version
+	}{
+		{"HELLO WORLD", 1},
+		{"https://github.com/shogo82148/qrcode", 7},
+	}
+	for _, tt := range tests {
+		qr, err := New([]byte(tt.data), WithLevel(LevelM), WithVersion(tt.version))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, scale := range []float64{4, 5, 6} {
+			img, err := qr.Encode(WithModuleSize(scale))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for angle := 0; angle <= 90; angle += 3 {
+				rotated := warp(img, float64(angle)*math.Pi/180, 0)
+				got, err := Decode(rotated)
+				if err != nil {
+					t.Errorf("version %d, scale %v, angle %d: %v", tt.version, scale, angle, err)
+					continue
+				}
+				if string(got.Segments[0].Data) != tt.data {
+					t.Errorf("version %d, scale %v, angle %d: unexpected data: got %q, want %q", tt.version, scale, angle, got.Segments[0].Data, tt.data)
+				}
+			}
+		}
+	}
+}
+
+func TestDecode_Keystone(t *testing.T) {
+	for _, version := range []Version{13, 22, 30, 40} {
+		qr, err := New([]byte("HELLO WORLD"), WithLevel(LevelM), WithVersion(version))
+		if err != nil {
+			t.Fatal(err)
+		}
+		img, err := qr.Encode(WithModuleSize(4))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the top side is 10% longer than the bottom side, before rotating.
+		size := float64(img.Bounds().Dx()) * 1.5
+		for _, angle := range []float64{0, 90, 180, 270} {
+			got, err := Decode(warp(img, angle*math.Pi/180, 0.1/size))
+			if err != nil {
+				t.Errorf("version %d, angle %v: %v", version, angle, err)
+				continue
+			}
+			if string(got.Segments[0].Data) != "HELLO WORLD" {
+				t.Errorf("version %d, angle %v: unexpected data: got %q", version, angle, got.Segments[0].Data)
+			}
+		}
+	}
+}
+
+func TestDecode_UnevenLighting(t *testing.T) {
+	data := "https://github.com/shogo82148/qrcode"
+	img, err := Encode([]byte(data), WithModuleSize(6), WithLevel(LevelM))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// darken the left side of the image as if it is in a shadow.
+	bounds := img.Bounds()
+	shadowed := image.NewGray(bounds)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			l := color.GrayModel.Convert(img.At(x, y)).(color.Gray).Y
+			light := 0.2 + 0.8*float64(x-bounds.Min.X)/float64(bounds.Dx())
+			shadowed.SetGray(x, y, color.Gray{Y: uint8(float64(l)*light*0.8 + 20)})
+		}
+	}
+
+	qr, err := Decode(shadowed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(qr.Segments[0].Data); got != data {
+		t.Errorf("unexpected data: got %q, want %q", got, data)
+	}
+}
+
+func TestDecode_Inverted(t *testing.T) {
+	data := "https://github.com/shogo82148/qrcode"
+	img, err := Encode([]byte(data), WithModuleSize(6), WithLevel(LevelM))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// light-on-dark symbol
+	bounds := img.Bounds()
+	inverted := image.NewGray(bounds)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			l := color.GrayModel.Convert(img.At(x, y)).(color.Gray).Y
+			inverted.SetGray(x, y, color.Gray{Y: 0xff - l})
+		}
+	}
+
+	qr, err := Decode(inverted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(qr.Segments[0].Data); got != data {
+		t.Errorf("unexpected data: got %q, want %q", got, data)
+	}
+}
+
+// version10 is the data that is encoded to version 10 at level H.
+const version10 = "VERSION 10 QR CODE, UP TO 174 CHAR AT H LEVEL, WITH 57X57 MODULES AND PLENTY OF ERROR CORRECTION TO GO AROUND. NOTE THAT THERE ARE ADDITIONAL TRACKING BOXES"
+
+func TestDecodeVersion(t *testing.T) {
+	qr, err := New([]byte(version10), WithLevel(LevelH))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qr.Version != 10 {
+		t.Fatalf("unexpected version: %d", qr.Version)
+	}
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := img.Bounds().Dx() - 1
+
+	// 3 bit errors in the bottom-left block, and 4 bit errors in the top-right block.
+	for i := 0; i < 3; i++ {
+		img.SetBinary(i/3, w-10+i%3, !img.BinaryAt(i/3, w-10+i%3))
+	}
+	for i := 0; i < 4; i++ {
+		img.SetBinary(w-10+i%3, i/3, !img.BinaryAt(w-10+i%3, i/3))
+	}
+	version, distance, ok := decodeVersion(internalbitmap.Import(img))
+	if !ok {
+		t.Fatal("failed to decode version")
+	}
+	if version != 10 || distance != 3 {
+		t.Errorf("unexpected result: version %d, distance %d", version, distance)
+	}
+
+	// too many errors
+	img.SetBinary(1, w-10, !img.BinaryAt(1, w-10))
+	if _, _, ok := decodeVersion(internalbitmap.Import(img)); ok {
+		t.Error("want error, got ok")
+	}
+}
+
+func TestDecodeBitmap_VersionMismatch(t *testing.T) {
+	qr, err := New([]byte(version10), WithLevel(LevelH))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := img.Bounds().Dx() - 1
+
+	// rewrite the version information to version 11.
+	for i := 0; i < 18; i++ {
+		bit := bitmap.Color((encodedVersion[11]>>i)&1 != 0)
+		img.SetBinary(i/3, w-10+i%3, bit)
+		img.SetBinary(w-10+i%3, i/3, bit)
+	}
+	if _, err := DecodeBitmap(img); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestDecodeResult_Resample(t *testing.T) {
+	data := version10
+	img, err := Encode([]byte(data), WithModuleSize(4), WithLevel(LevelH))
+	if err != nil {
+		t.Fatal(err)
+	}
+	binimg := bitmap.BinarizeHybrid(img)
+	result, err := detector.DetectQR(binimg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// simulate that the dimension is estimated wrongly.
+	wrong, err := detector.ResampleQR(binimg, result, 53)
+	if err != nil {
+		t.Fatal(err)
+	}
+	qr, resampled, err := decodeResult(binimg, wrong)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := resampled.Bitmap.Bounds().Dx(); got != 57 {
+		t.Errorf("unexpected dimension: got %d, want %d", got, 57)
+	}
+	var got []byte
+	for _, seg := range qr.Segments {
+		got = append(got, seg.Data...)
+	}
+	if string(got) != data {
+		t.Errorf("unexpected data: got %q, want %q", got, data)
+	}
+}
+
+// warp rotates img by angle and applies a perspective distortion.
+func warp(img image.Image, angle, perspective float64) image.Image {
+	bounds := img.Bounds()
+	size := int(float64(bounds.Dx()) * 1.5)
+	ret := image.NewGray(image.Rect(0, 0, size, size))
+	cx, cy := float64(size)/2, float64(size)/2
+	sx, sy := float64(bounds.Min.X+bounds.Max.X)/2, float64(bounds.Min.Y+bounds.Max.Y)/2
+	sin, cos := math.Sincos(angle)
+	for Y := 0; Y < size; Y++ {
+		for X := 0; X < size; X++ {
+			u, v := float64(X)-cx, float64(Y)-cy
+			w := 1 - perspective*v
+			u, v = u/w, v/w
+			x := cos*u + sin*v + sx
+			y := -sin*u + cos*v + sy
+			p := image.Pt(int(math.Floor(x)), int(math.Floor(y)))
+			if !p.In(bounds) {
+				ret.SetGray(X, Y, color.Gray{Y: 0xff})
+				continue
+			}
+			ret.Set(X, Y, img.At(p.X, p.Y))
+		}
+	}
+	return ret
+}
+
+func TestDecodeBitmapWithInfo_Transform(t *testing.T) {
+	qr, err := New([]byte("HELLO WORLD"), WithLevel(LevelM), WithKanji(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tr := range bitmap.Transforms() {
+		img := tr.Apply(want)
+		got, info, err := DecodeBitmapWithInfo(img)
+		if err != nil {
+			t.Errorf("%+v: %v", tr, err)
+			continue
+		}
+		if string(got.Segments[0].Data) != "HELLO WORLD" {
+			t.Errorf("%+v: unexpected data: got %q, want %q", tr, got.Segments[0].Data, "HELLO WORLD")
+		}
+
+		// info.Transform restores the original image.
+		restored := info.Transform.Apply(img)
+		if !reflect.DeepEqual(restored, want) {
+			t.Errorf("%+v: unexpected transform: %+v", tr, info.Transform)
+		}
+	}
+}
+
+func TestDecodeBitmapWithInfo_FormatCopy(t *testing.T) {
+	qr, err := New([]byte("HELLO WORLD"), WithLevel(LevelM), WithKanji(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := DecodeBitmap(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := img.Bounds().Dx() - 1
+
+	// 4 bit errors in the first copy, and 2 bit errors in the second copy.
+	for i := 0; i < 4; i++ {
+		img.SetBinary(8, i, !img.BinaryAt(8, i))
+	}
+	for i := 0; i < 2; i++ {
+		img.SetBinary(w-i, 8, !img.BinaryAt(w-i, 8))
+	}
+
+	got, info, err := DecodeBitmapWithInfo(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Level != want.Level || got.Mask != want.Mask {
+		t.Errorf("unexpected format: got %v/%v, want %v/%v", got.Level, got.Mask, want.Level, want.Mask)
+	}
+	if info.FormatCopy != 2 {
+		t.Errorf("unexpected format copy: got %d, want 2", info.FormatCopy)
+	}
+	if info.FormatDistance != 2 {
+		t.Errorf("unexpected format distance: got %d, want 2", info.FormatDistance)
+	}
+}
+
+func TestDecodeBitmap_ECI(t *testing.T) {
+	qr := &QRCode{
+		Version: 1,
+		Level:   LevelM,
+		Mask:    MaskAuto,
+		Segments: []Segment{
+			{Mode: ModeECI, ECI: ECIISO8859_5},
+			{Mode: ModeBytes, Data: []byte("\xbc\xde\xe1\xda\xd2\xd0")},
+			{Mode: ModeECI, ECI: 100000},
+			{Mode: ModeNumeric, Data: []byte("2024")},
+		},
+	}
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := DecodeBitmap(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.Segments, qr.Segments) {
+		t.Errorf("unexpected segments: got %v, want %v", got.Segments, qr.Segments)
+	}
+
+	text, err := got.Text()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text != "Москва2024" {
+		t.Errorf("unexpected text: got %q, want %q", text, "Москва2024")
+	}
+}
+
+func TestQRCode_Text(t *testing.T) {
+	tests := []struct {
+		segments []Segment
+		want     string
+	}{
+		{
+			// UTF-8 without ECI
+			segments: []Segment{{Mode: ModeBytes, Data: []byte("点")}},
+			want:     "点",
+		},
+		{
+			// ISO/IEC 8859-1 without ECI
+			segments: []Segment{{Mode: ModeBytes, Data: []byte("caf\xe9")}},
+			want:     "café",
+		},
+		{
+			segments: []Segment{
+				{Mode: ModeECI, ECI: ECIShiftJIS},
+				{Mode: ModeBytes, Data: []byte("\x93\x5f")},
+				{Mode: ModeKanji, Data: []byte("点")},
+			},
+			want: "点点",
+		},
+	}
+	for _, tt := range tests {
+		qr := &QRCode{Segments: tt.segments}
+		got, err := qr.Text()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("got %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestDecodeBitmap_FNC1Second(t *testing.T) {
+	for _, indicator := range []string{"37", "a", "Z"} {
+		qr := &QRCode{
+			Version: 1,
+			Level:   LevelM,
+			Mask:    MaskAuto,
+			Segments: []Segment{
+				{Mode: ModeFNC1_2, Data: []byte(indicator)},
+				{Mode: ModeAlphanumeric, Data: []byte("AB%CD%%")},
+			},
+		}
+		img, err := qr.EncodeToBitmap()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := DecodeBitmap(img)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got.Segments, qr.Segments) {
+			t.Errorf("unexpected segments: got %v, want %v", got.Segments, qr.Segments)
+		}
+
+		text, err := got.Text()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if text != "AB\x1dCD%" {
+			t.Errorf("unexpected text: got %q, want %q", text, "AB\x1dCD%")
+		}
+		if _, err := got.GS1(); err == nil {
+			t.Error("want error, but not")
+		}
+	}
+}
+
+func TestJoiner(t *testing.T) {
+	data := bytes.Repeat([]byte("structured append "), 10)
+	symbols, err := NewStructuredAppend(data, WithVersion(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(symbols) < 2 {
+		t.Fatalf("want more than one symbol, got %d", len(symbols))
+	}
+
+	var j Joiner
+	for i := len(symbols) - 1; i >= 0; i-- {
+		if j.Complete() {
+			t.Error("unexpected complete")
+		}
+		img, err := symbols[i].EncodeToBitmap()
+		if err != nil {
+			t.Fatal(err)
+		}
+		qr, err := DecodeBitmap(img)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := j.Add(qr); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !j.Complete() {
+		t.Error("want complete, but not")
+	}
+	qr, err := j.Join()
+	if err != nil {
+		t.Fatal(err)
+	}
+	text, err := qr.Text()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text != string(data) {
+		t.Errorf("got %q, want %q", text, data)
+	}
+
+	// broken parity
+	j = Joiner{}
+	for _, qr := range symbols {
+		qr.Segments[0].StructuredAppend.Parity ^= 0xff
+		if err := j.Add(qr); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := j.Join(); err == nil {
+		t.Error("want error, but not")
+	}
+
+	// not in structured append mode
+	plain, err := New([]byte("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := j.Add(plain); err == nil {
+		t.Error("want error, but not")
+	}
+}
+
+func TestJoiner_ShiftJIS(t *testing.T) {
+	// "a" and 2001 "点" in Shift_JIS don't fit in version 40-L.
+	data := append([]byte("a"), bytes.Repeat([]byte("\x93\x5f"), 2001)...)
+	symbols, err := NewStructuredAppend(data, WithLevel(LevelL), WithKanji(true), WithShiftJIS(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(symbols) < 2 {
+		t.Fatalf("want more than one symbol, got %d", len(symbols))
+	}
+
+	// the parity is of the original Shift_JIS data.
+	var parity byte
+	for _, b := range data {
+		parity ^= b
+	}
+	if got := symbols[0].Segments[0].StructuredAppend.Parity; got != parity {
+		t.Errorf("unexpected parity: got %#02x, want %#02x", got, parity)
+	}
+
+	var j Joiner
+	for _, symbol := range symbols {
+		// the double-byte characters are not split across the symbols.
+		for _, s := range symbol.Segments[1:] {
+			if s.Mode == ModeBytes && string(s.Data) != "a" {
+				t.Errorf("unexpected bytes segment: %x", s.Data)
+			}
+		}
+
+		img, err := symbol.EncodeToBitmap()
+		if err != nil {
+			t.Fatal(err)
+		}
+		qr, err := DecodeBitmap(img)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := j.Add(qr); err != nil {
+			t.Fatal(err)
+		}
+	}
+	qr, err := j.Join()
+	if err != nil {
+		t.Fatal(err)
+	}
+	text, err := qr.Text()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "a" + strings.Repeat("点", 2001); text != want {
+		t.Errorf("unexpected text: got %q, want %q", text, want)
+	}
+}
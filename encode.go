@@ -3,17 +3,21 @@ package qrcode
 //go:generate go run genbch/main.go
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"image"
 	"image/color"
+	"io"
 	"math"
 	"unicode/utf8"
 
 	bitmap "github.com/shogo82148/qrcode/bitmap"
+	"github.com/shogo82148/qrcode/gs1"
 	internalbitmap "github.com/shogo82148/qrcode/internal/bitmap"
 	"github.com/shogo82148/qrcode/internal/bitstream"
 	"github.com/shogo82148/qrcode/internal/reedsolomon"
+	"github.com/shogo82148/qrcode/render"
 )
 
 func New(data []byte, opts ...EncodeOptions) (*QRCode, error) {
@@ -22,14 +26,297 @@ func New(data []byte, opts ...EncodeOptions) (*QRCode, error) {
 	if !lv.IsValid() {
 		return nil, fmt.Errorf("qrcode: invalid level: %d", lv)
 	}
-	if myopts.Kanji {
-		return newFromKanji(lv, data)
+	if !myopts.Version.IsValid() {
+		return nil, fmt.Errorf("qrcode: invalid version: %d", myopts.Version)
+	}
+	if !myopts.MinVersion.IsValid() {
+		return nil, fmt.Errorf("qrcode: invalid version: %d", myopts.MinVersion)
+	}
+
+	if !myopts.Mask.IsValid() {
+		return nil, fmt.Errorf("qrcode: invalid mask: %d", myopts.Mask)
+	}
+
+	// UTF-8 and Shift_JIS are exclusive.
+	eci := myopts.UTF8ECI && !myopts.ShiftJIS
+
+	var qr *QRCode
+	var err error
+	var optimize func(class versionClass) []Segment
+	if myopts.Hanzi {
+		qr, err = newFromKanji(lv, data, ModeHanzi, eci, false)
+		optimize = func(class versionClass) []Segment {
+			return segmentsKanji(data, class, ModeHanzi, eci, false)
+		}
+	} else if myopts.Kanji {
+		qr, err = newFromKanji(lv, data, ModeKanji, eci, myopts.ShiftJIS)
+		optimize = func(class versionClass) []Segment {
+			return segmentsKanji(data, class, ModeKanji, eci, myopts.ShiftJIS)
+		}
+	} else {
+		qr, err = newQR(lv, data, eci)
+		optimize = func(class versionClass) []Segment {
+			return segmentsQR(data, class, eci)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		optimize = nil
+	}
+	if err := selectOptimizedVersion(qr, myopts, optimize); err != nil {
+		return nil, err
+	}
+	qr.Mask = myopts.Mask
+	return qr, nil
+}
+
+// NewGS1 returns a GS1 QR code that encodes the elements.
+func NewGS1(elements []gs1.Element, opts ...EncodeOptions) (*QRCode, error) {
+	data, err := gs1.Format(elements)
+	if err != nil {
+		return nil, err
+	}
+	qr, err := New([]byte(data), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// GS is encoded as-is in the bytes mode.
+	// "%" in the alphanumeric mode is escaped because it means GS in FNC1 modes.
+	segments := make([]Segment, 0, len(qr.Segments)+1)
+	segments = append(segments, Segment{Mode: ModeFNC1_1})
+	for _, s := range qr.Segments {
+		if s.Mode == ModeAlphanumeric {
+			s.Data = bytes.ReplaceAll(s.Data, []byte("%"), []byte("%%"))
+		}
+		segments = append(segments, s)
+	}
+	qr.Segments = segments
+	if err := selectVersion(qr, newEncodeOptions(opts...)); err != nil {
+		return nil, err
+	}
+	return qr, nil
+}
+
+// NewStructuredAppend splits data across the smallest number of symbols in structured append mode.
+// If data fits in one symbol, it returns the single symbol of New without the structured append header.
+// Otherwise, it splits data into 2 to 16 symbols, and returns an error if data doesn't fit in 16 symbols.
+// data is split at character boundaries if it is UTF-8, or Shift_JIS with WithShiftJIS(true).
+func NewStructuredAppend(data []byte, opts ...EncodeOptions) ([]*QRCode, error) {
+	qr, err := New(data, opts...)
+	if err == nil {
+		return []*QRCode{qr}, nil
+	}
+	if !errors.Is(err, ErrDataTooLarge) {
+		return nil, err
+	}
+
+	myopts := newEncodeOptions(opts...)
+	lastErr := err
+LOOP:
+	for total := 2; total <= 16; total++ {
+		symbols := make([]*QRCode, 0, total)
+		for _, chunk := range splitData(data, total, myopts.ShiftJIS) {
+			qr, err := New(chunk, opts...)
+			if err != nil {
+				lastErr = err
+				continue LOOP
+			}
+			symbols = append(symbols, qr)
+		}
+
+		// calculate the parity in the same way as Joiner.Join.
+		var parity byte
+		for _, qr := range symbols {
+			p, err := segmentsParity(qr.Segments)
+			if err != nil {
+				return nil, err
+			}
+			parity ^= p
+		}
+
+		for i, qr := range symbols {
+			header := Segment{
+				Mode: ModeConnected,
+				StructuredAppend: StructuredAppend{
+					Index:  i,
+					Total:  total,
+					Parity: parity,
+				},
+			}
+			qr.Segments = append([]Segment{header}, qr.Segments...)
+			if err := selectVersion(qr, myopts); err != nil {
+				lastErr = err
+				continue LOOP
+			}
+		}
+		return symbols, nil
+	}
+	return nil, lastErr
+}
+
+// splitData splits data into n chunks of almost the same length.
+// It doesn't split the characters if data is UTF-8, or if shiftJIS is true.
+func splitData(data []byte, n int, shiftJIS bool) [][]byte {
+	var isStart func(i int) bool
+	switch {
+	case shiftJIS:
+		// the trail bytes may look like the lead bytes, so find the characters from the beginning.
+		starts := make([]bool, len(data))
+		for i := 0; i < len(data); {
+			starts[i] = true
+			if bitstream.IsShiftJISLead(data[i]) && i+1 < len(data) {
+				i += 2
+			} else {
+				i++
+			}
+		}
+		isStart = func(i int) bool { return starts[i] }
+	case utf8.Valid(data):
+		isStart = func(i int) bool { return utf8.RuneStart(data[i]) }
+	default:
+		isStart = func(i int) bool { return true }
+	}
+
+	chunks := make([][]byte, 0, n)
+	start := 0
+	for i := 1; i <= n; i++ {
+		end := len(data) * i / n
+		for end < len(data) && !isStart(end) {
+			end++
+		}
+		if end < start {
+			end = start
+		}
+		chunks = append(chunks, data[start:end])
+		start = end
+	}
+	return chunks
+}
+
+// segmentsParity returns the XOR of the data in the segments for the structured append.
+// JIS X 0510 defines the parity over the input data,
+// so the characters in the kanji mode are counted in Shift_JIS.
+func segmentsParity(segments []Segment) (byte, error) {
+	var parity byte
+	for _, s := range segments {
+		data := s.Data
+		switch s.Mode {
+		case ModeNumeric, ModeAlphanumeric, ModeBytes, ModeHanzi:
+		case ModeKanji:
+			var err error
+			data, err = bitstream.KanjiToShiftJIS(data)
+			if err != nil {
+				return 0, err
+			}
+		default:
+			continue
+		}
+		for _, b := range data {
+			parity ^= b
+		}
+	}
+	return parity, nil
+}
+
+// Optimize splits data into segments for the smallest symbol at the level.
+// It uses the numeric, alphanumeric, bytes and kanji modes, as New does by default.
+// It returns the segments and their total length in bits.
+func Optimize(data []byte, level Level) ([]Segment, int, error) {
+	if !level.IsValid() {
+		return nil, 0, fmt.Errorf("qrcode: invalid level: %d", level)
+	}
+	qr, err := newFromKanji(level, data, ModeKanji, false, false)
+	if err != nil {
+		return nil, 0, err
+	}
+	var bits int
+	for i := range qr.Segments {
+		bits += qr.Segments[i].length(qr.Version)
+	}
+	return qr.Segments, bits, nil
+}
+
+// selectVersion changes the version of qr to satisfy WithVersion and WithMinVersion,
+// and then raises the level if WithBoostLevel is set.
+func selectVersion(qr *QRCode, opts encodeOptions) error {
+	// New may have boosted the level, so start over from the requested one.
+	qr.Level = opts.Level
+
+	if opts.Version != 0 {
+		if !fits(qr.Level, opts.Version, qr.Segments) {
+			return newDataTooLargeError(qr.Level, opts.Version, qr.Segments)
+		}
+		qr.Version = opts.Version
 	} else {
-		return newQR(lv, data)
+		version := qr.Version
+		for version <= 40 && (version < opts.MinVersion || !fits(qr.Level, version, qr.Segments)) {
+			version++
+		}
+		if version > 40 {
+			return newDataTooLargeError(qr.Level, 40, qr.Segments)
+		}
+		qr.Version = version
 	}
+
+	if opts.BoostLevel {
+		boostLevel(qr)
+	}
+	return nil
 }
 
-func newQR(level Level, data []byte) (*QRCode, error) {
+// selectOptimizedVersion is the same as selectVersion,
+// but it optimizes the segments again if the version moves to another class of versions,
+// because the bit lengths of the character count indicators change.
+// optimize returns the segments for the class. It may be nil if the segments can't be optimized.
+func selectOptimizedVersion(qr *QRCode, opts encodeOptions, optimize func(class versionClass) []Segment) error {
+	class := classOf(qr.Version)
+	for {
+		err := selectVersion(qr, opts)
+		if optimize == nil {
+			return err
+		}
+
+		target := qr.Version
+		if err != nil {
+			var e *DataTooLargeError
+			if !errors.As(err, &e) {
+				return err
+			}
+			target = e.Version
+		}
+		if classOf(target) == class {
+			return err
+		}
+
+		// WithVersion fixes the class, and WithMinVersion only raises the version,
+		// so the loop stops.
+		class = classOf(target)
+		qr.Segments = optimize(class)
+		qr.Version = class.min
+	}
+}
+
+// boostLevel raises the level of qr while the data fit in the same version.
+func boostLevel(qr *QRCode) {
+	levels := [...]Level{LevelL, LevelM, LevelQ, LevelH}
+	for i, lv := range levels {
+		if lv != qr.Level {
+			continue
+		}
+		for _, higher := range levels[i+1:] {
+			if !fits(higher, qr.Version, qr.Segments) {
+				return
+			}
+			qr.Level = higher
+		}
+		return
+	}
+}
+
+func newQR(level Level, data []byte, eci bool) (*QRCode, error) {
 	if len(data) == 0 {
 		return &QRCode{
 			Version: 1,
@@ -38,6 +325,25 @@ func newQR(level Level, data []byte) (*QRCode, error) {
 		}, nil
 	}
 
+	// the bit lengths of the character count indicators depend on the version.
+	// optimize the segments for each class of versions, and choose the smallest symbol.
+	var segments []Segment
+	for _, class := range versionClasses {
+		segments = segmentsQR(data, class, eci)
+		if version := calcVersion(level, class, segments); version != 0 {
+			return &QRCode{
+				Version:  version,
+				Level:    level,
+				Mask:     MaskAuto,
+				Segments: segments,
+			}, nil
+		}
+	}
+	return nil, newDataTooLargeError(level, 40, segments)
+}
+
+// optimizeQR splits data into segments that minimize the bit length in the versions of class.
+func optimizeQR(data []byte, class versionClass) []Segment {
 	const inf = math.MaxInt - 1<<18 // 1<<18 is for avoiding overflow
 	const (
 		modeInit = iota
@@ -66,7 +372,7 @@ func newQR(level Level, data []byte) (*QRCode, error) {
 			for mode := modeInit; mode < modeMax; mode++ {
 				cost := states[i][mode].cost + 20
 				if mode != modeNumeric {
-					cost += (4 + 14) * 6
+					cost += (4 + class.numeric) * 6
 				}
 				if cost < minCost {
 					minCost = cost
@@ -92,7 +398,7 @@ func newQR(level Level, data []byte) (*QRCode, error) {
 			for mode := modeInit; mode < modeMax; mode++ {
 				cost := states[i][mode].cost + 33
 				if mode != modeAlphanumeric {
-					cost += (4 + 13) * 6
+					cost += (4 + class.alphanumeric) * 6
 				}
 				if cost < minCost {
 					minCost = cost
@@ -118,7 +424,7 @@ func newQR(level Level, data []byte) (*QRCode, error) {
 			for mode := modeInit; mode < modeMax; mode++ {
 				cost := states[i][mode].cost + 8*6
 				if mode != modeBytes {
-					cost += (4 + 16) * 6
+					cost += (4 + class.bytes) * 6
 				}
 				if cost < minCost {
 					minCost = cost
@@ -166,21 +472,11 @@ func newQR(level Level, data []byte) (*QRCode, error) {
 			})
 		}
 	}
-
-	version := calcVersion(level, segments)
-	if version == 0 {
-		return nil, errors.New("qrcode: data too large")
-	}
-
-	return &QRCode{
-		Version:  version,
-		Level:    level,
-		Mask:     MaskAuto,
-		Segments: segments,
-	}, nil
+	return segments
 }
 
-func newFromKanji(level Level, data []byte) (*QRCode, error) {
+// newFromKanji is the same as newQR, but it uses the double-byte mode, which is ModeKanji or ModeHanzi.
+func newFromKanji(level Level, data []byte, double Mode, eci, shiftJIS bool) (*QRCode, error) {
 	if len(data) == 0 {
 		return &QRCode{
 			Version: 1,
@@ -189,6 +485,55 @@ func newFromKanji(level Level, data []byte) (*QRCode, error) {
 		}, nil
 	}
 
+	var segments []Segment
+	for _, class := range versionClasses {
+		segments = segmentsKanji(data, class, double, eci, shiftJIS)
+		if version := calcVersion(level, class, segments); version != 0 {
+			return &QRCode{
+				Version:  version,
+				Level:    level,
+				Mask:     MaskAuto,
+				Segments: segments,
+			}, nil
+		}
+	}
+	return nil, newDataTooLargeError(level, 40, segments)
+}
+
+// segmentsQR returns the segments of data for the versions of class without the double-byte mode.
+func segmentsQR(data []byte, class versionClass, eci bool) []Segment {
+	segments := optimizeQR(data, class)
+	if eci && utf8.Valid(data) && hasNonASCIIBytes(segments) {
+		segments = append([]Segment{{Mode: ModeECI, ECI: ECIUTF8}}, segments...)
+	}
+	return segments
+}
+
+// segmentsKanji returns the segments of data for the versions of class with the double-byte mode.
+func segmentsKanji(data []byte, class versionClass, double Mode, eci, shiftJIS bool) []Segment {
+	segments, cost := optimizeKanji(data, class, double, false, shiftJIS)
+	if eci && utf8.Valid(data) && hasNonASCIIBytes(segments) {
+		// the ECI header costs 12 bits.
+		// it is unnecessary if all non-ASCII characters are encoded in the double-byte mode.
+		segments0, cost0 := segments, math.MaxInt
+		if isKanjiOrASCII(data, double) {
+			segments0, cost0 = optimizeKanji(data, class, double, true, false)
+		}
+		if cost0 <= cost+(4+8)*6 {
+			segments = segments0
+		} else {
+			segments = append([]Segment{{Mode: ModeECI, ECI: ECIUTF8}}, segments...)
+		}
+	}
+	return segments
+}
+
+// optimizeKanji splits data into segments that minimize the bit length.
+// double is the mode for double-byte characters, ModeKanji or ModeHanzi.
+// If asciiBytes is true, the bytes mode is used only for ASCII characters.
+// If shiftJIS is true, data is Shift_JIS.
+// It returns the segments and the cost in the versions of class, which is the bit length * 6.
+func optimizeKanji(data []byte, class versionClass, double Mode, asciiBytes, shiftJIS bool) ([]Segment, int) {
 	const inf = math.MaxInt - 1<<18 // 1<<18 is for avoiding overflow
 	const (
 		modeInit = iota
@@ -202,6 +547,9 @@ func newFromKanji(level Level, data []byte) (*QRCode, error) {
 		cost     int // = bit length * 6
 		lastMode int
 		data     []byte
+
+		// text is the data in UTF-8 if data is Shift_JIS.
+		text []byte
 	}
 	states := make([][5]state, len(data)+1)
 	states[0][modeNumeric].cost = inf
@@ -209,18 +557,24 @@ func newFromKanji(level Level, data []byte) (*QRCode, error) {
 	states[0][modeBytes].cost = inf
 	states[0][modeKanji].cost = inf
 
+	kanjiRunes, kanjiSizes := bitstream.KanjiRunes(data, shiftJIS)
+	kanjiHeader := (4 + class.kanji) * 6
+	if double == ModeHanzi {
+		kanjiSizes = bitstream.HanziSizes(data)
+		kanjiHeader += 4 * 6 // subset indicator
+	}
 	for i := 0; i < len(data); i++ {
 		if i != 0 {
 			states[i][modeInit].cost = inf
 		}
 		// numeric
 		if bitstream.IsNumeric(data[i]) {
-			minCost := states[i][modeInit].cost + (4+14)*6 + 20
+			minCost := states[i][modeInit].cost + (4+class.numeric)*6 + 20
 			lastMode := modeInit
 			for mode := modeInit + 1; mode < modeMax; mode++ {
 				cost := states[i][mode].cost + 20
 				if mode != modeNumeric {
-					cost += (4 + 14) * 6
+					cost += (4 + class.numeric) * 6
 				}
 				if cost < minCost {
 					minCost = cost
@@ -242,12 +596,12 @@ func newFromKanji(level Level, data []byte) (*QRCode, error) {
 
 		// alphanumeric
 		if bitstream.IsAlphanumeric(data[i]) {
-			minCost := states[i][modeInit].cost + (4+13)*6 + 33
+			minCost := states[i][modeInit].cost + (4+class.alphanumeric)*6 + 33
 			lastMode := modeInit
 			for mode := modeInit + 1; mode < modeMax; mode++ {
 				cost := states[i][mode].cost + 33
 				if mode != modeAlphanumeric {
-					cost += (4 + 13) * 6
+					cost += (4 + class.alphanumeric) * 6
 				}
 				if cost < minCost {
 					minCost = cost
@@ -268,13 +622,19 @@ func newFromKanji(level Level, data []byte) (*QRCode, error) {
 		}
 
 		// bytes
-		{
-			minCost := states[i][modeInit].cost + (4+16+8)*6
+		if asciiBytes && data[i] >= utf8.RuneSelf {
+			states[i+1][modeBytes] = state{
+				cost:     inf,
+				lastMode: modeInit,
+				data:     []byte{},
+			}
+		} else {
+			minCost := states[i][modeInit].cost + (4+class.bytes+8)*6
 			lastMode := modeInit
 			for mode := modeInit + 1; mode < modeMax; mode++ {
 				cost := states[i][mode].cost + 8*6
 				if mode != modeBytes {
-					cost += (4 + 16) * 6
+					cost += (4 + class.bytes) * 6
 				}
 				if cost < minCost {
 					minCost = cost
@@ -289,14 +649,13 @@ func newFromKanji(level Level, data []byte) (*QRCode, error) {
 		}
 
 		// kanji
-		r, size := utf8.DecodeRune(data[i:])
-		if r != utf8.RuneError && bitstream.IsKanji(r) && i+size < len(states) {
-			minCost := states[i][modeInit].cost + (4+12+13)*6
+		if size := kanjiSizes[i]; size > 0 && i+size < len(states) {
+			minCost := states[i][modeInit].cost + kanjiHeader + 13*6
 			lastMode := modeInit
 			for mode := modeInit + 1; mode < modeMax; mode++ {
 				cost := states[i][mode].cost + 13*6
 				if mode != modeKanji {
-					cost += (4 + 12) * 6
+					cost += kanjiHeader
 				}
 				if cost < minCost {
 					minCost = cost
@@ -308,10 +667,16 @@ func newFromKanji(level Level, data []byte) (*QRCode, error) {
 				states[i+j][modeKanji].cost = inf
 			}
 
+			var text []byte
+			if shiftJIS {
+				// the data of kanji mode segments are UTF-8.
+				text = utf8.AppendRune(nil, kanjiRunes[i])
+			}
 			states[i+size][modeKanji] = state{
 				cost:     minCost,
 				lastMode: lastMode,
 				data:     data[i : i+size],
+				text:     text,
 			}
 		} else if states[i+1][modeKanji].data == nil {
 			states[i+1][modeKanji] = state{
@@ -327,6 +692,12 @@ func newFromKanji(level Level, data []byte) (*QRCode, error) {
 		mode int
 		data []byte
 	}
+	segmentData := func(s state) []byte {
+		if s.text != nil {
+			return s.text
+		}
+		return s.data
+	}
 	best := make([]elem, 0, len(data))
 	minCost := states[len(data)][modeNumeric].cost
 	bestMode := modeNumeric
@@ -338,7 +709,7 @@ func newFromKanji(level Level, data []byte) (*QRCode, error) {
 	}
 	best = append(best, elem{
 		mode: bestMode,
-		data: states[len(data)][bestMode].data,
+		data: segmentData(states[len(data)][bestMode]),
 	})
 	for i := len(data); ; {
 		size := len(states[i][bestMode].data)
@@ -349,11 +720,11 @@ func newFromKanji(level Level, data []byte) (*QRCode, error) {
 		i -= size
 		best = append(best, elem{
 			mode: bestMode,
-			data: states[i][bestMode].data,
+			data: segmentData(states[i][bestMode]),
 		})
 	}
 
-	modeList := [...]Mode{0, ModeNumeric, ModeAlphanumeric, ModeBytes, ModeKanji}
+	modeList := [...]Mode{0, ModeNumeric, ModeAlphanumeric, ModeBytes, double}
 	segments := []Segment{
 		{
 			Mode: modeList[best[len(best)-1].mode],
@@ -372,44 +743,108 @@ func newFromKanji(level Level, data []byte) (*QRCode, error) {
 		}
 	}
 
-	version := calcVersion(level, segments)
-	if version == 0 {
-		return nil, errors.New("qrcode: data too large")
-	}
+	return segments, minCost
+}
 
-	return &QRCode{
-		Version:  version,
-		Level:    level,
-		Mask:     MaskAuto,
-		Segments: segments,
-	}, nil
+// hasNonASCIIBytes reports whether the bytes mode segments include non-ASCII characters.
+func hasNonASCIIBytes(segments []Segment) bool {
+	for _, s := range segments {
+		if s.Mode != ModeBytes {
+			continue
+		}
+		for _, b := range s.Data {
+			if b >= utf8.RuneSelf {
+				return true
+			}
+		}
+	}
+	return false
 }
 
-func calcVersion(level Level, segments []Segment) Version {
-	if !level.IsValid() {
-		return 0
+// isKanjiOrASCII reports whether all characters in data are ASCII characters
+// or can be encoded in the double-byte mode, which is ModeKanji or ModeHanzi.
+func isKanjiOrASCII(data []byte, double Mode) bool {
+	for _, r := range string(data) {
+		if r >= utf8.RuneSelf && (double == ModeKanji && !bitstream.IsKanji(r) || double == ModeHanzi && !bitstream.IsHanzi(r)) {
+			return false
+		}
 	}
+	return true
+}
 
-LOOP:
-	for version := Version(1); version <= 40; version++ {
-		capacity := capacityTable[version][level].Data * 8
-		length := 0
-		for _, s := range segments {
-			l := s.length(version)
-			length += l
-			if length > capacity {
-				continue LOOP
-			}
+// versionClass is a range of versions that share the bit lengths of the character count indicators.
+type versionClass struct {
+	min, max Version
+
+	// the bit lengths of the character count indicators.
+	numeric, alphanumeric, bytes, kanji int
+}
+
+var versionClasses = [...]versionClass{
+	{min: 1, max: 9, numeric: 10, alphanumeric: 9, bytes: 8, kanji: 8},
+	{min: 10, max: 26, numeric: 12, alphanumeric: 11, bytes: 16, kanji: 10},
+	{min: 27, max: 40, numeric: 14, alphanumeric: 13, bytes: 16, kanji: 12},
+}
+
+// classOf returns the class of the version.
+func classOf(version Version) versionClass {
+	for _, class := range versionClasses {
+		if version <= class.max {
+			return class
 		}
-		if length <= capacity {
+	}
+	return versionClasses[len(versionClasses)-1]
+}
+
+// calcVersion returns the smallest version in class that the segments fit in.
+// It returns 0 if no version fits.
+func calcVersion(level Level, class versionClass, segments []Segment) Version {
+	for version := class.min; version <= class.max; version++ {
+		if fits(level, version, segments) {
 			return version
 		}
 	}
 	return 0
 }
 
+// fits reports whether the segments fit in the version.
+func fits(level Level, version Version, segments []Segment) bool {
+	capacity := capacityTable[version][level].Data * 8
+	length := 0
+	for _, s := range segments {
+		length += s.length(version)
+		if length > capacity {
+			return false
+		}
+	}
+	return true
+}
+
 const timingPatternOffset = 6
 
+// Validate checks that qr can be encoded as-is.
+// It reports an invalid version, level, mask or segment, and data that don't fit in the version.
+func (qr *QRCode) Validate() error {
+	if qr.Version < 1 || qr.Version > 40 {
+		return fmt.Errorf("qrcode: invalid version: %d", qr.Version)
+	}
+	if !qr.Level.IsValid() {
+		return fmt.Errorf("qrcode: invalid level: %d", qr.Level)
+	}
+	if !qr.Mask.IsValid() {
+		return fmt.Errorf("qrcode: invalid mask: %d", qr.Mask)
+	}
+	for i := range qr.Segments {
+		if err := qr.Segments[i].validate(); err != nil {
+			return err
+		}
+	}
+	if !fits(qr.Level, qr.Version, qr.Segments) {
+		return newDataTooLargeError(qr.Level, qr.Version, qr.Segments)
+	}
+	return nil
+}
+
 func skipTimingPattern(n int) int {
 	if n < timingPatternOffset {
 		return n
@@ -422,10 +857,23 @@ type EncodeOptions interface {
 }
 
 type encodeOptions struct {
-	QuiteZone  int
-	ModuleSize float64
-	Level      Level
-	Kanji      bool
+	QuiteZone    int
+	ModuleSize   float64
+	Level        Level
+	Kanji        bool
+	Version      Version
+	MinVersion   Version
+	Mask         Mask
+	UTF8ECI      bool
+	ShiftJIS     bool
+	Hanzi        bool
+	BoostLevel   bool
+	Foreground   color.Color
+	Background   color.Color
+	Scalable     bool
+	Width        int
+	Height       int
+	IntegerScale bool
 }
 
 func newEncodeOptions(opts ...EncodeOptions) encodeOptions {
@@ -434,6 +882,9 @@ func newEncodeOptions(opts ...EncodeOptions) encodeOptions {
 		ModuleSize: 1,
 		Level:      LevelQ,
 		Kanji:      true,
+		Mask:       MaskAuto,
+		Foreground: color.Black,
+		Background: color.White,
 	}
 	for _, o := range opts {
 		o.apply(&myopts)
@@ -481,6 +932,173 @@ func WithKanji(use bool) EncodeOptions {
 	return withKanji(use)
 }
 
+type withVersion Version
+
+func (opt withVersion) apply(opts *encodeOptions) {
+	opts.Version = Version(opt)
+}
+
+// WithVersion fixes the version of QR code.
+// New returns an error if the data does not fit in the version.
+// The zero value means choosing the smallest version automatically.
+func WithVersion(version Version) EncodeOptions {
+	return withVersion(version)
+}
+
+type withMinVersion Version
+
+func (opt withMinVersion) apply(opts *encodeOptions) {
+	opts.MinVersion = Version(opt)
+}
+
+// WithMinVersion makes New choose the smallest version that is at least version.
+func WithMinVersion(version Version) EncodeOptions {
+	return withMinVersion(version)
+}
+
+type withMask Mask
+
+func (opt withMask) apply(opts *encodeOptions) {
+	opts.Mask = Mask(opt)
+}
+
+// WithMask fixes the mask pattern.
+// The default is MaskAuto, which chooses the best mask pattern.
+func WithMask(mask Mask) EncodeOptions {
+	return withMask(mask)
+}
+
+type withUTF8ECI bool
+
+func (opt withUTF8ECI) apply(opts *encodeOptions) {
+	opts.UTF8ECI = bool(opt)
+}
+
+// WithUTF8ECI makes New insert ECI 26 (UTF-8) at the head of the data,
+// if the data is UTF-8 and includes non-ASCII characters encoded in the bytes mode.
+func WithUTF8ECI(use bool) EncodeOptions {
+	return withUTF8ECI(use)
+}
+
+type withShiftJIS bool
+
+func (opt withShiftJIS) apply(opts *encodeOptions) {
+	opts.ShiftJIS = bool(opt)
+}
+
+// WithShiftJIS makes WithKanji(true) treat the data as Shift_JIS instead of UTF-8.
+// The double-byte codes are packed in the kanji mode, and the other bytes are kept as-is.
+func WithShiftJIS(use bool) EncodeOptions {
+	return withShiftJIS(use)
+}
+
+type withHanzi bool
+
+func (opt withHanzi) apply(opts *encodeOptions) {
+	opts.Hanzi = bool(opt)
+}
+
+// WithHanzi enables the hanzi mode of GB/T 18284, which encodes GB2312 characters in 13 bits.
+// It takes precedence over WithKanji, and the symbols can be read only by the readers that support GB/T 18284.
+func WithHanzi(use bool) EncodeOptions {
+	return withHanzi(use)
+}
+
+type withBoostLevel bool
+
+func (opt withBoostLevel) apply(opts *encodeOptions) {
+	opts.BoostLevel = bool(opt)
+}
+
+// WithBoostLevel raises the error correction level (L→M→Q→H) while the data still fit in the same version.
+func WithBoostLevel(use bool) EncodeOptions {
+	return withBoostLevel(use)
+}
+
+type withForeground struct{ c color.Color }
+
+func (opt withForeground) apply(opts *encodeOptions) {
+	opts.Foreground = opt.c
+}
+
+// WithForeground sets the color of the dark modules in Encode and EncodeSVG.
+// The default is black.
+func WithForeground(c color.Color) EncodeOptions {
+	return withForeground{c}
+}
+
+type withBackground struct{ c color.Color }
+
+func (opt withBackground) apply(opts *encodeOptions) {
+	opts.Background = opt.c
+}
+
+// WithBackground sets the color of the light modules and the quiet zone in Encode and EncodeSVG.
+// The default is white, and a fully transparent color leaves the background unpainted.
+// Encode and EncodeSVG return ErrLowContrast if the colors are too close for scanners.
+func WithBackground(c color.Color) EncodeOptions {
+	return withBackground{c}
+}
+
+type withScalable bool
+
+func (opt withScalable) apply(opts *encodeOptions) {
+	opts.Scalable = bool(opt)
+}
+
+// WithScalable makes EncodeSVG omit the width and the height of the image.
+// Only the viewBox is set, so the image fits its container.
+func WithScalable(use bool) EncodeOptions {
+	return withScalable(use)
+}
+
+type withImageSize int
+
+func (opt withImageSize) apply(opts *encodeOptions) {
+	opts.Width = int(opt)
+	opts.Height = int(opt)
+}
+
+// WithImageSize makes Encode draw the image in exactly size x size pixels, instead of WithModuleSize.
+// The leftover pixels are spread evenly over the modules, so the sizes of the modules differ by one pixel at most.
+// Encode returns an error if the image is smaller than the number of modules including the quiet zone.
+func WithImageSize(size int) EncodeOptions {
+	return withImageSize(size)
+}
+
+type withIntegerScale bool
+
+func (opt withIntegerScale) apply(opts *encodeOptions) {
+	opts.IntegerScale = bool(opt)
+}
+
+// WithIntegerScale makes WithImageSize use the same integer size for all modules,
+// and pad the quiet zone with the leftover pixels.
+func WithIntegerScale(use bool) EncodeOptions {
+	return withIntegerScale(use)
+}
+
+// checkContrast checks that scanners can tell the foreground from the background.
+func (opts *encodeOptions) checkContrast() error {
+	if render.Contrast(opts.Foreground, opts.Background) < render.MinContrast {
+		return ErrLowContrast
+	}
+	return nil
+}
+
+func (opts *encodeOptions) renderOptions() *render.Options {
+	return &render.Options{
+		QuietZone:    opts.QuiteZone,
+		ModuleSize:   opts.ModuleSize,
+		Foreground:   opts.Foreground,
+		Background:   opts.Background,
+		Scalable:     opts.Scalable,
+		Width:        opts.Width,
+		Height:       opts.Height,
+		IntegerScale: opts.IntegerScale,
+	}
+}
+
 func Encode(data []byte, opts ...EncodeOptions) (image.Image, error) {
 	qr, err := New(data, opts...)
 	if err != nil {
@@ -498,43 +1116,46 @@ func (qr *QRCode) Encode(opts ...EncodeOptions) (image.Image, error) {
 	}
 
 	myopts := newEncodeOptions(opts...)
+	if err := myopts.checkContrast(); err != nil {
+		return nil, err
+	}
 
 	binimg, err := qr.EncodeToBitmap()
 	if err != nil {
 		return nil, err
 	}
 
-	w := binimg.Bounds().Dx() + myopts.QuiteZone*2
-	W := int(math.Ceil(float64(w) * myopts.ModuleSize))
-	scale := myopts.ModuleSize
-	dX := float64(myopts.QuiteZone) * scale
-	dY := float64(myopts.QuiteZone) * scale
-
-	// create new paletted image
-	palette := color.Palette{
-		color.White, color.Black,
-	}
-	white := uint8(0)
-	black := uint8(1)
-	bounds := image.Rect(0, 0, W, W)
-	img := image.NewPaletted(bounds, palette)
-
-	// convert bitmap to image
-	for Y := bounds.Min.Y; Y < bounds.Max.Y; Y++ {
-		y := int(math.Floor((float64(Y) - dY) / scale))
-		for X := bounds.Min.X; X < bounds.Max.X; X++ {
-			x := int(math.Floor((float64(X) - dX) / scale))
-			c := binimg.BinaryAt(x, y)
-			if c {
-				img.SetColorIndex(X, Y, black)
-			} else {
-				img.SetColorIndex(X, Y, white)
-			}
-		}
+	img, err := render.Raster(binimg, myopts.renderOptions())
+	if err != nil {
+		return nil, err
 	}
 	return img, nil
 }
 
+// EncodeSVG encodes data into QR code, and writes it to w in the SVG format.
+func EncodeSVG(w io.Writer, data []byte, opts ...EncodeOptions) error {
+	qr, err := New(data, opts...)
+	if err != nil {
+		return err
+	}
+	return qr.EncodeSVG(w, opts...)
+}
+
+// EncodeSVG writes qr to w in the SVG format.
+// The dark modules are merged into outlines.
+func (qr *QRCode) EncodeSVG(w io.Writer, opts ...EncodeOptions) error {
+	myopts := newEncodeOptions(opts...)
+	if err := myopts.checkContrast(); err != nil {
+		return err
+	}
+
+	binimg, err := qr.EncodeToBitmap()
+	if err != nil {
+		return err
+	}
+	return render.SVG(w, binimg, myopts.renderOptions())
+}
+
 // EncodeToBitmap encodes QR Code into bitmap image.
 func (qr *QRCode) EncodeToBitmap() (*bitmap.Image, error) {
 	if !qr.Version.IsValid() {
@@ -707,7 +1328,12 @@ func (qr *QRCode) encodeSegments(buf *bitstream.Buffer) error {
 	l := buf.Len()
 	capacity := capacityTable[qr.Version][qr.Level]
 	if l > capacity.Data*8 {
-		return errors.New("qrcode: data is too large")
+		return &DataTooLargeError{
+			Version:   qr.Version,
+			Level:     qr.Level,
+			Required:  l,
+			Available: capacity.Data * 8,
+		}
 	}
 
 	// terminate pattern
@@ -732,6 +1358,9 @@ func (qr *QRCode) encodeSegments(buf *bitstream.Buffer) error {
 }
 
 func (s *Segment) encode(version Version, buf *bitstream.Buffer) error {
+	if err := s.validate(); err != nil {
+		return err
+	}
 	switch s.Mode {
 	case ModeNumeric:
 		return s.encodeNumber(version, buf)
@@ -741,11 +1370,68 @@ func (s *Segment) encode(version Version, buf *bitstream.Buffer) error {
 		return s.encodeBytes(version, buf)
 	case ModeKanji:
 		return s.encodeKanji(version, buf)
+	case ModeHanzi:
+		return s.encodeHanzi(version, buf)
+	case ModeECI:
+		return s.encodeECI(buf)
+	case ModeConnected:
+		return s.encodeConnected(buf)
+	case ModeFNC1_1:
+		return buf.WriteBitsLSB(uint64(ModeFNC1_1), 4)
+	case ModeFNC1_2:
+		return s.encodeFNC1Second(buf)
 	default:
 		return errors.New("qrcode: unknown mode")
 	}
 }
 
+// validate checks that the data of s can be encoded in the mode.
+func (s *Segment) validate() error {
+	switch s.Mode {
+	case ModeNumeric:
+		for i, ch := range s.Data {
+			if !bitstream.IsNumeric(ch) {
+				return &InvalidCharacterError{Mode: s.Mode, Offset: i}
+			}
+		}
+	case ModeAlphanumeric:
+		for i, ch := range s.Data {
+			if !bitstream.IsAlphanumeric(ch) {
+				return &InvalidCharacterError{Mode: s.Mode, Offset: i}
+			}
+		}
+	case ModeKanji:
+		for i, r := range string(s.Data) {
+			if !bitstream.IsKanji(r) {
+				return &InvalidCharacterError{Mode: s.Mode, Offset: i}
+			}
+		}
+	case ModeHanzi:
+		for i, r := range string(s.Data) {
+			if !bitstream.IsHanzi(r) {
+				return &InvalidCharacterError{Mode: s.Mode, Offset: i}
+			}
+		}
+	case ModeECI:
+		if !s.ECI.IsValid() {
+			return fmt.Errorf("qrcode: invalid ECI: %d", s.ECI)
+		}
+	case ModeConnected:
+		sa := s.StructuredAppend
+		if sa.Total < 1 || sa.Total > 16 || sa.Index < 0 || sa.Index >= sa.Total {
+			return fmt.Errorf("qrcode: invalid structured append: %d of %d", sa.Index, sa.Total)
+		}
+	case ModeFNC1_2:
+		if _, err := applicationIndicator(s.Data); err != nil {
+			return err
+		}
+	case ModeBytes, ModeFNC1_1:
+	default:
+		return fmt.Errorf("qrcode: unknown mode: %s", s.Mode)
+	}
+	return nil
+}
+
 // length returns the length of s in bits.
 func (s *Segment) length(version Version) int {
 	var n int = 4 // mode indicator
@@ -809,6 +1495,36 @@ func (s *Segment) length(version Version) int {
 		}
 		n += utf8.RuneCount(s.Data) * 13
 		return n
+	case ModeHanzi:
+		n += 4 // subset indicator
+		switch {
+		case version <= 0 || version > 40:
+			panic(fmt.Errorf("qrcode: invalid version: %d", version))
+		case version < 10:
+			n += 8
+		case version < 27:
+			n += 10
+		default:
+			n += 12
+		}
+		n += utf8.RuneCount(s.Data) * 13
+		return n
+	case ModeECI:
+		switch {
+		case s.ECI < 1<<7:
+			n += 8
+		case s.ECI < 1<<14:
+			n += 16
+		default:
+			n += 24
+		}
+		return n
+	case ModeConnected:
+		return n + 16
+	case ModeFNC1_1:
+		return n
+	case ModeFNC1_2:
+		return n + 8
 	default:
 		panic(errors.New("qrcode: unknown mode"))
 	}
@@ -923,3 +1639,102 @@ func (s *Segment) encodeKanji(version Version, buf *bitstream.Buffer) error {
 	// data
 	return bitstream.EncodeKanji(buf, data)
 }
+
+// hanziSubsetGB2312 is the subset indicator of GB2312 in the hanzi mode.
+const hanziSubsetGB2312 = 0b0001
+
+func (s *Segment) encodeHanzi(version Version, buf *bitstream.Buffer) error {
+	// validation
+	var n int
+	data := s.Data
+	switch {
+	case version <= 0 || version > 40:
+		return fmt.Errorf("qrcode: invalid version: %d", version)
+	case version < 10:
+		n = 8
+	case version < 27:
+		n = 10
+	default:
+		n = 12
+	}
+	count := utf8.RuneCount(data)
+	if count >= 1<<n {
+		return fmt.Errorf("qrcode: data is too long: %d", len(data))
+	}
+
+	// mode
+	buf.WriteBitsLSB(uint64(ModeHanzi), 4)
+
+	// subset
+	buf.WriteBitsLSB(hanziSubsetGB2312, 4)
+
+	// data length
+	buf.WriteBitsLSB(uint64(count), n)
+
+	// data
+	return bitstream.EncodeHanzi(buf, data)
+}
+
+func (s *Segment) encodeECI(buf *bitstream.Buffer) error {
+	// validation
+	if !s.ECI.IsValid() {
+		return fmt.Errorf("qrcode: invalid ECI assignment number: %d", s.ECI)
+	}
+
+	// mode
+	buf.WriteBitsLSB(uint64(ModeECI), 4)
+
+	// ECI designator
+	eci := uint64(s.ECI)
+	switch {
+	case eci < 1<<7:
+		buf.WriteBitsLSB(eci, 8)
+	case eci < 1<<14:
+		buf.WriteBitsLSB(0b10<<14|eci, 16)
+	default:
+		buf.WriteBitsLSB(0b110<<21|eci, 24)
+	}
+	return nil
+}
+
+func (s *Segment) encodeFNC1Second(buf *bitstream.Buffer) error {
+	// validation
+	indicator, err := applicationIndicator(s.Data)
+	if err != nil {
+		return err
+	}
+
+	// mode
+	buf.WriteBitsLSB(uint64(ModeFNC1_2), 4)
+
+	// application indicator
+	buf.WriteBitsLSB(indicator, 8)
+	return nil
+}
+
+// applicationIndicator returns the application indicator of ModeFNC1_2 segment.
+func applicationIndicator(data []byte) (uint64, error) {
+	switch {
+	case len(data) == 1 && ('a' <= data[0] && data[0] <= 'z' || 'A' <= data[0] && data[0] <= 'Z'):
+		return uint64(data[0]) + 100, nil
+	case len(data) == 2 && bitstream.IsNumeric(data[0]) && bitstream.IsNumeric(data[1]):
+		return uint64(data[0]-'0')*10 + uint64(data[1]-'0'), nil
+	default:
+		return 0, fmt.Errorf("qrcode: invalid application indicator: %q", data)
+	}
+}
+
+func (s *Segment) encodeConnected(buf *bitstream.Buffer) error {
+	sa := s.StructuredAppend
+
+	// mode
+	buf.WriteBitsLSB(uint64(ModeConnected), 4)
+
+	// symbol sequence indicator
+	buf.WriteBitsLSB(uint64(sa.Index), 4)
+	buf.WriteBitsLSB(uint64(sa.Total-1), 4)
+
+	// parity data
+	buf.WriteBitsLSB(uint64(sa.Parity), 8)
+	return nil
+}
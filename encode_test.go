@@ -2,8 +2,15 @@ package qrcode
 
 import (
 	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"io"
+	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/shogo82148/qrcode/gs1"
 	"github.com/shogo82148/qrcode/internal/bitstream"
 )
 
@@ -77,6 +84,421 @@ func TestNew3(t *testing.T) {
 	}
 }
 
+func TestNew_WithVersion(t *testing.T) {
+	qr, err := New([]byte("01234567"), WithLevel(LevelH), WithVersion(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qr.Version != 5 {
+		t.Errorf("unexpected version: got %v, want %v", qr.Version, 5)
+	}
+
+	// version 1-H can hold only 17 digits.
+	_, err = New([]byte("012345678901234567"), WithLevel(LevelH), WithVersion(1))
+	if err == nil {
+		t.Error("want error, but not")
+	}
+
+	_, err = New([]byte("01234567"), WithVersion(41))
+	if err == nil {
+		t.Error("want error, but not")
+	}
+}
+
+func TestNew_WithMinVersion(t *testing.T) {
+	qr, err := New([]byte("01234567"), WithLevel(LevelH), WithMinVersion(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qr.Version != 3 {
+		t.Errorf("unexpected version: got %v, want %v", qr.Version, 3)
+	}
+
+	// the data needs version 10, which is larger than the minimum.
+	qr, err = New(
+		[]byte("VERSION 10 QR CODE, UP TO 174 CHAR AT H LEVEL, WITH 57X57 MODULES AND PLENTY OF ERROR CORRECTION TO GO AROUND. NOTE THAT THERE ARE ADDITIONAL TRACKING BOXES"),
+		WithLevel(LevelH),
+		WithKanji(false),
+		WithMinVersion(3),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qr.Version != 10 {
+		t.Errorf("unexpected version: got %v, want %v", qr.Version, 10)
+	}
+}
+
+func TestNew_ReoptimizeForVersion(t *testing.T) {
+	// the numeric mode pays off in versions 1-9, but not in versions 27-40.
+	data := []byte("ABC123456789012345DEF")
+	want := []Segment{
+		{Mode: ModeAlphanumeric, Data: []byte("ABC123456789012345DEF")},
+	}
+
+	qr, err := New(data, WithLevel(LevelH), WithVersion(27))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(qr.Segments, want) {
+		t.Errorf("WithVersion: got %v, want %v", qr.Segments, want)
+	}
+
+	qr, err = New(data, WithLevel(LevelH), WithMinVersion(30))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qr.Version != 30 {
+		t.Errorf("WithMinVersion: unexpected version: got %d, want %d", qr.Version, 30)
+	}
+	if !reflect.DeepEqual(qr.Segments, want) {
+		t.Errorf("WithMinVersion: got %v, want %v", qr.Segments, want)
+	}
+}
+
+func TestNew_WithMask(t *testing.T) {
+	qr, err := New([]byte("01234567"), WithLevel(LevelH), WithMask(Mask5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qr.Mask != Mask5 {
+		t.Errorf("unexpected mask: got %v, want %v", qr.Mask, Mask5)
+	}
+
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := DecodeBitmap(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Mask != Mask5 {
+		t.Errorf("unexpected mask: got %v, want %v", got.Mask, Mask5)
+	}
+
+	_, err = New([]byte("01234567"), WithMask(8))
+	if err == nil {
+		t.Error("want error, but not")
+	}
+}
+
+func TestNew_WithBoostLevel(t *testing.T) {
+	qr, err := New([]byte("01234567"), WithLevel(LevelL), WithBoostLevel(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qr.Version != 1 {
+		t.Errorf("unexpected version: got %v, want %v", qr.Version, 1)
+	}
+	if qr.Level != LevelH {
+		t.Errorf("unexpected level: got %v, want %v", qr.Level, LevelH)
+	}
+
+	// 1-Q can't hold 28 digits.
+	qr, err = New([]byte("0123456789012345678901234567"), WithLevel(LevelL), WithBoostLevel(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qr.Version != 1 {
+		t.Errorf("unexpected version: got %v, want %v", qr.Version, 1)
+	}
+	if qr.Level != LevelM {
+		t.Errorf("unexpected level: got %v, want %v", qr.Level, LevelM)
+	}
+}
+
+func TestNew_WithUTF8ECI(t *testing.T) {
+	qr, err := New([]byte("café"), WithUTF8ECI(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(qr.Segments) == 0 || qr.Segments[0].Mode != ModeECI || qr.Segments[0].ECI != ECIUTF8 {
+		t.Fatalf("want ECI 26 at the head: %v", qr.Segments)
+	}
+
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := DecodeBitmap(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	text, err := got.Text()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text != "café" {
+		t.Errorf("unexpected text: got %q, want %q", text, "café")
+	}
+
+	tests := []struct {
+		data []byte
+		opts []EncodeOptions
+	}{
+		// ASCII only
+		{[]byte("cafe"), []EncodeOptions{WithUTF8ECI(true)}},
+		// the kanji mode doesn't need ECI.
+		{[]byte("点"), []EncodeOptions{WithUTF8ECI(true)}},
+		// not UTF-8
+		{[]byte("caf\xe9"), []EncodeOptions{WithUTF8ECI(true)}},
+		// disabled
+		{[]byte("café"), nil},
+	}
+	for _, tt := range tests {
+		qr, err := New(tt.data, tt.opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range qr.Segments {
+			if s.Mode == ModeECI {
+				t.Errorf("%q: unexpected ECI segment", tt.data)
+			}
+		}
+	}
+}
+
+func TestNewGS1(t *testing.T) {
+	elements := []gs1.Element{
+		{AI: "01", Value: "09501101530003"},
+		{AI: "10", Value: "AB-123"},
+		{AI: "17", Value: "250101"},
+		{AI: "21", Value: "A%B"},
+	}
+	qr, err := NewGS1(elements)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qr.Segments[0].Mode != ModeFNC1_1 {
+		t.Errorf("unexpected mode: got %v, want %v", qr.Segments[0].Mode, ModeFNC1_1)
+	}
+
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := DecodeBitmap(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := decoded.GS1()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, elements) {
+		t.Errorf("got %v, want %v", got, elements)
+	}
+
+	if _, err := NewGS1([]gs1.Element{{AI: "01", Value: "123"}}); err == nil {
+		t.Error("want error, but not")
+	}
+}
+
+func TestNewStructuredAppend(t *testing.T) {
+	// version 40-L can hold only 2953 bytes.
+	data := bytes.Repeat([]byte("structured append "), 200)
+	symbols, err := NewStructuredAppend(data, WithLevel(LevelL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(symbols) != 2 {
+		t.Fatalf("unexpected number of symbols: got %d, want %d", len(symbols), 2)
+	}
+	var parity byte
+	for _, b := range data {
+		parity ^= b
+	}
+	for i, qr := range symbols {
+		want := StructuredAppend{Index: i, Total: 2, Parity: parity}
+		if qr.Segments[0].Mode != ModeConnected || qr.Segments[0].StructuredAppend != want {
+			t.Errorf("unexpected header: got %v, want %v", qr.Segments[0], want)
+		}
+	}
+
+	// version 1-H can hold 3 kanji with the header.
+	symbols, err = NewStructuredAppend([]byte("点点点点点点点点点点点点点"), WithVersion(1), WithLevel(LevelH))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(symbols) != 5 {
+		t.Errorf("unexpected number of symbols: got %d, want %d", len(symbols), 5)
+	}
+
+	if _, err := NewStructuredAppend(data, WithVersion(1)); err == nil {
+		t.Error("want error, but not")
+	}
+	// the double-byte characters of Shift_JIS are not split.
+	for _, chunk := range splitData(bytes.Repeat([]byte("\x93\x5f"), 5), 4, true) {
+		if len(chunk)%2 != 0 {
+			t.Errorf("unexpected chunk: %x", chunk)
+		}
+	}
+
+	// a single symbol doesn't need the header.
+	symbols, err = NewStructuredAppend([]byte("hi"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(symbols) != 1 {
+		t.Fatalf("unexpected number of symbols: got %d, want %d", len(symbols), 1)
+	}
+	for _, seg := range symbols[0].Segments {
+		if seg.Mode == ModeConnected {
+			t.Errorf("unexpected header: %v", seg)
+		}
+	}
+}
+
+func TestNew_WithShiftJIS(t *testing.T) {
+	data := []byte("\x88\x9f\x88\x9f") // "亜亜" in Shift_JIS
+	qr, err := New(data, WithLevel(LevelL), WithKanji(true), WithShiftJIS(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(qr.Segments) != 1 {
+		t.Fatalf("unexpected the length of segment: got %d, want %d", len(qr.Segments), 1)
+	}
+	if qr.Segments[0].Mode != ModeKanji {
+		t.Errorf("got %v, want %v", qr.Segments[0].Mode, ModeKanji)
+	}
+	if !bytes.Equal(qr.Segments[0].Data, []byte("亜亜")) {
+		t.Errorf("got %q, want %q", qr.Segments[0].Data, "亜亜")
+	}
+
+	got, err := qr.ShiftJIS()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("got %x, want %x", got, data)
+	}
+}
+
+func TestNew_WithHanzi(t *testing.T) {
+	qr, err := New([]byte("点啊123"), WithLevel(LevelH), WithHanzi(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Segment{
+		{Mode: ModeHanzi, Data: []byte("点啊")},
+		{Mode: ModeNumeric, Data: []byte("123")},
+	}
+	if !reflect.DeepEqual(qr.Segments, want) {
+		t.Errorf("got %v, want %v", qr.Segments, want)
+	}
+
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := DecodeBitmap(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.Segments, want) {
+		t.Errorf("got %v, want %v", got.Segments, want)
+	}
+	text, err := got.Text()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text != "点啊123" {
+		t.Errorf("got %q, want %q", text, "点啊123")
+	}
+}
+
+func TestSegmentConstructors(t *testing.T) {
+	if _, err := NumericSegment([]byte("0123")); err != nil {
+		t.Error(err)
+	}
+	if _, err := NumericSegment([]byte("012A")); err == nil {
+		t.Error("want error, but not")
+	}
+	if _, err := AlphanumericSegment([]byte("AC-42")); err != nil {
+		t.Error(err)
+	}
+	if _, err := AlphanumericSegment([]byte("ac-42")); err == nil {
+		t.Error("want error, but not")
+	}
+	if _, err := KanjiSegment([]byte("点茗")); err != nil {
+		t.Error(err)
+	}
+	if _, err := KanjiSegment([]byte("点A")); err == nil {
+		t.Error("want error, but not")
+	}
+	if _, err := HanziSegment([]byte("点啊")); err != nil {
+		t.Error(err)
+	}
+	if _, err := HanziSegment([]byte("込")); err == nil {
+		t.Error("want error, but not")
+	}
+	if _, err := ECISegment(ECIUTF8); err != nil {
+		t.Error(err)
+	}
+	if _, err := ECISegment(1000000); err == nil {
+		t.Error("want error, but not")
+	}
+}
+
+func TestQRCode_Validate(t *testing.T) {
+	numeric, err := NumericSegment([]byte("01234567"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	qr := &QRCode{
+		Version:  1,
+		Level:    LevelH,
+		Mask:     MaskAuto,
+		Segments: []Segment{numeric},
+	}
+	if err := qr.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := []*QRCode{
+		{Version: 0, Level: LevelH, Mask: MaskAuto, Segments: []Segment{numeric}},
+		{Version: 1, Level: 4, Mask: MaskAuto, Segments: []Segment{numeric}},
+		{Version: 1, Level: LevelH, Mask: 8, Segments: []Segment{numeric}},
+		{Version: 1, Level: LevelH, Mask: MaskAuto, Segments: []Segment{{Mode: ModeNumeric, Data: []byte("A")}}},
+		{Version: 1, Level: LevelH, Mask: MaskAuto, Segments: []Segment{{Mode: 0b1111}}},
+		{Version: 1, Level: LevelH, Mask: MaskAuto, Segments: []Segment{BytesSegment(make([]byte, 10))}},
+	}
+	for i, tt := range tests {
+		if err := tt.Validate(); err == nil {
+			t.Errorf("%d: want error, but not", i)
+		}
+	}
+}
+
+func TestOptimize(t *testing.T) {
+	// the numeric mode pays off for 15 digits in versions 1-9,
+	// but not in versions 27-40, where the character count indicators are longer.
+	segments, bits, err := Optimize([]byte("ABC123456789012345DEF"), LevelH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Segment{
+		{Mode: ModeAlphanumeric, Data: []byte("ABC")},
+		{Mode: ModeNumeric, Data: []byte("123456789012345")},
+		{Mode: ModeAlphanumeric, Data: []byte("DEF")},
+	}
+	if !reflect.DeepEqual(segments, want) {
+		t.Errorf("got %v, want %v", segments, want)
+	}
+	if bits != 124 {
+		t.Errorf("got %d, want %d", bits, 124)
+	}
+
+	segments, _ = optimizeKanji([]byte("ABC123456789012345DEF"), versionClasses[2], ModeKanji, false, false)
+	want = []Segment{
+		{Mode: ModeAlphanumeric, Data: []byte("ABC123456789012345DEF")},
+	}
+	if !reflect.DeepEqual(segments, want) {
+		t.Errorf("got %v, want %v", segments, want)
+	}
+}
+
 func TestNewFromKanji1(t *testing.T) {
 	qr, err := New([]byte("点茗"), WithLevel(LevelH), WithKanji(true))
 	if err != nil {
@@ -949,3 +1371,140 @@ func BenchmarkEncode(b *testing.B) {
 		}
 	}
 }
+
+func TestSegment_encodeECI(t *testing.T) {
+	tests := []struct {
+		eci  ECI
+		want []byte
+	}{
+		{ECIUTF8, []byte{0b0111_0001, 0b1010_0000}},
+		{1000, []byte{0b0111_1000, 0b0011_1110, 0b1000_0000}},
+		{999999, []byte{0b0111_1100, 0b1111_0100, 0b0010_0011, 0b1111_0000}},
+	}
+	for _, tt := range tests {
+		s := &Segment{
+			Mode: ModeECI,
+			ECI:  tt.eci,
+		}
+		var buf bitstream.Buffer
+		if err := s.encode(1, &buf); err != nil {
+			t.Fatal(err)
+		}
+		got := buf.Bytes()
+		if !bytes.Equal(got, tt.want) {
+			t.Errorf("%d: got %08b, want %08b", tt.eci, got, tt.want)
+		}
+		if l := s.length(1); l != buf.Len() {
+			t.Errorf("%d: unexpected length: got %d, want %d", tt.eci, l, buf.Len())
+		}
+	}
+
+	s := &Segment{
+		Mode: ModeECI,
+		ECI:  1000000,
+	}
+	var buf bitstream.Buffer
+	if err := s.encode(1, &buf); err == nil {
+		t.Error("want error, but not")
+	}
+}
+
+func TestEncodeSVG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := EncodeSVG(&buf, []byte("HELLO"), WithModuleSize(2)); err != nil {
+		t.Fatal(err)
+	}
+
+	// version 1 has 21 modules, and the quiet zone is 4 modules.
+	// the outline of the top-left finder pattern comes first.
+	want := `<svg xmlns="http://www.w3.org/2000/svg" version="1.1" viewBox="0 0 29 29" width="58" height="58" shape-rendering="crispEdges">
+<rect width="29" height="29" fill="#ffffff"/>
+<path d="M4 4H11V11H4Z`
+	if !strings.HasPrefix(buf.String(), want) {
+		t.Errorf("unexpected svg: %s", buf.String())
+	}
+}
+
+func TestQRCode_Encode_WithColors(t *testing.T) {
+	qr, err := New([]byte("HELLO"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	darkBlue := color.NRGBA{0x00, 0x20, 0x60, 0xff}
+	img, err := qr.Encode(WithForeground(darkBlue), WithBackground(color.Transparent))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the quiet zone is 4 modules, and the top-left finder pattern starts at (4, 4) with a light ring inside.
+	if _, _, _, a := img.At(3, 4).RGBA(); a != 0 {
+		t.Errorf("the quiet zone must be transparent: got %v", img.At(3, 4))
+	}
+	if got := color.NRGBAModel.Convert(img.At(4, 4)); got != darkBlue {
+		t.Errorf("unexpected foreground: got %v, want %v", got, darkBlue)
+	}
+	if _, _, _, a := img.At(5, 5).RGBA(); a != 0 {
+		t.Errorf("the light modules must be transparent: got %v", img.At(5, 5))
+	}
+
+	_, err = qr.Encode(WithForeground(color.Gray{0xe0}), WithBackground(color.White))
+	if !errors.Is(err, ErrLowContrast) {
+		t.Errorf("want ErrLowContrast, got %v", err)
+	}
+	err = qr.EncodeSVG(io.Discard, WithForeground(color.Gray{0xe0}), WithBackground(color.White))
+	if !errors.Is(err, ErrLowContrast) {
+		t.Errorf("want ErrLowContrast, got %v", err)
+	}
+}
+
+func TestQRCode_Encode_WithImageSize(t *testing.T) {
+	qr, err := New([]byte("HELLO"), WithVersion(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dark := func(img image.Image, x, y int) bool {
+		return color.GrayModel.Convert(img.At(x, y)).(color.Gray).Y < 0x80
+	}
+
+	// version 1 has 21 modules, and 29 modules with the quiet zone of 4 modules.
+	// With the integer scale, a module is 10 pixels, and the leftover 10 pixels are split into both sides.
+	tests := []struct {
+		integer bool
+		x, y    int
+	}{
+		{false, 42, 42},
+		{true, 45, 45},
+	}
+	for _, tt := range tests {
+		img, err := qr.Encode(WithImageSize(300), WithIntegerScale(tt.integer))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if img.Bounds().Dx() != 300 || img.Bounds().Dy() != 300 {
+			t.Errorf("unexpected size: %v", img.Bounds())
+		}
+		if !dark(img, tt.x, tt.y) || dark(img, tt.x-1, tt.y) || dark(img, tt.x, tt.y-1) {
+			t.Errorf("integer scale %v: the finder pattern doesn't start at (%d, %d)", tt.integer, tt.x, tt.y)
+		}
+
+		got, err := Decode(img)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got.Segments[0].Data, []byte("HELLO")) {
+			t.Errorf("unexpected data: %q", got.Segments[0].Data)
+		}
+	}
+
+	// the image must have a pixel per module at least.
+	for i, opt := range []EncodeOptions{
+		WithImageSize(28),
+	} {
+		if _, err := qr.Encode(opt); err == nil {
+			t.Errorf("%d: want error, but not", i)
+		}
+	}
+	if _, err := qr.Encode(WithImageSize(29)); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,139 @@
+package qrcode
+
+import (
+	"errors"
+	"image"
+	"image/draw"
+	"testing"
+
+	"github.com/shogo82148/qrcode/bitmap"
+	"github.com/shogo82148/qrcode/internal/bitstream"
+)
+
+func TestDataTooLargeError(t *testing.T) {
+	_, err := New(make([]byte, 3000), WithLevel(LevelH))
+	if !errors.Is(err, ErrDataTooLarge) {
+		t.Fatalf("want ErrDataTooLarge, got %v", err)
+	}
+	var e *DataTooLargeError
+	if !errors.As(err, &e) {
+		t.Fatalf("want *DataTooLargeError, got %T", err)
+	}
+	if e.Version != 40 || e.Level != LevelH {
+		t.Errorf("unexpected symbol: got %d-%s, want 40-H", e.Version, e.Level)
+	}
+	if e.Available != 1276*8 {
+		t.Errorf("unexpected available bits: got %d, want %d", e.Available, 1276*8)
+	}
+	if e.Required != 4+16+3000*8 {
+		t.Errorf("unexpected required bits: got %d, want %d", e.Required, 4+16+3000*8)
+	}
+
+	_, err = New([]byte("012345678901234567890123456789"), WithLevel(LevelH), WithVersion(1))
+	if !errors.As(err, &e) {
+		t.Fatalf("want *DataTooLargeError, got %T", err)
+	}
+	if e.Version != 1 || e.Required != 4+10+100 || e.Available != 9*8 {
+		t.Errorf("unexpected error: %v", e)
+	}
+}
+
+func TestInvalidCharacterError(t *testing.T) {
+	_, err := NumericSegment([]byte("012A"))
+	if !errors.Is(err, ErrInvalidCharacter) {
+		t.Fatalf("want ErrInvalidCharacter, got %v", err)
+	}
+	var e *InvalidCharacterError
+	if !errors.As(err, &e) {
+		t.Fatalf("want *InvalidCharacterError, got %T", err)
+	}
+	if e.Mode != ModeNumeric || e.Offset != 3 {
+		t.Errorf("unexpected error: %v", e)
+	}
+
+	// the segments are validated before encoding.
+	qr := &QRCode{
+		Version:  1,
+		Level:    LevelH,
+		Mask:     MaskAuto,
+		Segments: []Segment{{Mode: ModeKanji, Data: []byte("点A")}},
+	}
+	_, err = qr.EncodeToBitmap()
+	if !errors.As(err, &e) {
+		t.Fatalf("want *InvalidCharacterError, got %T", err)
+	}
+	if e.Mode != ModeKanji || e.Offset != 3 {
+		t.Errorf("unexpected error: %v", e)
+	}
+}
+
+func TestDecodeBitmap_Errors(t *testing.T) {
+	qr, err := New([]byte("HELLO WORLD"), WithLevel(LevelL), WithKanji(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// clear both copies of the format information.
+	binimg, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := binimg.Bounds().Dx() - 1
+	for i := 0; i < 9; i++ {
+		binimg.SetBinary(8, i, bitmap.White)
+		binimg.SetBinary(i, 8, bitmap.White)
+		binimg.SetBinary(8, w-i, bitmap.White)
+		binimg.SetBinary(w-i, 8, bitmap.White)
+	}
+	_, err = DecodeBitmap(binimg)
+	if !errors.Is(err, ErrFormatNotFound) {
+		t.Errorf("want ErrFormatNotFound, got %v", err)
+	}
+
+	// scratch the symbol beyond the error correction capability.
+	binimg, err = qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for y := 9; y <= w; y++ {
+		for x := 9; x <= w; x++ {
+			binimg.SetBinary(x, y, !binimg.BinaryAt(x, y))
+		}
+	}
+	_, err = DecodeBitmap(binimg)
+	if !errors.Is(err, ErrTooManyErrors) {
+		t.Fatalf("want ErrTooManyErrors, got %v", err)
+	}
+	var e *TooManyErrorsError
+	if !errors.As(err, &e) {
+		t.Fatalf("want *TooManyErrorsError, got %T", err)
+	}
+	if e.Block != 0 {
+		t.Errorf("unexpected block: got %d, want %d", e.Block, 0)
+	}
+}
+
+func TestDecode_NotFound(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 100, 100))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+	_, err := Decode(img)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("want ErrNotFound, got %v", err)
+	}
+}
+
+func TestInvalidModeError(t *testing.T) {
+	for _, mode := range []Mode{6, 10, 11, 12, 14, 15} {
+		_, err := decodeSegments(1, bitstream.NewBuffer([]byte{byte(mode) << 4, 0x00}))
+		if !errors.Is(err, ErrInvalidMode) {
+			t.Fatalf("mode %d: want ErrInvalidMode, got %v", mode, err)
+		}
+		var e *InvalidModeError
+		if !errors.As(err, &e) {
+			t.Fatalf("mode %d: want *InvalidModeError, got %T", mode, err)
+		}
+		if e.Mode != mode {
+			t.Errorf("unexpected mode: got %d, want %d", e.Mode, mode)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+// Package gs1 handles GS1 element strings encoded in GS1 QR codes.
+package gs1
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// GS is the group separator that terminates variable length fields.
+// It is the FNC1 character in the symbol.
+const GS = '\x1d'
+
+// Element is a pair of an application identifier (AI) and its data.
+type Element struct {
+	AI    string
+	Value string
+}
+
+// String returns the human readable interpretation of the element, such as "(01)09501101530003".
+func (e Element) String() string {
+	return "(" + e.AI + ")" + e.Value
+}
+
+// aiLength is the number of digits of AIs indexed by their first two digits.
+// 0 means that the AI is unknown.
+var aiLength = [100]int{
+	0: 2, 1: 2, 2: 2, 3: 2, 4: 2,
+	10: 2, 11: 2, 12: 2, 13: 2, 14: 2, 15: 2, 16: 2, 17: 2, 18: 2, 19: 2,
+	20: 2, 21: 2, 22: 2, 23: 3, 24: 3, 25: 3,
+	30: 2, 31: 4, 32: 4, 33: 4, 34: 4, 35: 4, 36: 4, 37: 2, 39: 4,
+	40: 3, 41: 3, 42: 3, 43: 4,
+	70: 4, 71: 3, 72: 4,
+	80: 4, 81: 4, 82: 4,
+	90: 2, 91: 2, 92: 2, 93: 2, 94: 2, 95: 2, 96: 2, 97: 2, 98: 2, 99: 2,
+}
+
+// fixedLength is the length of the AI and the data of the predefined length fields
+// indexed by the first two digits of the AI.
+// These fields don't need the separator.
+var fixedLength = [100]int{
+	0: 20, 1: 16, 2: 16, 3: 16, 4: 18,
+	11: 8, 12: 8, 13: 8, 14: 8, 15: 8, 16: 8, 17: 8, 18: 8, 19: 8,
+	20: 4,
+	31: 10, 32: 10, 33: 10, 34: 10, 35: 10, 36: 10,
+	41: 16,
+}
+
+func prefix(ai string) (int, bool) {
+	if len(ai) < 2 || !isDigit(ai[0]) || !isDigit(ai[1]) {
+		return 0, false
+	}
+	return int(ai[0]-'0')*10 + int(ai[1]-'0'), true
+}
+
+func isDigit(ch byte) bool {
+	return '0' <= ch && ch <= '9'
+}
+
+func (e Element) validate() error {
+	p, ok := prefix(e.AI)
+	if !ok || aiLength[p] == 0 || len(e.AI) != aiLength[p] {
+		return fmt.Errorf("gs1: unknown AI: %q", e.AI)
+	}
+	for i := 0; i < len(e.AI); i++ {
+		if !isDigit(e.AI[i]) {
+			return fmt.Errorf("gs1: unknown AI: %q", e.AI)
+		}
+	}
+	if l := fixedLength[p]; l != 0 && len(e.AI)+len(e.Value) != l {
+		return fmt.Errorf("gs1: invalid length of AI (%s): %d", e.AI, len(e.Value))
+	}
+	if e.Value == "" {
+		return fmt.Errorf("gs1: empty data of AI (%s)", e.AI)
+	}
+	if strings.IndexByte(e.Value, GS) >= 0 {
+		return fmt.Errorf("gs1: data of AI (%s) includes the separator", e.AI)
+	}
+	return nil
+}
+
+// Format concatenates the elements into an element string.
+// Variable length fields are terminated by GS unless they are at the end.
+func Format(elements []Element) (string, error) {
+	var buf strings.Builder
+	for i, e := range elements {
+		if err := e.validate(); err != nil {
+			return "", err
+		}
+		buf.WriteString(e.AI)
+		buf.WriteString(e.Value)
+		p, _ := prefix(e.AI)
+		if fixedLength[p] == 0 && i != len(elements)-1 {
+			buf.WriteByte(GS)
+		}
+	}
+	return buf.String(), nil
+}
+
+// Parse parses the element string.
+func Parse(s string) ([]Element, error) {
+	var elements []Element
+	for len(s) > 0 {
+		p, ok := prefix(s)
+		if !ok || aiLength[p] == 0 || len(s) < aiLength[p] {
+			return nil, fmt.Errorf("gs1: unknown AI: %q", s)
+		}
+		n := aiLength[p]
+		ai := s[:n]
+
+		var value string
+		if l := fixedLength[p]; l != 0 {
+			if len(s) < l {
+				return nil, fmt.Errorf("gs1: invalid length of AI (%s): %d", ai, len(s)-n)
+			}
+			value, s = s[n:l], s[l:]
+			// some encoders put the separator after the predefined length fields.
+			s = strings.TrimPrefix(s, string(GS))
+		} else if i := strings.IndexByte(s, GS); i >= 0 {
+			value, s = s[n:i], s[i+1:]
+		} else {
+			value, s = s[n:], ""
+		}
+
+		e := Element{AI: ai, Value: value}
+		if err := e.validate(); err != nil {
+			return nil, err
+		}
+		elements = append(elements, e)
+	}
+	if len(elements) == 0 {
+		return nil, errors.New("gs1: empty element string")
+	}
+	return elements, nil
+}
+
+// ParseHRI parses the human readable interpretation, such as "(01)09501101530003(10)ABC123".
+func ParseHRI(s string) ([]Element, error) {
+	var elements []Element
+	for len(s) > 0 {
+		if s[0] != '(' {
+			return nil, fmt.Errorf("gs1: want '(', but got %q", s)
+		}
+		end := strings.IndexByte(s, ')')
+		if end < 0 {
+			return nil, errors.New("gs1: unclosed parenthesis")
+		}
+		ai := s[1:end]
+		s = s[end+1:]
+
+		next := strings.IndexByte(s, '(')
+		if next < 0 {
+			next = len(s)
+		}
+		e := Element{AI: ai, Value: s[:next]}
+		if err := e.validate(); err != nil {
+			return nil, err
+		}
+		elements = append(elements, e)
+		s = s[next:]
+	}
+	if len(elements) == 0 {
+		return nil, errors.New("gs1: empty element string")
+	}
+	return elements, nil
+}
@@ -0,0 +1,75 @@
+package gs1
+
+import (
+	"reflect"
+	"testing"
+)
+
+var testElements = []Element{
+	{AI: "01", Value: "09501101530003"},
+	{AI: "10", Value: "AB-123"},
+	{AI: "17", Value: "250101"},
+	{AI: "3103", Value: "000189"},
+	{AI: "21", Value: "12345"},
+}
+
+const testElementString = "0109501101530003" + "10AB-123\x1d" + "17250101" + "3103000189" + "2112345"
+
+func TestFormat(t *testing.T) {
+	got, err := Format(testElements)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != testElementString {
+		t.Errorf("got %q, want %q", got, testElementString)
+	}
+}
+
+func TestFormat_Invalid(t *testing.T) {
+	tests := [][]Element{
+		{{AI: "01", Value: "0950110153000"}}, // too short
+		{{AI: "05", Value: "123"}},           // unknown AI
+		{{AI: "310", Value: "000189"}},       // AI is too short
+		{{AI: "10", Value: ""}},
+		{{AI: "10", Value: "A\x1dB"}},
+	}
+	for _, tt := range tests {
+		if _, err := Format(tt); err == nil {
+			t.Errorf("%v: want error, but not", tt)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	got, err := Parse(testElementString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, testElements) {
+		t.Errorf("got %v, want %v", got, testElements)
+	}
+
+	// the separator after a predefined length field is allowed.
+	got, err = Parse("0109501101530003\x1d10AB")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Element{{AI: "01", Value: "09501101530003"}, {AI: "10", Value: "AB"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestParseHRI(t *testing.T) {
+	got, err := ParseHRI("(01)09501101530003(10)AB-123(17)250101(3103)000189(21)12345")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, testElements) {
+		t.Errorf("got %v, want %v", got, testElements)
+	}
+
+	if _, err := ParseHRI("01)09501101530003"); err == nil {
+		t.Error("want error, but not")
+	}
+}
@@ -104,3 +104,28 @@ func DecodeKanji(buf *Buffer, length int) ([]byte, error) {
 	}
 	return ret.Bytes(), nil
 }
+
+// KanjiRune returns the character of the 13-bit code in Kanji mode.
+func KanjiRune(code uint64) (rune, bool) {
+	if code >= uint64(len(decode)) || decode[code] == 0 {
+		return 0, false
+	}
+	return rune(decode[code]), true
+}
+
+// DecodeHanzi decodes length characters in the hanzi mode of GB/T 18284.
+func DecodeHanzi(buf *Buffer, length int) ([]byte, error) {
+	var ret bytes.Buffer
+	ret.Grow(length * 3)
+	for i := 0; i < length; i++ {
+		bits, err := buf.ReadBits(13)
+		if err != nil {
+			return nil, err
+		}
+		if bits >= uint64(len(hanziDecode)) || hanziDecode[bits] == 0 {
+			return nil, fmt.Errorf("bitstream: invalid hanzi code: %d", bits)
+		}
+		ret.WriteRune(rune(hanziDecode[bits]))
+	}
+	return ret.Bytes(), nil
+}
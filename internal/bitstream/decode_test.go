@@ -165,3 +165,38 @@ func TestDecodeKanji_Error(t *testing.T) {
 		}
 	}
 }
+
+func TestDecodeHanzi(t *testing.T) {
+	tests := []struct {
+		in    []byte
+		count int
+		want  []byte
+	}{
+		{
+			in:    []byte{0b00101111, 0b00010000},
+			count: 1,
+			want:  []byte("点"),
+		},
+		{
+			in:    []byte{0b00011110, 0b00000000},
+			count: 1,
+			want:  []byte("啊"),
+		},
+	}
+
+	for i, tt := range tests {
+		buf := NewBuffer(tt.in)
+		got, err := DecodeHanzi(buf, tt.count)
+		if err != nil {
+			t.Errorf("%d: error %v", i, err)
+			continue
+		}
+		if !bytes.Equal(tt.want, got) {
+			t.Errorf("%d: got %q, want %q", i, got, tt.want)
+		}
+	}
+
+	if _, err := DecodeHanzi(NewBuffer([]byte{0b11111111, 0b11111000}), 1); err == nil {
+		t.Error("want error, but not")
+	}
+}
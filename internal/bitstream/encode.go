@@ -2,6 +2,7 @@ package bitstream
 
 import (
 	"fmt"
+	"unicode/utf8"
 )
 
 func IsNumeric(ch byte) bool {
@@ -17,6 +18,26 @@ func IsKanji(ch rune) bool {
 	return ok
 }
 
+// IsHanzi reports whether ch can be encoded in the hanzi mode, which is GB2312.
+func IsHanzi(ch rune) bool {
+	_, ok := encodeHanzi(ch)
+	return ok
+}
+
+// HanziSizes returns the sizes of the characters that can be encoded in the hanzi mode, like the sizes of KanjiRunes.
+// data is UTF-8.
+func HanziSizes(data []byte) []int {
+	sizes := make([]int, len(data))
+	for i := 0; i < len(data); {
+		r, size := utf8.DecodeRune(data[i:])
+		if IsHanzi(r) {
+			sizes[i] = size
+		}
+		i += size
+	}
+	return sizes
+}
+
 func EncodeNumeric(buf *Buffer, data []byte) error {
 	// validate
 	for _, ch := range data {
@@ -123,3 +144,37 @@ func encodeKanji(r rune) (uint64, bool) {
 	}
 	return uint64(code), true
 }
+
+// EncodeHanzi encodes data in the hanzi mode of GB/T 18284.
+func EncodeHanzi(buf *Buffer, data []byte) error {
+	for _, r := range string(data) {
+		code, ok := encodeHanzi(r)
+		if !ok {
+			return fmt.Errorf("qrcode: invalid character in hanzi mode: %x", r)
+		}
+		buf.WriteBitsLSB(code, 13)
+	}
+	return nil
+}
+
+func encodeHanzi(r rune) (uint64, bool) {
+	var code int16
+	switch {
+	case hanziEncode0Low <= r && r <= hanziEncode0High:
+		code = hanziEncode0[r-hanziEncode0Low]
+	case hanziEncode1Low <= r && r <= hanziEncode1High:
+		code = hanziEncode1[r-hanziEncode1Low]
+	case hanziEncode2Low <= r && r <= hanziEncode2High:
+		code = hanziEncode2[r-hanziEncode2Low]
+	case hanziEncode3Low <= r && r <= hanziEncode3High:
+		code = hanziEncode3[r-hanziEncode3Low]
+	case hanziEncode4Low <= r && r <= hanziEncode4High:
+		code = hanziEncode4[r-hanziEncode4Low]
+	default:
+		return 0, false
+	}
+	if code < 0 {
+		return 0, false
+	}
+	return uint64(code), true
+}
@@ -163,3 +163,37 @@ func TestEncodeKanji_Error(t *testing.T) {
 		}
 	}
 }
+
+func TestEncodeHanzi(t *testing.T) {
+	tests := []struct {
+		in   []byte
+		want []byte
+	}{
+		{
+			in:   []byte("点"),
+			want: []byte{0b00101111, 0b00010000},
+		},
+		{
+			in:   []byte("啊"),
+			want: []byte{0b00011110, 0b00000000},
+		},
+	}
+
+	for i, tt := range tests {
+		var buf Buffer
+		if err := EncodeHanzi(&buf, tt.in); err != nil {
+			t.Errorf("%d: %v", i, err)
+			continue
+		}
+		got := buf.Bytes()
+		if !bytes.Equal(tt.want, got) {
+			t.Errorf("%d: got %08b, want %08b", i, got, tt.want)
+		}
+	}
+
+	// "込" is not in GB2312.
+	var buf Buffer
+	if err := EncodeHanzi(&buf, []byte("込")); err == nil {
+		t.Error("want error, but not")
+	}
+}
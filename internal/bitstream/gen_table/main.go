@@ -14,12 +14,26 @@ import (
 )
 
 func main() {
-	decodeJIS0208, err := loadJIS0208()
+	decodeJIS0208, err := loadIndex("index-jis0208.txt")
 	if err != nil {
 		log.Fatal(err)
 	}
 	encodeJIS0208 := reverse(decodeJIS0208)
+	if err := generate("table_gen.go", "encode", "decode", createEncodeTable(encodeJIS0208, encodeQR), createDecodeTable(decodeJIS0208, encodeQR)); err != nil {
+		log.Fatal(err)
+	}
 
+	decodeGB2312, err := loadIndex("index-gb2312.txt")
+	if err != nil {
+		log.Fatal(err)
+	}
+	encodeGB2312 := reverse(decodeGB2312)
+	if err := generate("table_hanzi_gen.go", "hanziEncode", "hanziDecode", createEncodeTable(encodeGB2312, encodeHanzi), createDecodeTable(decodeGB2312, encodeHanzi)); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func generate(filename, encodeName, decodeName string, encodeQR map[rune]uint16, decodeQR map[uint16]rune) error {
 	var buf bytes.Buffer
 	w := &buf
 	fmt.Fprintln(w, "// Code generated by gen_table/main.go; DO NOT EDIT.")
@@ -27,11 +41,10 @@ func main() {
 	fmt.Fprintln(w, "package bitstream")
 
 	// generate encode tables.
-	encodeQR := createEncodeTable(encodeJIS0208)
 	encodeTables := optimizeEncodeTable(encodeQR)
 	for i, t := range encodeTables {
-		fmt.Fprintf(w, "const encode%dLow, encode%dHigh = %d, %d\n", i, i, t.min, t.max)
-		fmt.Fprintf(w, "var encode%d = [...]int16{", i)
+		fmt.Fprintf(w, "const %s%dLow, %s%dHigh = %d, %d\n", encodeName, i, encodeName, i, t.min, t.max)
+		fmt.Fprintf(w, "var %s%d = [...]int16{", encodeName, i)
 		for i, code := range t.table {
 			if i%16 == 0 {
 				fmt.Fprintln(w)
@@ -46,14 +59,13 @@ func main() {
 	}
 
 	// generate decode tables
-	decodeQR := createDecodeTable(decodeJIS0208)
 	codes := make([]uint16, 0, len(decodeQR))
 	for code := range decodeQR {
 		codes = append(codes, code)
 	}
 	sort.Slice(codes, func(i, j int) bool { return codes[i] < codes[j] })
 
-	fmt.Fprintf(w, "var decode = [...]uint16{\n")
+	fmt.Fprintf(w, "var %s = [...]uint16{\n", decodeName)
 	for _, code := range codes {
 		fmt.Fprintf(w, "0x%04x: 0x%04x, // %c\n", code, decodeQR[code], decodeQR[code])
 	}
@@ -61,21 +73,20 @@ func main() {
 
 	out, err := format.Source(buf.Bytes())
 	if err != nil {
-		log.Fatal(err)
-	}
-	if err := os.WriteFile("table_gen.go", out, 0o644); err != nil {
-		log.Fatal(err)
+		return err
 	}
+	return os.WriteFile(filename, out, 0o644)
 }
 
-func loadJIS0208() (map[int]rune, error) {
-	f, err := os.Open(filepath.Join("gen_table", "index-jis0208.txt"))
+// loadIndex loads an index file of the Encoding Standard.
+func loadIndex(name string) (map[int]rune, error) {
+	f, err := os.Open(filepath.Join("gen_table", name))
 	if err != nil {
 		return nil, err
 	}
 	defer f.Close()
 
-	jis0208 := make(map[int]rune)
+	index := make(map[int]rune)
 	scanner := bufio.NewScanner(f)
 	for scanner.Scan() {
 		row := scanner.Bytes()
@@ -100,13 +111,13 @@ func loadJIS0208() (map[int]rune, error) {
 		if err != nil {
 			return nil, err
 		}
-		jis0208[int(c)] = rune(u)
+		index[int(c)] = rune(u)
 	}
 	if err := scanner.Err(); err != nil {
 		return nil, err
 	}
 
-	return jis0208, nil
+	return index, nil
 }
 
 func reverse(decode map[int]rune) map[rune]int {
@@ -163,7 +174,33 @@ func encodeQR(code int) (uint16, bool) {
 	return (s>>8)*0xC0 + (s % 0x100), true
 }
 
-func createEncodeTable(encode map[rune]int) map[rune]uint16 {
+// encodeHanzi converts the pointer of index-gb2312.txt into the 13-bit code in the hanzi mode.
+// index-gb2312.txt is the GB2312 subset of index-gb18030.txt of the WHATWG Encoding Standard,
+// and its pointers are the same as in index-gb18030.txt.
+func encodeHanzi(pointer int) (uint16, bool) {
+	lead := pointer/190 + 0x81
+	trail := pointer % 190
+	if trail < 0x3f {
+		trail += 0x40
+	} else {
+		trail += 0x41
+	}
+	if trail < 0xa1 || trail > 0xfe {
+		return 0, false
+	}
+
+	s := uint16(lead<<8 | trail)
+	if s >= 0xA1A1 && s <= 0xAAFE {
+		s -= 0xA1A1
+	} else if s >= 0xB0A1 && s <= 0xFAFE {
+		s -= 0xA6A1
+	} else {
+		return 0, false
+	}
+	return (s>>8)*0x60 + (s % 0x100), true
+}
+
+func createEncodeTable(encode map[rune]int, encodeQR func(int) (uint16, bool)) map[rune]uint16 {
 	ret := make(map[rune]uint16)
 	for ch, code := range encode {
 		qr, ok := encodeQR(code)
@@ -218,7 +255,7 @@ func optimizeEncodeTable(encode map[rune]uint16) []encodeTable {
 	return tables
 }
 
-func createDecodeTable(decode map[int]rune) map[uint16]rune {
+func createDecodeTable(decode map[int]rune, encodeQR func(int) (uint16, bool)) map[uint16]rune {
 	ret := make(map[uint16]rune)
 	for code, ch := range decode {
 		qr, ok := encodeQR(code)
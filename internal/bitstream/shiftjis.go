@@ -0,0 +1,108 @@
+package bitstream
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// KanjiPrefix returns the first character of data and its length in bytes,
+// if it can be encoded in the kanji mode.
+// data is UTF-8, or Shift_JIS if shiftJIS is true.
+func KanjiPrefix(data []byte, shiftJIS bool) (rune, int, bool) {
+	if !shiftJIS {
+		r, size := utf8.DecodeRune(data)
+		if r == utf8.RuneError || !IsKanji(r) {
+			return 0, 0, false
+		}
+		return r, size, true
+	}
+
+	if len(data) < 2 {
+		return 0, 0, false
+	}
+	s1, s2 := uint64(data[0]), uint64(data[1])
+	if s2 < 0x40 || s2 == 0x7f || s2 > 0xfc {
+		return 0, 0, false
+	}
+	s := s1<<8 | s2
+	switch {
+	case 0x8140 <= s && s <= 0x9ffc:
+		s -= 0x8140
+	case 0xe040 <= s && s <= 0xebbf:
+		s -= 0xc140
+	default:
+		return 0, 0, false
+	}
+	r, ok := KanjiRune((s>>8)*0xc0 + (s & 0xff))
+	if !ok {
+		return 0, 0, false
+	}
+	return r, 2, true
+}
+
+// KanjiRunes returns the characters that can be encoded in the kanji mode.
+// runes[i] is the character starting at data[i], and sizes[i] is its length in bytes.
+// sizes[i] is 0 if data[i] doesn't start a kanji.
+// data is UTF-8, or Shift_JIS if shiftJIS is true.
+func KanjiRunes(data []byte, shiftJIS bool) (runes []rune, sizes []int) {
+	runes = make([]rune, len(data))
+	sizes = make([]int, len(data))
+	for i := 0; i < len(data); {
+		if r, size, ok := KanjiPrefix(data[i:], shiftJIS); ok {
+			runes[i] = r
+			sizes[i] = size
+			i += size
+			continue
+		}
+
+		// skip the character
+		switch {
+		case !shiftJIS:
+			_, size := utf8.DecodeRune(data[i:])
+			i += size
+		case IsShiftJISLead(data[i]) && i+1 < len(data):
+			i += 2
+		default:
+			i++
+		}
+	}
+	return runes, sizes
+}
+
+// IsShiftJISLead reports whether b is the first byte of a double-byte character in Shift_JIS.
+func IsShiftJISLead(b byte) bool {
+	return 0x81 <= b && b <= 0x9f || 0xe0 <= b && b <= 0xfc
+}
+
+// KanjiFromShiftJIS converts Shift_JIS data in the kanji mode into UTF-8.
+func KanjiFromShiftJIS(data []byte) ([]byte, error) {
+	ret := make([]byte, 0, len(data)/2*3)
+	for len(data) > 0 {
+		r, size, ok := KanjiPrefix(data, true)
+		if !ok {
+			return nil, fmt.Errorf("bitstream: invalid character in kanji mode: %x", data[0])
+		}
+		ret = utf8.AppendRune(ret, r)
+		data = data[size:]
+	}
+	return ret, nil
+}
+
+// KanjiToShiftJIS converts UTF-8 data in the kanji mode into Shift_JIS.
+func KanjiToShiftJIS(data []byte) ([]byte, error) {
+	ret := make([]byte, 0, len(data)/3*2)
+	for _, r := range string(data) {
+		code, ok := encodeKanji(r)
+		if !ok {
+			return nil, fmt.Errorf("bitstream: invalid character in kanji mode: %x", r)
+		}
+		s := code/0xc0<<8 | code%0xc0
+		if s < 0x1f00 {
+			s += 0x8140
+		} else {
+			s += 0xc140
+		}
+		ret = append(ret, byte(s>>8), byte(s))
+	}
+	return ret, nil
+}
@@ -0,0 +1,82 @@
+package bitstream
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestKanjiPrefix(t *testing.T) {
+	tests := []struct {
+		data     []byte
+		shiftJIS bool
+		want     rune
+		size     int
+		ok       bool
+	}{
+		{[]byte("点A"), false, '点', 3, true},
+		{[]byte("A"), false, 0, 0, false},
+		{[]byte("\x93\x5fA"), true, '点', 2, true},
+		{[]byte("\xe4\xaa"), true, '茗', 2, true},
+		{[]byte("\x93"), true, 0, 0, false},
+		{[]byte("\xb1"), true, 0, 0, false}, // half-width katakana
+		{[]byte("\xf0\x40"), true, 0, 0, false},
+	}
+	for _, tt := range tests {
+		r, size, ok := KanjiPrefix(tt.data, tt.shiftJIS)
+		if r != tt.want || size != tt.size || ok != tt.ok {
+			t.Errorf("%x: got (%q, %d, %t), want (%q, %d, %t)", tt.data, r, size, ok, tt.want, tt.size, tt.ok)
+		}
+	}
+}
+
+func TestKanjiRunes(t *testing.T) {
+	tests := []struct {
+		data      []byte
+		shiftJIS  bool
+		wantRunes []rune
+		wantSizes []int
+	}{
+		{[]byte("A点"), false, []rune{0, '点', 0, 0}, []int{0, 3, 0, 0}},
+		// "\x9f\x88" in the middle of "亜亜" is not a kanji.
+		{[]byte("\x88\x9f\x88\x9f"), true, []rune{'亜', 0, '亜', 0}, []int{2, 0, 2, 0}},
+		{[]byte("\xb1\x88\x9f"), true, []rune{0, '亜', 0}, []int{0, 2, 0}},
+	}
+	for _, tt := range tests {
+		runes, sizes := KanjiRunes(tt.data, tt.shiftJIS)
+		if !reflect.DeepEqual(runes, tt.wantRunes) {
+			t.Errorf("%x: got %q, want %q", tt.data, runes, tt.wantRunes)
+		}
+		if !reflect.DeepEqual(sizes, tt.wantSizes) {
+			t.Errorf("%x: got %v, want %v", tt.data, sizes, tt.wantSizes)
+		}
+	}
+}
+
+func TestKanjiShiftJIS(t *testing.T) {
+	sjis := []byte("\x93\x5f\xe4\xaa\x81\x40")
+	utf8 := []byte("点茗　")
+
+	got, err := KanjiFromShiftJIS(sjis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, utf8) {
+		t.Errorf("got %q, want %q", got, utf8)
+	}
+
+	got, err = KanjiToShiftJIS(utf8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, sjis) {
+		t.Errorf("got %x, want %x", got, sjis)
+	}
+
+	if _, err := KanjiFromShiftJIS([]byte("A")); err == nil {
+		t.Error("want error, but not")
+	}
+	if _, err := KanjiToShiftJIS([]byte("A")); err == nil {
+		t.Error("want error, but not")
+	}
+}
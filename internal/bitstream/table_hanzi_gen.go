@@ -0,0 +1,8991 @@
+// Code generated by gen_table/main.go; DO NOT EDIT.
+
+package bitstream
+
+const hanziEncode0Low, hanziEncode0High = 164, 1105
+
+var hanziEncode0 = [...]int16{
+	0x0047, -1, -1, 0x004b, 0x0006, -1, -1, -1, -1, -1, -1, -1, 0x0042, 0x001f, -1, -1,
+	-1, -1, -1, 0x0003, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, 0x0020, -1, -1, -1, -1, -1, -1, -1, -1, 0x02a3, 0x02a1, -1, -1,
+	-1, -1, -1, -1, 0x02a7, 0x02a5, 0x02b9, -1, 0x02ab, 0x02a9, -1, -1, -1, -1, 0x02af, 0x02ad,
+	-1, -1, -1, 0x0021, -1, 0x02b3, 0x02b1, -1, 0x02b8, -1, -1, -1, -1, 0x02a0, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x02a4,
+	-1, -1, -1, -1, -1, -1, -1, 0x02a6, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x02a8, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, 0x02ac, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x02b0, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x02a2, -1, 0x02aa, -1, 0x02ae, -1,
+	0x02b2, -1, 0x02b4, -1, 0x02b5, -1, 0x02b6, -1, 0x02b7, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, 0x0005, -1, 0x0004, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x01e0, 0x01e1, 0x01e2,
+	0x01e3, 0x01e4, 0x01e5, 0x01e6, 0x01e7, 0x01e8, 0x01e9, 0x01ea, 0x01eb, 0x01ec, 0x01ed, 0x01ee, 0x01ef, 0x01f0, -1, 0x01f1,
+	0x01f2, 0x01f3, 0x01f4, 0x01f5, 0x01f6, 0x01f7, -1, -1, -1, -1, -1, -1, -1, 0x0200, 0x0201, 0x0202,
+	0x0203, 0x0204, 0x0205, 0x0206, 0x0207, 0x0208, 0x0209, 0x020a, 0x020b, 0x020c, 0x020d, 0x020e, 0x020f, 0x0210, -1, 0x0211,
+	0x0212, 0x0213, 0x0214, 0x0215, 0x0216, 0x0217, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0246, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0240, 0x0241, 0x0242, 0x0243,
+	0x0244, 0x0245, 0x0247, 0x0248, 0x0249, 0x024a, 0x024b, 0x024c, 0x024d, 0x024e, 0x024f, 0x0250, 0x0251, 0x0252, 0x0253, 0x0254,
+	0x0255, 0x0256, 0x0257, 0x0258, 0x0259, 0x025a, 0x025b, 0x025c, 0x025d, 0x025e, 0x025f, 0x0260, 0x0270, 0x0271, 0x0272, 0x0273,
+	0x0274, 0x0275, 0x0277, 0x0278, 0x0279, 0x027a, 0x027b, 0x027c, 0x027d, 0x027e, 0x027f, 0x0280, 0x0281, 0x0282, 0x0283, 0x0284,
+	0x0285, 0x0286, 0x0287, 0x0288, 0x0289, 0x028a, 0x028b, 0x028c, 0x028d, 0x028e, 0x028f, 0x0290, -1, 0x0276,
+}
+
+const hanziEncode1Low, hanziEncode1High = 8212, 9794
+
+var hanziEncode1 = [...]int16{
+	0x0009, -1, 0x000b, -1, 0x000d, 0x000e, -1, -1, 0x000f, 0x0010, -1, -1, -1, -1, -1, -1,
+	-1, -1, 0x000c, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x004a, -1, 0x0043, 0x0044,
+	-1, -1, -1, -1, -1, -1, -1, 0x0058, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0045,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, 0x004c, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x00b0, 0x00b1, 0x00b2, 0x00b3,
+	0x00b4, 0x00b5, 0x00b6, 0x00b7, 0x00b8, 0x00b9, 0x00ba, 0x00bb, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x005a, 0x005b, 0x0059, 0x005c,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, 0x0029, -1, -1, -1, -1, -1, -1, 0x0026, -1, 0x0025, -1, -1,
+	-1, -1, -1, -1, -1, -1, 0x002b, -1, -1, 0x0037, 0x003d, -1, 0x002e, -1, -1, -1,
+	-1, 0x002d, -1, 0x0023, 0x0024, 0x0028, 0x0027, 0x0031, -1, -1, 0x0032, -1, -1, -1, -1, -1,
+	0x003f, 0x003e, 0x0022, 0x002a, -1, -1, -1, -1, -1, 0x0036, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, 0x0035, -1, -1, -1, 0x0034, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0038, 0x0033, -1, -1,
+	0x003b, 0x003c, -1, -1, -1, -1, -1, -1, -1, -1, 0x0039, 0x003a, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, 0x0030, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, 0x002c, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x002f, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0098, 0x0099, 0x009a, 0x009b,
+	0x009c, 0x009d, 0x009e, 0x009f, 0x00a0, 0x00a1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x0084, 0x0085, 0x0086, 0x0087, 0x0088, 0x0089, 0x008a, 0x008b, 0x008c, 0x008d, 0x008e, 0x008f, 0x0090, 0x0091, 0x0092, 0x0093,
+	0x0094, 0x0095, 0x0096, 0x0097, 0x0070, 0x0071, 0x0072, 0x0073, 0x0074, 0x0075, 0x0076, 0x0077, 0x0078, 0x0079, 0x007a, 0x007b,
+	0x007c, 0x007d, 0x007e, 0x007f, 0x0080, 0x0081, 0x0082, 0x0083, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0303, 0x0304, 0x0305, 0x0306,
+	0x0307, 0x0308, 0x0309, 0x030a, 0x030b, 0x030c, 0x030d, 0x030e, 0x030f, 0x0310, 0x0311, 0x0312, 0x0313, 0x0314, 0x0315, 0x0316,
+	0x0317, 0x0318, 0x0319, 0x031a, 0x031b, 0x031c, 0x031d, 0x031e, 0x031f, 0x0320, 0x0321, 0x0322, 0x0323, 0x0324, 0x0325, 0x0326,
+	0x0327, 0x0328, 0x0329, 0x032a, 0x032b, 0x032c, 0x032d, 0x032e, 0x032f, 0x0330, 0x0331, 0x0332, 0x0333, 0x0334, 0x0335, 0x0336,
+	0x0337, 0x0338, 0x0339, 0x033a, 0x033b, 0x033c, 0x033d, 0x033e, 0x033f, 0x0340, 0x0341, 0x0342, 0x0343, 0x0344, 0x0345, 0x0346,
+	0x0347, 0x0348, 0x0349, 0x034a, 0x034b, 0x034c, 0x034d, 0x034e, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0055, 0x0054, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0057, 0x0056,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, 0x0053, 0x0052, -1, -1, -1, 0x004f, -1, -1, 0x0051, 0x0050, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, 0x004e, 0x004d, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0041, -1, 0x0040,
+}
+
+const hanziEncode2Low, hanziEncode2High = 12288, 12841
+
+var hanziEncode2 = [...]int16{
+	0x0000, 0x0001, 0x0002, 0x0007, -1, 0x0008, -1, -1, 0x0013, 0x0014, 0x0015, 0x0016, 0x0017, 0x0018, 0x0019, 0x001a,
+	0x001d, 0x001e, -1, 0x005d, 0x0011, 0x0012, 0x001b, 0x001c, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, 0x0120, 0x0121, 0x0122, 0x0123, 0x0124, 0x0125, 0x0126, 0x0127, 0x0128, 0x0129, 0x012a, 0x012b, 0x012c, 0x012d, 0x012e,
+	0x012f, 0x0130, 0x0131, 0x0132, 0x0133, 0x0134, 0x0135, 0x0136, 0x0137, 0x0138, 0x0139, 0x013a, 0x013b, 0x013c, 0x013d, 0x013e,
+	0x013f, 0x0140, 0x0141, 0x0142, 0x0143, 0x0144, 0x0145, 0x0146, 0x0147, 0x0148, 0x0149, 0x014a, 0x014b, 0x014c, 0x014d, 0x014e,
+	0x014f, 0x0150, 0x0151, 0x0152, 0x0153, 0x0154, 0x0155, 0x0156, 0x0157, 0x0158, 0x0159, 0x015a, 0x015b, 0x015c, 0x015d, 0x015e,
+	0x015f, 0x0160, 0x0161, 0x0162, 0x0163, 0x0164, 0x0165, 0x0166, 0x0167, 0x0168, 0x0169, 0x016a, 0x016b, 0x016c, 0x016d, 0x016e,
+	0x016f, 0x0170, 0x0171, 0x0172, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, 0x0180, 0x0181, 0x0182, 0x0183, 0x0184, 0x0185, 0x0186, 0x0187, 0x0188, 0x0189, 0x018a, 0x018b, 0x018c, 0x018d, 0x018e,
+	0x018f, 0x0190, 0x0191, 0x0192, 0x0193, 0x0194, 0x0195, 0x0196, 0x0197, 0x0198, 0x0199, 0x019a, 0x019b, 0x019c, 0x019d, 0x019e,
+	0x019f, 0x01a0, 0x01a1, 0x01a2, 0x01a3, 0x01a4, 0x01a5, 0x01a6, 0x01a7, 0x01a8, 0x01a9, 0x01aa, 0x01ab, 0x01ac, 0x01ad, 0x01ae,
+	0x01af, 0x01b0, 0x01b1, 0x01b2, 0x01b3, 0x01b4, 0x01b5, 0x01b6, 0x01b7, 0x01b8, 0x01b9, 0x01ba, 0x01bb, 0x01bc, 0x01bd, 0x01be,
+	0x01bf, 0x01c0, 0x01c1, 0x01c2, 0x01c3, 0x01c4, 0x01c5, 0x01c6, 0x01c7, 0x01c8, 0x01c9, 0x01ca, 0x01cb, 0x01cc, 0x01cd, 0x01ce,
+	0x01cf, 0x01d0, 0x01d1, 0x01d2, 0x01d3, 0x01d4, 0x01d5, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, 0x02c4, 0x02c5, 0x02c6, 0x02c7, 0x02c8, 0x02c9, 0x02ca, 0x02cb, 0x02cc, 0x02cd, 0x02ce,
+	0x02cf, 0x02d0, 0x02d1, 0x02d2, 0x02d3, 0x02d4, 0x02d5, 0x02d6, 0x02d7, 0x02d8, 0x02d9, 0x02da, 0x02db, 0x02dc, 0x02dd, 0x02de,
+	0x02df, 0x02e0, 0x02e1, 0x02e2, 0x02e3, 0x02e4, 0x02e5, 0x02e6, 0x02e7, 0x02e8, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x00a4, 0x00a5, 0x00a6, 0x00a7, 0x00a8, 0x00a9, 0x00aa, 0x00ab, 0x00ac, 0x00ad,
+}
+
+const hanziEncode3Low, hanziEncode3High = 19968, 40864
+
+var hanziEncode3 = [...]int16{
+	0x109a, 0x0600, -1, 0x0c3e, -1, -1, -1, 0x0ef1, 0x11c8, 0x0d1c, 0x0d4e, 0x0f81, 0x12c1, 0x049a, 0x112a, -1,
+	0x12c3, 0x0532, -1, 0x1267, 0x0c91, 0x12c6, 0x0d9f, -1, 0x0caf, 0x047a, 0x1094, 0x0573, 0x060a, 0x0dfe, 0x12c8, -1,
+	-1, -1, 0x0609, -1, 0x0a3c, 0x104e, -1, 0x0d24, 0x12cc, -1, 0x0715, 0x103d, 0x171b, 0x122f, -1, -1,
+	0x06a0, -1, 0x054d, -1, 0x0a58, -1, 0x12db, -1, 0x0ee7, 0x05a3, 0x0f09, 0x1256, -1, 0x0a15, 0x0938, 0x12ce,
+	-1, -1, -1, 0x0b6a, -1, 0x0922, -1, 0x12d0, 0x0af3, 0x10c4, -1, 0x120d, 0x0f39, 0x11a6, 0x07d4, 0x0665,
+	0x09f5, -1, 0x0c18, 0x0bd1, 0x0c86, -1, 0x0753, -1, 0x050a, 0x10b1, -1, -1, 0x12de, 0x0924, 0x0c51, 0x1091,
+	0x0f6f, 0x0fa6, -1, -1, -1, -1, 0x0dc8, -1, -1, 0x12df, -1, -1, -1, -1, -1, -1,
+	0x0ad1, 0x0ab1, -1, 0x0d08, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0c6b, -1,
+	-1, -1, -1, -1, -1, -1, 0x0a4a, -1, 0x1127, 0x11f8, -1, 0x0da1, 0x065d, 0x12c0, 0x1119, 0x09b6,
+	-1, 0x1165, 0x07e4, 0x12e0, 0x0f44, 0x090d, -1, -1, 0x12c7, -1, 0x1046, 0x0fc8, -1, -1, -1, 0x12dc,
+	0x136e, 0x0ef5, 0x0979, -1, 0x08ba, 0x0784, 0x10bf, 0x04d9, 0x07bf, 0x0b55, -1, 0x0fac, 0x0908, 0x0ea3, 0x0a40, -1,
+	-1, -1, 0x0c96, 0x1370, -1, 0x1373, -1, -1, -1, -1, 0x0cea, 0x1308, -1, -1, -1, 0x10b9,
+	0x0d91, 0x0ce9, 0x130b, 0x1309, 0x12e5, 0x08f5, 0x0c2c, 0x052f, -1, 0x130a, 0x08f0, 0x08e8, -1, 0x0cf3, 0x0572, -1,
+	-1, 0x0ab7, -1, 0x04b5, 0x128f, 0x0daa, 0x0e3a, 0x11cb, 0x06d5, 0x0f88, -1, -1, -1, 0x1359, 0x130f, 0x0c69,
+	-1, 0x130d, -1, 0x0599, 0x0a6d, 0x10b3, -1, -1, 0x130c, -1, 0x10a6, 0x130e, 0x0b06, -1, -1, -1,
+	0x1075, -1, 0x1238, 0x1311, -1, 0x1314, 0x089d, 0x087a, -1, -1, -1, 0x0ced, -1, 0x069c, -1, 0x0681,
+	-1, 0x0c52, -1, -1, -1, -1, -1, -1, -1, 0x1317, 0x10a0, -1, -1, 0x0f48, 0x085e, 0x06bb,
+	0x0664, 0x0ffc, -1, -1, -1, -1, -1, 0x1239, 0x1104, 0x082e, 0x0820, 0x1310, -1, -1, 0x0d20, 0x0f0f,
+	0x054a, -1, 0x1312, -1, 0x0d4a, 0x1315, 0x0ab6, 0x1316, -1, -1, 0x0f10, 0x1318, -1, -1, -1, 0x048d,
+	0x073f, -1, 0x1322, -1, 0x0408, -1, 0x0a65, -1, 0x0d6b, -1, 0x0e04, -1, 0x0e05, 0x1323, -1, -1,
+	-1, -1, -1, 0x05e7, -1, -1, 0x05aa, -1, -1, -1, -1, -1, -1, 0x0f1a, 0x05cc, 0x1260,
+	0x12b3, 0x1112, -1, 0x0e84, -1, 0x07ad, -1, 0x1321, 0x135b, 0x111f, 0x131c, 0x06af, 0x12b6, 0x131d, 0x1319, 0x1320,
+	0x0b82, -1, -1, 0x10f5, 0x1313, 0x135c, -1, 0x131a, -1, 0x0be4, -1, -1, 0x09ef, -1, -1, 0x106f,
+	0x03fa, -1, -1, 0x0870, 0x1325, -1, 0x1324, -1, -1, -1, -1, 0x132b, 0x132d, -1, 0x132a, 0x0d98,
+	-1, -1, -1, 0x1328, 0x1215, -1, -1, -1, 0x051d, 0x1327, -1, 0x0a1c, -1, 0x0dab, -1, 0x1329,
+	-1, 0x1326, -1, -1, 0x132f, -1, -1, 0x0610, -1, -1, -1, 0x0728, -1, 0x109f, -1, -1,
+	0x0f7f, -1, -1, 0x0aa1, -1, 0x08c3, 0x11eb, 0x04bf, 0x0c87, 0x09aa, 0x132c, -1, 0x132e, -1, 0x0f49, 0x07cd,
+	-1, -1, -1, -1, -1, 0x0c95, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0462,
+	-1, -1, -1, 0x0578, 0x064c, 0x1333, -1, -1, -1, -1, 0x0960, -1, -1, -1, 0x135d, 0x0c8d,
+	0x0a1d, 0x1337, -1, -1, -1, -1, -1, 0x0e16, 0x06bc, -1, 0x1334, -1, 0x1336, 0x0422, 0x1120, 0x1338,
+	-1, 0x0fe4, -1, 0x1335, -1, -1, 0x1330, -1, 0x1331, 0x0a28, 0x1332, -1, -1, 0x0892, 0x0ffd, 0x06c8,
+	-1, 0x0942, -1, 0x133c, -1, -1, -1, -1, 0x1339, -1, 0x03d2, -1, -1, -1, 0x1341, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1343, 0x0435, -1, 0x133e,
+	-1, -1, 0x05b8, -1, 0x0952, -1, -1, -1, 0x0e67, 0x07d1, 0x10af, -1, 0x1342, -1, -1, 0x08e7,
+	-1, 0x04ea, -1, -1, -1, 0x1344, 0x094a, -1, 0x1345, 0x133a, 0x0b7e, -1, 0x133d, 0x1340, 0x133f, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x11ad, -1, 0x1214, -1, 0x0ca2, -1,
+	-1, -1, -1, 0x1347, -1, -1, -1, 0x0878, 0x1349, -1, -1, -1, 0x133b, -1, 0x134a, 0x0c0a,
+	-1, -1, -1, -1, -1, 0x1348, -1, -1, -1, -1, 0x12b5, -1, 0x0ea2, -1, -1, -1,
+	-1, -1, -1, -1, -1, 0x08a0, -1, -1, -1, -1, -1, -1, 0x134b, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, 0x0bbb, 0x0eb4, -1, -1, -1, 0x134c, -1, -1, 0x1346, 0x04e4,
+	0x09bd, -1, -1, -1, -1, 0x06d4, -1, -1, 0x0a1b, -1, -1, -1, -1, 0x0417, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, 0x0595, -1, 0x134d, -1, 0x134e, 0x0541, 0x134f, -1, -1, 0x057e, -1, -1, -1,
+	-1, -1, 0x03e0, -1, -1, -1, -1, -1, -1, -1, 0x1350, 0x0d34, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0fb0,
+	-1, -1, -1, -1, -1, -1, 0x1351, -1, -1, -1, 0x0a44, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, 0x1355, 0x0d2d, -1, -1, -1, -1, 0x1354, 0x1353, 0x1356, -1,
+	-1, -1, -1, 0x0e1a, -1, 0x08a8, -1, -1, -1, -1, -1, 0x0c06, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, 0x1352, 0x1357, -1, -1, -1, 0x1358, -1, -1, -1, -1,
+	-1, -1, 0x0d04, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, 0x09fb, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0658,
+	0x12c2, 0x1169, -1, 0x1149, 0x0ff5, 0x0523, 0x11d6, -1, 0x0f87, 0x0761, -1, 0x098a, -1, 0x0b21, -1, -1,
+	-1, 0x0631, -1, -1, 0x0ec2, 0x136d, 0x136f, -1, -1, -1, 0x05b2, -1, 0x0614, -1, -1, -1,
+	-1, -1, 0x0903, -1, -1, 0x0d0a, -1, -1, 0x0cca, -1, -1, 0x03ea, 0x072a, 0x0a78, 0x1361, -1,
+	0x09db, 0x0731, -1, 0x0757, 0x0fea, 0x0477, 0x0c43, 0x093e, 0x05e3, 0x1287, -1, 0x1077, 0x0885, 0x0dbd, -1, -1,
+	0x085c, 0x1365, 0x1306, -1, -1, 0x0b79, -1, -1, 0x06f3, 0x0cdc, -1, -1, 0x04c0, 0x1178, -1, -1,
+	-1, -1, 0x0aef, -1, -1, 0x0b20, 0x1381, 0x0cfe, -1, 0x0fd3, -1, 0x095b, 0x0ba8, -1, -1, -1,
+	0x0759, -1, 0x1382, -1, 0x1148, 0x1383, -1, -1, -1, -1, -1, 0x137a, 0x060b, -1, -1, 0x06aa,
+	0x0478, 0x137b, 0x0524, 0x0955, -1, 0x09b5, 0x1090, 0x0a03, -1, -1, -1, 0x0612, 0x137d, 0x137c, -1, -1,
+	0x091a, -1, -1, -1, 0x0c3f, -1, 0x127b, 0x1380, -1, 0x0a38, -1, 0x05f1, 0x0a67, -1, -1, 0x0894,
+	-1, 0x0574, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0a5c, -1, 0x0b9c, -1, -1,
+	0x0857, 0x0671, -1, -1, 0x06ae, -1, -1, -1, -1, -1, -1, 0x136b, -1, 0x0c1d, -1, 0x096c,
+	0x080a, -1, -1, 0x05c9, -1, 0x1408, 0x0ff6, -1, 0x0eb8, 0x03db, 0x0535, 0x0836, 0x1409, 0x078e, -1, 0x1183,
+	0x05b5, 0x05f2, 0x12f5, 0x0cef, -1, -1, 0x0695, 0x0c8f, 0x12f6, -1, 0x096e, -1, -1, 0x13fa, 0x12f7, -1,
+	-1, 0x0feb, 0x07ed, -1, -1, -1, 0x19fd, 0x0a4f, 0x0a74, 0x1191, 0x06f4, 0x0553, -1, 0x0534, -1, -1,
+	0x0d3d, -1, -1, -1, 0x0bcf, -1, -1, -1, 0x0bd8, 0x0a1a, -1, 0x046f, -1, 0x12f8, 0x074d, -1,
+	0x05bc, -1, -1, 0x12f9, -1, -1, 0x1225, 0x0de1, 0x0cce, 0x0d31, 0x056b, 0x098b, -1, 0x0773, -1, 0x12fa,
+	0x12fb, 0x0646, 0x0860, 0x0e89, -1, -1, -1, -1, -1, -1, 0x0fb6, -1, 0x12fc, 0x0c6f, -1, -1,
+	0x074f, 0x08a2, -1, -1, 0x0e7d, -1, 0x0c29, -1, -1, -1, -1, -1, 0x12ff, -1, 0x12fd, -1,
+	-1, 0x12fe, -1, -1, -1, 0x041d, -1, 0x0946, -1, 0x0d82, 0x0893, -1, -1, -1, -1, 0x06d0,
+	-1, -1, 0x070d, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1301, -1, 0x08ca,
+	-1, 0x1303, 0x1302, -1, -1, -1, -1, -1, 0x0bfb, -1, -1, -1, -1, -1, -1, -1,
+	0x1304, -1, -1, 0x1305, -1, -1, -1, -1, -1, -1, -1, 0x0a25, -1, 0x0ccf, 0x040b, 0x0725,
+	0x0872, 0x0f50, 0x13fc, 0x0a52, -1, -1, -1, -1, 0x060e, 0x1259, 0x0bab, 0x08d8, 0x13fd, 0x13fe, -1, -1,
+	-1, 0x0a17, 0x0901, 0x09ec, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x13ff, 0x0da5,
+	-1, -1, -1, 0x0489, -1, -1, -1, 0x1101, -1, 0x0b22, -1, 0x102a, -1, -1, -1, -1,
+	0x1401, -1, 0x09f4, -1, -1, -1, 0x1402, -1, 0x0970, -1, -1, -1, -1, -1, -1, 0x0b5b,
+	-1, -1, -1, -1, 0x0c99, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x1403, -1, -1, -1, -1, -1, -1, -1, -1, 0x1367, 0x0d56, -1, -1, -1, 0x0733, 0x0f4f,
+	0x1167, -1, -1, -1, -1, 0x041b, 0x0571, -1, 0x0ff8, -1, -1, -1, -1, 0x1368, -1, 0x152a,
+	0x136a, -1, -1, -1, -1, 0x12cf, 0x07ee, 0x0430, -1, 0x0516, 0x12ed, -1, -1, 0x1170, -1, -1,
+	0x08b2, 0x09ae, -1, 0x0f7a, -1, -1, 0x12ef, -1, -1, -1, 0x068a, -1, -1, -1, 0x12f0, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0c04, 0x0cb7, 0x109c, -1, -1, 0x12f1, 0x0b83,
+	-1, 0x0d8d, -1, 0x0c66, -1, 0x12c5, -1, 0x0d7c, 0x0f46, 0x081b, 0x040a, -1, -1, -1, 0x07e9, 0x0fcc,
+	-1, 0x042f, 0x12a3, 0x127e, -1, 0x05a4, 0x0ad3, 0x0b6e, -1, -1, 0x0488, -1, 0x0496, -1, 0x0464, 0x1571,
+	0x11bb, 0x0967, 0x0a8b, 0x12f4, 0x0a90, -1, 0x12f3, 0x0f33, -1, 0x13bf, -1, 0x0f1f, -1, -1, 0x12d3, 0x0aed,
+	0x10e0, 0x0f02, -1, 0x0853, 0x0cd3, 0x0ab0, -1, 0x094c, 0x0fd5, -1, 0x13c0, -1, -1, -1, -1, 0x0ca3,
+	-1, -1, 0x04e6, -1, 0x0651, 0x0e9b, 0x0a19, -1, -1, 0x0a16, -1, 0x1038, 0x1060, 0x12e6, -1, -1,
+	-1, -1, -1, -1, -1, 0x04bd, -1, -1, 0x0a04, -1, 0x07d0, -1, -1, 0x12e7, -1, 0x114c,
+	-1, -1, 0x0fa0, 0x12e8, -1, 0x12e9, 0x0f82, -1, 0x0537, 0x0926, -1, -1, -1, -1, 0x12ea, -1,
+	-1, -1, -1, -1, -1, -1, 0x140b, -1, -1, -1, -1, 0x0cc4, -1, -1, -1, 0x0f97,
+	-1, 0x0d1d, 0x04ad, -1, -1, -1, -1, -1, 0x1115, 0x04c5, 0x084f, 0x1110, 0x0dea, 0x0673, -1, -1,
+	-1, 0x0661, -1, -1, 0x0dc4, -1, 0x0cc0, 0x0dbb, 0x0463, 0x100f, -1, 0x0bd0, -1, -1, -1, 0x1404,
+	0x05fd, -1, -1, 0x0999, 0x0744, 0x0943, 0x0a6c, -1, 0x1575, 0x1574, 0x121a, 0x08cf, 0x11d8, 0x03e7, 0x0602, 0x0988,
+	0x0e47, 0x1572, 0x0d96, 0x1111, -1, 0x12ee, 0x1095, 0x07a4, 0x0dfd, 0x0e5d, -1, 0x1576, 0x05ef, 0x1573, -1, -1,
+	-1, 0x1134, -1, 0x0513, 0x0716, -1, 0x1579, -1, 0x07ae, 0x0849, 0x05f4, -1, 0x0eab, 0x0b3a, 0x07d2, 0x0a13,
+	0x0ec1, 0x0fb1, 0x1577, 0x0f84, -1, 0x0a9f, 0x1578, 0x0acf, -1, -1, -1, 0x095c, -1, 0x0a5e, 0x0ecb, 0x10d6,
+	0x068c, 0x1580, -1, 0x1583, -1, -1, 0x06b0, 0x03e8, 0x0635, 0x0693, -1, 0x078b, 0x0e9c, 0x0993, 0x0df0, 0x0c53,
+	-1, 0x1207, 0x1584, -1, 0x0f41, 0x04f2, -1, -1, 0x0f5b, 0x0554, -1, 0x0f26, 0x07cf, -1, 0x0f40, -1,
+	0x103c, -1, -1, 0x157f, -1, -1, 0x0593, -1, 0x0509, -1, 0x0705, 0x157a, -1, -1, -1, -1,
+	0x0b64, -1, 0x157b, 0x157c, 0x157d, 0x0bba, 0x157e, 0x1581, 0x1150, 0x1582, -1, 0x0c79, 0x0f37, -1, -1, -1,
+	-1, -1, 0x0b77, -1, 0x1589, -1, 0x158e, -1, 0x123b, -1, -1, -1, -1, -1, -1, -1,
+	-1, 0x1588, 0x1599, 0x0f15, -1, 0x07a6, 0x158d, 0x1587, 0x0bdd, -1, -1, 0x0d6a, 0x07d3, 0x0b3b, -1, -1,
+	0x0936, -1, 0x1585, -1, 0x158c, -1, 0x0bd7, -1, -1, -1, -1, 0x11a5, 0x07ac, -1, 0x092b, 0x10fc,
+	0x06df, -1, 0x1243, -1, 0x1586, 0x073d, 0x0966, -1, -1, 0x0a7b, 0x158a, 0x158b, -1, 0x158f, -1, -1,
+	-1, -1, -1, 0x159a, 0x15a4, -1, 0x1596, 0x1595, 0x1288, 0x15a2, 0x15a3, 0x17ea, 0x1086, 0x1591, -1, 0x0968,
+	-1, 0x117a, -1, 0x0987, 0x1593, -1, -1, -1, 0x0f8b, -1, -1, 0x159c, -1, 0x1049, -1, 0x159d,
+	0x03c6, 0x0c16, 0x1592, -1, 0x07c4, -1, 0x063e, 0x0eda, 0x077d, 0x1174, -1, -1, 0x159e, 0x0fab, 0x03c4, 0x15a6,
+	0x1590, 0x1045, 0x1594, 0x1597, 0x1598, 0x159b, -1, 0x07e8, -1, 0x159f, 0x15a0, -1, 0x15a1, 0x15a5, 0x15a7, 0x10f3,
+	-1, -1, -1, -1, -1, 0x0706, 0x0bb5, 0x15a9, 0x0d59, 0x0a27, 0x0b63, -1, -1, 0x099d, 0x0fb7, -1,
+	-1, -1, 0x11db, 0x15ad, -1, -1, -1, -1, -1, -1, 0x0497, -1, 0x07be, 0x15ab, -1, 0x1400,
+	-1, 0x1063, -1, -1, -1, -1, 0x0e33, 0x055c, -1, 0x03c5, -1, -1, -1, -1, -1, 0x15b0,
+	0x0e65, 0x15b1, -1, -1, 0x15ac, -1, -1, -1, -1, -1, -1, 0x15a8, -1, -1, -1, -1,
+	0x15aa, -1, 0x15ae, 0x15af, 0x07fc, -1, -1, 0x15b2, -1, -1, 0x15b3, -1, 0x07e2, -1, 0x0dba, 0x0f07,
+	0x15c6, 0x04e9, -1, 0x15c5, -1, -1, -1, 0x15c0, -1, -1, -1, -1, 0x15bd, -1, 0x0ed8, 0x15bb,
+	-1, 0x15b9, -1, 0x098f, 0x1283, -1, 0x0d4b, -1, -1, 0x15b7, 0x03c0, -1, -1, -1, -1, -1,
+	0x15bc, -1, -1, -1, -1, 0x15ba, 0x15c1, -1, -1, -1, -1, -1, 0x15c7, -1, -1, -1,
+	-1, 0x0687, -1, -1, 0x0c00, 0x0d35, 0x09d1, 0x15b4, -1, -1, 0x0bbd, -1, 0x12e3, 0x15b8, 0x0b95, -1,
+	-1, -1, -1, -1, -1, 0x15c2, 0x15c3, 0x15c4, 0x0fc4, -1, -1, 0x15d3, 0x0e83, -1, 0x15d0, -1,
+	0x0965, 0x15ce, 0x0f18, 0x15ca, 0x0d45, -1, -1, 0x09cd, 0x15cd, 0x07cc, 0x078f, 0x15c8, -1, -1, -1, 0x15b5,
+	-1, 0x15d2, -1, -1, 0x15d7, -1, -1, -1, 0x054c, 0x15d8, -1, -1, 0x0f71, 0x07a7, -1, 0x15cf,
+	-1, -1, -1, -1, -1, -1, -1, 0x1019, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, 0x15cb, -1, 0x119a, -1, 0x15b6, -1, 0x0be6, -1, 0x15cc, -1, 0x1136, -1, 0x15d5, 0x15d6, -1,
+	-1, -1, -1, -1, 0x15e3, 0x1000, -1, -1, -1, 0x15db, -1, -1, 0x15e8, 0x15e9, -1, -1,
+	-1, 0x15dd, 0x15c9, 0x0d23, 0x15e0, -1, 0x15d1, -1, -1, -1, -1, -1, 0x0da7, 0x15e2, -1, 0x15d4,
+	-1, 0x0f2a, -1, 0x0e02, 0x15ec, 0x15e5, 0x15e1, -1, 0x15ea, -1, 0x15d9, 0x15de, 0x15df, -1, -1, 0x15e4,
+	-1, -1, 0x15e6, 0x15e7, -1, 0x15eb, -1, 0x15da, -1, -1, -1, -1, -1, 0x0e13, 0x15f4, -1,
+	0x15f5, 0x15f1, -1, -1, -1, -1, -1, -1, 0x15ef, 0x086d, -1, -1, 0x15f0, -1, 0x06e1, 0x12e4,
+	-1, -1, -1, -1, -1, -1, -1, -1, 0x1009, -1, -1, 0x0ace, -1, -1, 0x15ee, 0x15dc,
+	-1, -1, -1, 0x15f3, 0x15f2, -1, -1, 0x15f6, -1, -1, -1, -1, 0x15fb, 0x15f7, -1, -1,
+	-1, 0x1255, 0x04ef, -1, 0x12ab, -1, 0x0dfa, -1, -1, 0x15f9, -1, 0x0f5a, -1, -1, -1, 0x07b8,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1600, 0x15fc, 0x108c, -1,
+	-1, -1, -1, -1, 0x1601, -1, -1, 0x15fa, 0x15f8, 0x15fe, -1, -1, 0x15ff, -1, -1, -1,
+	-1, -1, 0x15fd, -1, 0x1603, -1, -1, -1, 0x0c56, 0x12cb, 0x118a, 0x1605, 0x0da8, -1, -1, -1,
+	-1, 0x1604, -1, -1, -1, -1, 0x06e0, -1, -1, -1, -1, 0x1606, 0x1607, -1, -1, -1,
+	-1, -1, -1, -1, -1, 0x1608, 0x1602, -1, -1, -1, -1, -1, -1, -1, 0x079e, 0x0e86,
+	-1, -1, -1, 0x1609, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, 0x0fb8, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x160a,
+	-1, -1, -1, -1, -1, -1, -1, 0x0ce1, -1, -1, -1, -1, 0x08bf, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0b71, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, 0x160b, -1, -1, 0x160c, -1, -1, 0x0cb3, 0x0e03, -1, 0x160d, 0x0817, 0x12d5,
+	0x10d1, 0x160e, 0x0ec4, -1, 0x0639, -1, -1, -1, -1, -1, -1, 0x1610, -1, 0x114f, -1, -1,
+	0x09c6, 0x0570, -1, -1, 0x0f06, 0x160f, -1, -1, -1, 0x1611, 0x074b, -1, -1, 0x0779, 0x0ebb, 0x1612,
+	-1, -1, -1, 0x0c33, 0x1613, -1, 0x1151, -1, 0x0cc5, 0x1615, 0x1614, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1616, -1, -1, 0x0ec0,
+	-1, -1, -1, 0x0d84, -1, -1, -1, -1, 0x1179, 0x1416, 0x1418, -1, 0x1417, 0x0766, 0x141b, 0x141c,
+	0x05d7, -1, -1, 0x1419, -1, -1, -1, -1, -1, 0x141a, 0x04e0, 0x141e, -1, -1, 0x0837, -1,
+	0x1216, -1, 0x141f, -1, -1, -1, -1, 0x0958, -1, -1, 0x067a, -1, 0x140f, 0x0e4d, 0x0971, 0x07f4,
+	0x12b7, 0x0992, -1, -1, -1, -1, -1, 0x09a8, -1, -1, 0x0880, 0x0e52, 0x141d, 0x03f2, 0x0f4a, 0x0697,
+	0x1278, 0x0c21, -1, -1, 0x09c3, -1, 0x0e58, -1, 0x1426, 0x1420, 0x0c19, 0x1422, -1, 0x1427, -1, 0x0bf6,
+	-1, -1, -1, 0x1429, -1, -1, 0x1428, 0x097f, -1, -1, -1, 0x1425, 0x1424, -1, -1, -1,
+	-1, -1, 0x0558, 0x09cb, 0x0a81, 0x1421, 0x1423, -1, -1, -1, -1, 0x0fec, 0x142c, -1, -1, -1,
+	-1, -1, 0x09fc, 0x1431, -1, -1, -1, -1, -1, -1, -1, 0x0641, -1, -1, -1, -1,
+	0x1432, 0x1411, 0x0737, 0x114a, 0x142b, -1, 0x0990, 0x142f, -1, 0x1410, -1, 0x05e5, -1, 0x142a, 0x09a4, -1,
+	-1, -1, 0x142d, -1, 0x1430, -1, -1, -1, 0x1438, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, 0x0720, 0x03c2, -1, -1, -1, -1, -1, -1, -1, 0x0ad0, -1, -1, 0x0506, 0x142e,
+	-1, -1, 0x1437, -1, 0x0c31, 0x1433, -1, -1, 0x1434, 0x1436, 0x1435, -1, -1, 0x143d, -1, 0x1131,
+	0x0499, -1, -1, -1, 0x143c, -1, -1, -1, -1, -1, -1, -1, -1, 0x1443, -1, 0x143a,
+	-1, -1, -1, -1, 0x1439, -1, -1, -1, 0x143b, 0x0bdf, 0x0838, -1, -1, 0x1442, -1, -1,
+	0x1444, -1, 0x0e62, -1, -1, -1, 0x0630, 0x14bf, -1, -1, -1, 0x1440, -1, 0x1441, -1, -1,
+	-1, 0x0c74, -1, -1, -1, 0x0648, -1, -1, -1, 0x1446, -1, -1, -1, -1, 0x1445, -1,
+	0x1448, 0x0423, -1, -1, 0x05cb, -1, -1, -1, -1, -1, 0x096f, -1, -1, -1, -1, -1,
+	0x105e, -1, -1, -1, -1, 0x0621, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, 0x1447, -1, -1, -1, -1, -1, -1, -1, 0x0e39, 0x1a32, -1, -1,
+	-1, 0x0e1b, -1, -1, 0x0e3d, -1, -1, -1, 0x0e60, -1, -1, -1, -1, -1, 0x0d1a, -1,
+	-1, -1, -1, -1, -1, 0x1449, -1, -1, -1, -1, -1, 0x0e8d, 0x144a, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1412, -1,
+	0x144e, 0x144b, -1, 0x0912, -1, 0x0dda, -1, -1, -1, 0x144c, -1, -1, -1, -1, -1, -1,
+	-1, -1, 0x0d49, 0x0b58, -1, -1, -1, -1, -1, 0x0c7c, 0x144d, -1, -1, -1, 0x1195, 0x1005,
+	-1, -1, -1, -1, -1, -1, -1, -1, 0x0b4a, 0x0634, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1413, -1, -1, -1,
+	-1, 0x0459, -1, -1, -1, 0x1414, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, 0x1415, -1, -1, -1, 0x079d, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, 0x0cdf, -1, -1, -1, -1, -1, -1, 0x0d9e, 0x0ce8, -1, 0x1272, -1,
+	0x0d78, -1, -1, 0x0986, -1, -1, 0x07d7, -1, -1, 0x109b, -1, -1, -1, -1, -1, -1,
+	-1, -1, 0x1699, -1, 0x0545, -1, -1, 0x0437, -1, -1, -1, -1, -1, 0x06d3, -1, 0x0f83,
+	-1, -1, -1, -1, 0x1366, 0x0f65, 0x0ee1, -1, -1, 0x136c, 0x063f, -1, 0x1098, -1, -1, 0x073a,
+	-1, -1, -1, -1, 0x1698, 0x1696, -1, 0x0592, -1, 0x0e8b, 0x0e4a, 0x06b1, -1, 0x12d1, 0x106a, 0x079a,
+	-1, 0x0d86, -1, -1, 0x0eb6, -1, -1, 0x10a3, 0x09a3, 0x086f, 0x0640, -1, 0x1524, -1, -1, -1,
+	-1, 0x1525, 0x13fb, -1, 0x1058, -1, -1, 0x0c45, 0x0b6d, 0x06ad, -1, 0x069b, -1, -1, 0x09bb, 0x129f,
+	-1, 0x0c54, -1, -1, 0x043b, 0x1527, 0x08b0, 0x0e76, 0x1529, -1, 0x1528, -1, -1, -1, -1, -1,
+	0x05eb, -1, 0x0d5c, -1, -1, 0x03e1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, 0x0bad, 0x0baa, -1, 0x0b6b, -1, 0x0888, 0x0e3c, -1, -1, -1, 0x07a2, -1, -1,
+	-1, 0x17f8, 0x0d06, 0x17f9, 0x0efc, -1, 0x1270, 0x06dd, 0x0ac7, -1, 0x0cf0, -1, -1, 0x17fa, -1, -1,
+	-1, -1, 0x0629, 0x086a, -1, -1, 0x107c, 0x1800, -1, 0x0b2d, -1, -1, -1, -1, 0x1803, -1,
+	-1, -1, -1, 0x17fd, 0x1804, 0x0ed6, -1, -1, 0x0680, 0x17fb, 0x17fc, 0x1802, -1, -1, 0x0b7c, 0x1807,
+	-1, -1, 0x1806, -1, -1, -1, -1, -1, -1, 0x0b02, -1, 0x0c3d, -1, -1, 0x1809, -1,
+	-1, -1, -1, -1, -1, -1, 0x0b56, -1, -1, -1, 0x1801, 0x0d9b, -1, -1, -1, -1,
+	0x08e2, 0x0742, 0x1805, 0x0ff4, 0x0f0e, -1, -1, 0x1808, 0x180f, -1, 0x1085, -1, 0x08a9, 0x180c, -1, -1,
+	-1, -1, -1, 0x180e, -1, 0x09f0, -1, -1, 0x10ab, -1, -1, -1, 0x0846, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1810, -1, 0x10d5, -1, -1, -1, 0x128a,
+	-1, 0x0efd, -1, 0x0edd, 0x0a85, 0x180a, 0x180b, 0x08be, 0x180d, 0x1812, -1, -1, 0x1811, -1, -1, -1,
+	-1, 0x1815, -1, 0x1817, -1, -1, -1, -1, 0x0b8e, -1, -1, -1, 0x0b67, -1, -1, 0x0949,
+	0x0d6e, -1, -1, 0x1816, -1, 0x064f, -1, -1, -1, 0x0b23, -1, -1, -1, -1, -1, -1,
+	-1, 0x1128, 0x1813, -1, 0x1814, -1, 0x0cc1, -1, -1, -1, -1, -1, 0x181c, -1, -1, -1,
+	0x1818, -1, -1, -1, -1, -1, 0x0c24, -1, -1, 0x0ef0, 0x181a, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, 0x181b, -1, -1, -1, -1, 0x0828, -1, -1, -1, -1, -1,
+	-1, -1, 0x181d, -1, -1, -1, -1, 0x1819, -1, -1, 0x09d6, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, 0x10e3, 0x181e, 0x0d73, 0x1822, -1, -1, 0x1823, -1, -1, -1, -1, 0x1015,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, 0x0afc, -1, -1, -1, -1, -1, -1, -1, 0x0b03, 0x1821, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1820, -1, -1, -1, -1, -1,
+	-1, -1, 0x1826, 0x0f70, -1, 0x1a33, -1, -1, 0x1829, -1, -1, -1, -1, -1, 0x1824, -1,
+	-1, 0x087d, 0x0d28, -1, -1, -1, -1, -1, -1, 0x0854, -1, -1, 0x0f92, -1, -1, -1,
+	-1, -1, 0x1827, -1, 0x1828, -1, 0x182d, -1, 0x182f, -1, -1, -1, 0x1830, -1, -1, -1,
+	0x182a, 0x05d4, -1, 0x182b, -1, -1, 0x182e, -1, -1, 0x0b7a, -1, 0x1825, -1, -1, -1, -1,
+	-1, 0x182c, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1831, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, 0x1833, 0x1832, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, 0x1834, -1, 0x1377, -1, -1, 0x1835, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x1836, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x1292, 0x183c, -1, 0x183d, 0x0996, 0x116f, -1, 0x1295, 0x0585, 0x0e2e, 0x1839, 0x12e2, 0x128d, 0x0fc1, -1, 0x0b0e,
+	-1, -1, 0x183e, 0x085d, 0x0741, 0x183a, 0x1026, -1, -1, 0x0781, 0x0aae, -1, 0x12ca, -1, -1, -1,
+	0x0dca, 0x17ed, -1, 0x183b, -1, 0x06b4, -1, -1, -1, -1, 0x0d05, -1, -1, 0x0b94, -1, -1,
+	0x17b1, 0x0b9d, -1, 0x0e3b, 0x17b2, 0x11ab, -1, 0x112d, 0x0db7, 0x03d1, -1, 0x0e0d, 0x0ee9, -1, -1, 0x07c9,
+	-1, -1, -1, 0x17b4, -1, 0x17b3, -1, 0x1299, 0x0758, 0x1245, 0x0607, 0x0eef, 0x10aa, 0x0425, 0x0d94, -1,
+	0x0527, 0x0d72, 0x098c, 0x101a, 0x0db1, 0x17b5, 0x0801, -1, -1, -1, 0x0f9b, 0x072b, -1, -1, -1, -1,
+	0x1176, -1, -1, 0x0785, 0x1066, 0x0fbb, 0x0871, -1, 0x17b6, 0x0cfc, -1, -1, -1, 0x09ac, 0x0475, 0x0e1d,
+	-1, -1, 0x0864, -1, 0x0863, 0x10d9, 0x0b1b, 0x099b, -1, -1, -1, -1, 0x06da, -1, -1, -1,
+	0x0b01, -1, 0x078d, 0x1141, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0c9d, 0x0b4e, 0x04cb,
+	-1, 0x0750, -1, -1, 0x17ba, 0x0a47, -1, -1, 0x11ae, -1, -1, -1, -1, -1, 0x17bb, -1,
+	0x17bd, -1, -1, -1, -1, -1, -1, -1, 0x0586, 0x0633, 0x0e01, 0x102f, 0x05bb, -1, -1, 0x0db8,
+	-1, 0x06a1, -1, -1, 0x0d63, -1, 0x08aa, -1, -1, 0x0f1d, 0x12af, -1, -1, -1, -1, 0x0fc0,
+	-1, 0x0d58, -1, -1, 0x065a, 0x1837, 0x0881, -1, 0x04fd, -1, 0x0d4f, -1, 0x1838, 0x04e1, -1, -1,
+	-1, -1, 0x152b, -1, 0x1107, 0x152c, -1, 0x1081, -1, -1, -1, -1, 0x152d, -1, -1, -1,
+	-1, 0x092c, -1, -1, 0x152e, -1, -1, -1, 0x0d8b, 0x10db, 0x051e, 0x17e9, 0x0b80, 0x0900, 0x0f11, 0x0b91,
+	0x0935, 0x0c07, 0x04c2, -1, -1, 0x0932, -1, -1, 0x0cbb, 0x0e8a, 0x08eb, 0x0f3c, -1, -1, 0x0d99, 0x0c20,
+	0x17eb, 0x0fdb, -1, -1, -1, 0x11b8, -1, -1, -1, 0x17ec, -1, -1, -1, -1, 0x0dd3, -1,
+	0x0ebf, 0x0aa4, -1, 0x17ee, -1, 0x0aa3, 0x17ef, -1, -1, -1, -1, -1, -1, -1, 0x17f7, 0x0ecc,
+	-1, 0x0d3c, -1, -1, -1, -1, -1, -1, -1, 0x10b8, 0x1627, -1, -1, -1, -1, 0x112b,
+	-1, 0x0e29, 0x0c50, -1, -1, -1, -1, -1, 0x162b, -1, -1, -1, 0x1626, 0x1628, -1, -1,
+	0x1629, 0x162e, -1, -1, 0x04cc, -1, 0x162a, 0x06f9, 0x162c, 0x162d, 0x162f, 0x05b9, 0x1630, -1, -1, -1,
+	-1, -1, 0x1632, 0x1637, -1, -1, -1, -1, -1, 0x1051, -1, 0x1635, 0x1634, 0x0a6a, -1, -1,
+	-1, 0x1636, -1, 0x115f, -1, 0x1631, -1, 0x1639, 0x03d5, -1, -1, -1, -1, 0x1633, -1, 0x09b8,
+	-1, 0x1638, -1, -1, 0x163a, -1, -1, -1, -1, -1, -1, 0x163d, -1, -1, -1, -1,
+	-1, -1, 0x163b, -1, -1, -1, -1, -1, -1, 0x1224, -1, -1, -1, -1, -1, -1,
+	-1, 0x0f7e, -1, -1, 0x163c, 0x163e, 0x0aac, -1, 0x064a, -1, 0x1137, -1, -1, 0x0c8c, -1, -1,
+	0x06a4, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x095d, -1, -1, -1, -1,
+	-1, -1, 0x163f, 0x1640, -1, -1, 0x1646, 0x0526, -1, -1, -1, -1, -1, -1, 0x0c48, -1,
+	-1, -1, -1, -1, 0x057d, -1, 0x1041, -1, -1, -1, -1, 0x1647, -1, -1, 0x1645, -1,
+	-1, -1, -1, -1, 0x1644, -1, 0x1642, 0x1641, -1, 0x043f, -1, -1, -1, 0x11b7, 0x1643, -1,
+	-1, -1, -1, -1, 0x164a, -1, -1, -1, -1, -1, -1, -1, -1, 0x164b, 0x1649, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x1bb9, -1, -1, 0x1652, 0x1651, 0x0c75, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, 0x1648, -1, -1, 0x164d, -1, 0x164f, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1653, -1, 0x1650, 0x164c, -1, -1, 0x164e,
+	-1, -1, -1, -1, 0x1654, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, 0x1655, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1656, -1, -1, -1, 0x1657, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x1659, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, 0x165a, -1, -1, -1, -1, -1, -1, -1, 0x0f00, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x189c, -1, 0x0547, 0x123c, -1,
+	-1, 0x1031, 0x04f1, -1, -1, 0x0723, 0x12b2, 0x0c88, 0x093d, 0x072d, -1, 0x0f36, -1, -1, 0x04cd, 0x140e,
+	-1, 0x0859, 0x10b0, 0x0e07, 0x03ec, -1, -1, 0x0fae, -1, -1, -1, -1, -1, 0x1362, 0x08ec, -1,
+	-1, 0x0451, 0x0daf, 0x049b, -1, 0x0de6, 0x066a, -1, 0x0d85, -1, -1, -1, 0x0f62, -1, -1, 0x1617,
+	0x11c9, 0x161a, -1, -1, 0x1619, 0x0bc0, 0x0e9a, -1, 0x0a30, 0x1618, 0x1242, 0x048e, 0x1223, 0x05da, -1, -1,
+	-1, -1, -1, -1, -1, -1, 0x0597, 0x1200, -1, -1, -1, -1, -1, 0x0f6e, 0x040e, -1,
+	-1, 0x161b, -1, -1, -1, -1, -1, 0x1620, 0x04e2, -1, -1, 0x161c, 0x161d, 0x0af0, -1, -1,
+	-1, -1, 0x0b1c, -1, 0x1621, 0x06b8, -1, -1, -1, -1, -1, -1, 0x080e, -1, -1, -1,
+	-1, -1, -1, -1, 0x1622, 0x0b5a, -1, -1, -1, -1, -1, 0x1623, -1, -1, 0x1624, -1,
+	-1, 0x1625, 0x0550, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, 0x06e8, 0x0c1c, 0x0b89, -1, 0x0481, -1, 0x0ff1, -1, 0x189a, 0x0802, 0x1116, 0x1103, -1, 0x0762,
+	0x16ae, -1, -1, -1, 0x126e, -1, 0x0cab, 0x0452, -1, -1, 0x0551, 0x16b0, -1, -1, -1, 0x1011,
+	0x0a8d, 0x16af, -1, 0x09a1, 0x10e5, 0x05d6, 0x16b1, 0x05e9, -1, 0x0b2c, 0x071c, -1, 0x06cd, -1, 0x0bd2, 0x068e,
+	0x16b3, -1, -1, -1, -1, 0x16b2, 0x0627, 0x12b8, -1, -1, -1, -1, -1, 0x0ea4, -1, -1,
+	-1, -1, -1, 0x16b7, -1, 0x16b5, 0x0ddb, 0x0974, 0x10f8, 0x16b4, -1, -1, -1, -1, 0x16b6, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0a2d, 0x09e7, -1, -1, -1, -1, -1,
+	-1, 0x16ba, 0x16b9, 0x09c9, -1, -1, 0x0a4d, -1, -1, -1, -1, 0x16bb, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, 0x16bc, -1, 0x16bd, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, 0x1407, -1, 0x1052, 0x0ea1, -1, -1, 0x08a7, -1, -1, -1, 0x1522, 0x12c4,
+	0x0969, 0x140c, 0x10cb, 0x0c59, 0x0ba9, -1, -1, -1, 0x1523, -1, 0x0456, 0x156d, -1, -1, -1, 0x0d9c,
+	-1, 0x1570, -1, 0x072c, -1, 0x10dc, -1, 0x06c4, 0x07ca, -1, -1, 0x0519, -1, -1, -1, 0x05db,
+	0x11c4, -1, -1, -1, -1, 0x0b15, 0x0f91, 0x07e0, -1, 0x17f2, 0x17f1, -1, -1, 0x17f3, -1, 0x0ee3,
+	-1, 0x0d14, -1, -1, -1, -1, -1, -1, -1, 0x05ae, 0x0c7e, -1, 0x17f5, -1, -1, -1,
+	0x1a4f, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x17e5, -1, 0x0768, 0x05b0, -1, 0x0a9b, 0x17e7, 0x17e6, 0x17e8, -1, -1, -1, -1, 0x10ac, -1, -1,
+	-1, 0x1669, 0x0fed, -1, 0x0ead, -1, 0x1064, -1, -1, 0x04a9, 0x046a, -1, 0x0471, 0x0bec, -1, -1,
+	0x11c2, 0x10ef, -1, 0x165b, -1, -1, -1, 0x165c, -1, 0x10ba, -1, 0x04f8, 0x044a, -1, -1, -1,
+	0x0ef8, 0x11f6, 0x165d, -1, 0x0915, 0x059c, -1, 0x165e, 0x07bb, 0x165f, 0x07f1, 0x0aa8, 0x1660, -1, -1, -1,
+	0x100b, -1, 0x0ebc, -1, -1, 0x1661, -1, 0x05c2, 0x0bc6, 0x1662, -1, -1, 0x1663, -1, -1, -1,
+	-1, 0x1138, -1, -1, -1, -1, -1, -1, 0x1664, -1, 0x102c, -1, -1, 0x1665, 0x0f01, -1,
+	-1, -1, -1, -1, -1, 0x1666, -1, 0x05c1, -1, -1, -1, -1, 0x1667, 0x0814, -1, -1,
+	-1, -1, -1, 0x0fe3, 0x16bf, 0x0457, 0x10c3, -1, -1, 0x16c0, -1, -1, 0x0868, 0x0ceb, -1, 0x16c2,
+	0x1a9d, 0x1a9c, 0x156e, -1, -1, -1, 0x16c1, 0x121d, 0x0efb, 0x0ae5, -1, -1, -1, 0x1702, -1, -1,
+	0x1231, 0x16c6, -1, -1, 0x16c7, -1, -1, 0x1106, -1, -1, 0x16cb, 0x09ab, -1, 0x16cc, 0x16c4, -1,
+	-1, 0x04ff, -1, -1, -1, 0x0b8d, -1, -1, 0x16cd, -1, -1, 0x0fe2, -1, 0x07d5, 0x16c8, 0x069d,
+	0x07f2, 0x0e4b, 0x0e0a, 0x16c3, 0x16c5, 0x16c9, 0x16ca, -1, -1, -1, 0x16d6, -1, -1, 0x16d3, 0x1194, 0x16d2,
+	-1, -1, 0x0bac, -1, 0x11f9, 0x0bc1, 0x049f, -1, -1, 0x16ce, -1, 0x16d1, 0x0a2e, 0x0dfb, -1, -1,
+	0x05a0, 0x16d8, -1, -1, -1, 0x0850, 0x16d0, 0x0ff3, 0x1158, 0x16d4, 0x0755, 0x16d5, -1, -1, -1, 0x0c92,
+	-1, -1, -1, -1, -1, 0x16cf, -1, -1, -1, -1, -1, 0x129b, 0x1aa0, -1, -1, 0x16d7,
+	-1, 0x1aa4, 0x16dd, 0x0db0, -1, -1, -1, -1, -1, -1, -1, 0x0a34, -1, 0x080f, -1, -1,
+	0x0995, -1, 0x07c2, -1, -1, 0x0de0, -1, -1, -1, 0x1aa5, 0x1aa2, -1, -1, 0x1aa1, -1, -1,
+	-1, -1, 0x0815, 0x1aa6, 0x1013, -1, -1, 0x1aa3, 0x07bd, 0x0656, 0x16e0, 0x0611, 0x0e90, 0x0726, -1, 0x0f61,
+	0x0c60, -1, -1, 0x0991, -1, -1, 0x0650, -1, 0x16d9, 0x16da, 0x16dc, 0x16db, 0x0b74, 0x16e1, -1, 0x1100,
+	-1, -1, -1, 0x16e6, 0x0c83, -1, -1, -1, -1, 0x0f63, -1, -1, 0x16e8, 0x0796, -1, -1,
+	-1, -1, 0x16e7, -1, 0x0819, -1, 0x16e2, -1, -1, -1, 0x16e3, 0x16e9, -1, 0x16e5, -1, 0x0f51,
+	0x1105, -1, -1, 0x07fb, -1, -1, 0x1162, -1, 0x0b99, -1, -1, 0x1aa7, 0x101b, 0x16e4, -1, 0x0b34,
+	-1, 0x16ec, 0x042e, -1, 0x16f1, -1, -1, -1, 0x0861, -1, -1, 0x16eb, 0x05be, -1, -1, -1,
+	-1, -1, -1, -1, -1, 0x0ca8, 0x16ef, -1, -1, -1, 0x0909, 0x0eee, -1, -1, -1, -1,
+	-1, 0x0832, -1, -1, -1, 0x0e87, -1, -1, 0x16ee, -1, 0x16f0, -1, 0x0f66, 0x16ed, -1, 0x0f08,
+	0x081c, -1, -1, -1, -1, -1, 0x05ea, 0x0944, 0x04b1, 0x050c, -1, 0x0438, 0x16ea, 0x04b0, 0x05ab, 0x075e,
+	0x0647, -1, -1, 0x0faa, 0x16f6, -1, 0x080b, -1, -1, 0x0ce6, 0x0fe9, -1, -1, -1, -1, -1,
+	0x16f7, 0x052d, -1, -1, -1, -1, 0x1aa8, -1, 0x1139, 0x1123, -1, -1, -1, 0x1aa9, 0x16f8, 0x10c1,
+	-1, -1, -1, -1, -1, 0x16f4, -1, -1, -1, -1, 0x111d, -1, -1, -1, -1, 0x06ef,
+	0x16f2, -1, -1, 0x16f5, 0x069e, -1, 0x16f3, 0x09c1, -1, -1, -1, 0x16f9, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1157,
+	-1, -1, -1, -1, -1, -1, -1, -1, 0x0567, -1, 0x16fa, -1, 0x0804, -1, 0x0d76, -1,
+	-1, 0x0d64, -1, -1, -1, 0x0b5c, -1, -1, -1, -1, -1, -1, -1, 0x1aaa, -1, -1,
+	-1, -1, 0x0adc, -1, -1, -1, -1, 0x081a, 0x096d, -1, -1, -1, -1, -1, -1, -1,
+	0x0f1e, -1, -1, -1, -1, 0x16fb, -1, 0x0975, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x046e, -1, -1, 0x1196, -1,
+	-1, -1, -1, -1, 0x16fd, -1, -1, -1, -1, -1, -1, -1, -1, 0x1aac, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x16fe, 0x0788, 0x1aab, -1, -1, 0x16fc, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x16ff, -1, -1, -1, -1, -1, -1, 0x0795, -1,
+	-1, -1, 0x060d, -1, -1, -1, -1, -1, 0x0fd7, -1, 0x03e2, 0x1aad, -1, -1, -1, -1,
+	-1, 0x1aae, 0x09e0, -1, 0x1700, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, 0x0bb2, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, 0x1701, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1451,
+	-1, -1, -1, -1, -1, -1, 0x1aaf, -1, 0x0709, -1, 0x0f4b, 0x1986, 0x1006, 0x0dd8, 0x0cf5, 0x0f76,
+	0x0508, 0x0f31, 0x08e3, -1, -1, 0x171d, 0x0831, 0x1987, 0x11bc, -1, 0x0c3c, 0x1988, -1, -1, -1, 0x1989,
+	-1, 0x198b, 0x198a, -1, 0x198d, 0x198c, -1, -1, -1, -1, 0x08d7, -1, 0x198e, -1, 0x0a9d, -1,
+	-1, -1, -1, 0x0560, 0x0596, -1, -1, 0x07e6, -1, -1, -1, -1, -1, 0x1a85, 0x1a84, 0x067e,
+	0x0e38, 0x0461, -1, 0x1a86, -1, -1, -1, 0x0d47, 0x1a87, 0x1a88, -1, 0x0db5, 0x152f, 0x04a4, 0x1199, -1,
+	-1, 0x0c2a, 0x03e6, 0x0591, 0x0cf2, -1, -1, -1, 0x0ecf, -1, -1, 0x0977, -1, -1, -1, -1,
+	-1, -1, -1, 0x099a, -1, -1, 0x0c63, 0x1213, -1, 0x09c8, 0x1530, 0x0d27, 0x106e, 0x0ba3, 0x0406, 0x04f5,
+	0x0ce4, -1, -1, 0x0401, -1, -1, 0x06b5, -1, -1, 0x0bf9, -1, -1, 0x0652, -1, 0x11d1, 0x050f,
+	0x085b, -1, -1, -1, 0x04ec, -1, -1, -1, -1, 0x0950, 0x03f0, -1, -1, -1, -1, -1,
+	-1, 0x10b5, 0x0dc2, 0x1264, -1, 0x0eb5, 0x0615, 0x0978, 0x11da, -1, 0x06c6, 0x0bd6, -1, -1, -1, 0x1531,
+	0x0998, 0x0ab4, 0x0c7f, -1, 0x07e3, 0x0427, -1, -1, 0x0be9, -1, -1, 0x0bfa, 0x0e46, -1, -1, -1,
+	-1, 0x0426, -1, -1, -1, 0x05d5, -1, -1, -1, 0x0b47, -1, 0x1532, 0x1039, 0x0528, -1, 0x0b31,
+	-1, -1, 0x06b6, -1, 0x1253, 0x05a2, 0x04cf, 0x0b53, 0x0b88, 0x09cc, 0x1533, -1, 0x0407, 0x0bc3, 0x0a5f, -1,
+	0x0754, -1, 0x093b, 0x0ed7, 0x03ed, -1, 0x0ece, 0x1535, 0x092f, 0x127d, 0x1534, 0x11cf, 0x03fc, -1, -1, 0x0b81,
+	-1, -1, 0x0a82, 0x088f, -1, 0x10f4, 0x09d8, 0x0ba0, 0x0485, 0x1190, -1, -1, 0x09c7, 0x0da2, 0x1536, 0x11fb,
+	-1, 0x072f, -1, 0x0ccc, 0x0de8, -1, 0x1538, 0x097c, -1, -1, -1, -1, 0x0c13, 0x1266, 0x0d8f, 0x0b62,
+	-1, 0x0515, 0x0751, -1, -1, -1, -1, 0x1217, 0x19db, 0x03d3, -1, -1, -1, -1, 0x09a5, -1,
+	-1, 0x0e93, -1, -1, -1, -1, 0x0ed9, -1, -1, -1, 0x121e, 0x0aad, -1, 0x0f2d, 0x0e41, 0x0fcd,
+	0x0b72, 0x05b1, 0x1537, 0x11f4, 0x0856, 0x0812, -1, -1, 0x03c3, -1, 0x0bb1, 0x058b, -1, -1, -1, 0x11f0,
+	-1, -1, 0x19dc, -1, -1, -1, -1, -1, -1, 0x1539, 0x0ea5, -1, -1, 0x0eeb, -1, -1,
+	-1, -1, 0x0f45, 0x153b, -1, 0x0eb0, 0x09c5, -1, -1, 0x127c, -1, 0x153a, 0x03e5, 0x0793, 0x0d52, 0x0b92,
+	0x0947, -1, -1, -1, -1, 0x0495, -1, -1, -1, -1, -1, -1, -1, -1, 0x09eb, 0x0e2f,
+	-1, 0x0890, 0x07fa, 0x05b6, -1, -1, -1, 0x0bf4, -1, 0x1545, -1, -1, -1, 0x1542, 0x093c, -1,
+	-1, 0x153e, -1, -1, -1, -1, 0x0556, 0x08dc, -1, -1, 0x153f, 0x0b8c, -1, -1, -1, -1,
+	0x0f85, -1, 0x05df, -1, -1, -1, -1, 0x063d, 0x0db9, 0x05f3, 0x1544, -1, 0x11c5, -1, 0x1540, 0x0e6c,
+	0x0c5d, -1, 0x0bc4, -1, -1, -1, 0x1093, -1, 0x0951, -1, -1, -1, -1, -1, -1, -1,
+	0x0ab2, -1, 0x0e5c, 0x04f7, -1, 0x08d2, -1, 0x0997, 0x0ec5, 0x1059, 0x058a, -1, 0x1543, 0x153c, 0x1546, -1,
+	0x19dd, -1, -1, 0x0a8f, 0x1541, -1, -1, 0x121f, 0x05a6, -1, 0x04d3, -1, 0x1547, -1, 0x1551, -1,
+	-1, -1, -1, -1, 0x154c, -1, 0x1550, -1, -1, 0x0cff, -1, -1, -1, 0x12a0, 0x154e, 0x0b27,
+	0x0e80, -1, 0x04c4, -1, -1, -1, 0x109d, -1, -1, -1, -1, -1, -1, -1, 0x154d, -1,
+	0x154a, 0x0f34, -1, 0x0546, -1, -1, -1, -1, -1, 0x096a, 0x091d, -1, -1, 0x08d1, -1, -1,
+	-1, -1, 0x1548, -1, 0x114d, -1, 0x153d, -1, 0x1549, -1, -1, -1, -1, 0x09de, -1, 0x154b,
+	0x04d2, 0x0708, 0x0a86, -1, -1, 0x08c0, -1, -1, -1, -1, -1, 0x1554, 0x1557, -1, -1, 0x048a,
+	0x0543, -1, -1, 0x0589, 0x0d25, -1, -1, -1, -1, -1, -1, 0x1555, 0x0e10, -1, 0x0702, -1,
+	0x1556, 0x1559, -1, -1, -1, -1, 0x1558, -1, -1, -1, 0x0e61, -1, 0x0400, 0x058d, -1, -1,
+	-1, -1, -1, -1, 0x17b9, -1, -1, -1, -1, -1, 0x0fce, -1, -1, 0x04ca, -1, 0x19e0,
+	-1, 0x1553, -1, -1, 0x0d62, 0x1552, 0x03f9, 0x1080, 0x0476, -1, 0x0e4e, -1, -1, -1, -1, -1,
+	-1, -1, 0x154f, -1, 0x0de3, -1, -1, -1, 0x11a9, -1, -1, -1, -1, -1, 0x155a, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x057c, -1, 0x0b45, -1, -1, -1, 0x155c, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, 0x0b3d, 0x0b40, 0x1560, -1, -1, -1, -1, -1,
+	-1, -1, 0x0a4b, -1, 0x155b, 0x094e, -1, 0x0c11, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, 0x0504, 0x0d15, -1, -1, 0x0df9, 0x155d, -1, -1, 0x1563, -1, -1, -1, -1, 0x1271, -1,
+	-1, -1, -1, -1, 0x04f6, -1, -1, -1, -1, 0x0a42, -1, -1, 0x0c8a, 0x0484, 0x0588, -1,
+	0x126a, -1, -1, -1, -1, 0x0b8b, -1, 0x1561, 0x1562, -1, 0x1564, -1, 0x0792, -1, -1, -1,
+	0x1565, -1, 0x09fd, -1, -1, 0x0d42, -1, -1, -1, -1, -1, -1, -1, 0x04b8, 0x0ca5, -1,
+	0x1566, -1, 0x0c9b, -1, -1, -1, -1, 0x1567, 0x19e1, -1, -1, -1, -1, -1, 0x0e12, -1,
+	-1, -1, 0x1569, -1, 0x1568, -1, 0x04a0, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x0bc9, -1, -1, -1, -1, -1, -1, -1, -1, 0x156a, -1, -1, -1, -1, -1, -1,
+	-1, -1, 0x117b, -1, -1, -1, -1, -1, 0x0ce0, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, 0x156b, -1, -1, -1, -1, -1, 0x094f, -1, -1, 0x156c, 0x1206,
+	-1, -1, -1, -1, 0x1996, 0x19f5, 0x0db4, -1, 0x131b, 0x06e3, -1, 0x0724, -1, -1, 0x0684, 0x11fd,
+	-1, -1, -1, -1, -1, 0x0749, -1, -1, 0x0fc6, 0x1d6c, -1, -1, 0x05cf, -1, -1, 0x0b33,
+	-1, 0x0927, -1, -1, -1, 0x19f6, 0x03dc, -1, -1, 0x08cb, -1, 0x0a31, -1, 0x0455, 0x04e7, -1,
+	-1, -1, 0x06f1, 0x0d21, -1, -1, 0x0637, -1, -1, -1, -1, 0x19f7, 0x0913, -1, -1, -1,
+	0x0ddc, -1, 0x0c82, -1, 0x11fa, -1, -1, 0x06b2, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x0f23, -1, -1, -1, 0x11aa, 0x0472, -1, -1, -1,
+	0x1a52, 0x03fe, -1, 0x1a54, -1, -1, -1, 0x0616, -1, 0x0a4e, -1, 0x1df9, 0x0fd0, -1, -1, 0x11e4,
+	-1, 0x0f32, -1, -1, 0x08ee, 0x0521, -1, 0x06ca, -1, 0x11b5, -1, 0x1abc, -1, 0x062e, -1, 0x0df8,
+	0x0fe1, -1, -1, -1, -1, -1, -1, -1, -1, 0x067c, -1, -1, 0x1a55, 0x0d88, -1, -1,
+	-1, 0x0bd3, -1, 0x1a58, 0x1a57, 0x0aa2, 0x1a56, -1, -1, -1, -1, 0x101c, 0x1a59, -1, 0x1a5a, 0x12a4,
+	-1, -1, 0x1a5b, -1, -1, -1, 0x1a5c, 0x0c4b, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x0f3d, -1, 0x0867, -1, -1, 0x0cf4, 0x05a8, 0x0928, 0x121b, 0x1186, -1, -1, 0x102d, 0x1010, 0x1997, 0x1998,
+	0x1999, 0x0794, -1, -1, -1, -1, 0x0d90, 0x09b4, -1, -1, 0x0ef9, -1, -1, -1, -1, -1,
+	0x199f, -1, 0x03d9, 0x199d, -1, -1, 0x09c4, -1, -1, -1, 0x199a, -1, 0x04dc, -1, 0x0b36, 0x0827,
+	-1, -1, -1, 0x10b6, 0x0f53, 0x199e, -1, -1, -1, 0x199b, -1, -1, -1, 0x19a2, -1, 0x0fe6,
+	0x10f2, -1, -1, -1, -1, 0x0559, -1, 0x0b00, 0x12b1, -1, -1, -1, -1, 0x11d0, -1, 0x0da6,
+	-1, 0x19a4, -1, -1, 0x19a3, 0x19a6, 0x19a5, -1, -1, -1, -1, -1, 0x1246, -1, 0x0f93, -1,
+	-1, 0x19aa, -1, 0x080d, -1, -1, -1, -1, -1, -1, -1, 0x08f9, 0x0d4d, -1, -1, 0x19ab,
+	-1, -1, 0x0d38, 0x0fbd, 0x19a9, 0x116d, 0x19ac, 0x19ae, -1, -1, 0x0eec, -1, -1, -1, -1, 0x19a8,
+	-1, 0x19ad, -1, -1, 0x0f4d, -1, 0x081d, -1, 0x04fe, -1, -1, -1, -1, -1, 0x0c34, 0x090f,
+	0x0f59, -1, -1, -1, 0x0ca6, -1, 0x0906, 0x19af, -1, -1, 0x1226, -1, -1, -1, 0x0a3f, -1,
+	-1, -1, 0x117c, -1, 0x19b0, -1, -1, 0x0f7d, -1, -1, -1, -1, 0x19b1, -1, -1, -1,
+	-1, 0x0dcd, -1, -1, -1, -1, 0x0bae, 0x03d4, -1, -1, -1, -1, -1, 0x19b3, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x19b2, 0x1d7e, -1, -1, -1, -1, -1, 0x0b59, -1,
+	-1, -1, -1, -1, 0x0428, -1, -1, -1, -1, 0x17de, -1, -1, -1, -1, 0x19b4, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0dce, -1, 0x19b5, 0x19b6, 0x0c37, -1, -1,
+	-1, -1, -1, -1, -1, -1, 0x19b7, -1, -1, 0x19b8, -1, -1, -1, -1, -1, -1,
+	0x115a, -1, 0x0cb9, 0x1096, 0x071b, -1, -1, 0x19a1, -1, 0x04bb, -1, -1, 0x0adb, -1, 0x1197, 0x0e85,
+	0x12ad, -1, -1, -1, -1, -1, -1, -1, 0x1161, 0x110f, 0x1a02, 0x0bf2, -1, 0x06bd, -1, -1,
+	0x1a13, -1, -1, -1, 0x0df6, 0x1a1d, -1, 0x09e9, -1, -1, -1, 0x0efa, -1, 0x04ee, -1, 0x0c39,
+	-1, -1, -1, -1, -1, -1, 0x1a3b, -1, 0x0b5d, -1, 0x0f13, 0x0b48, 0x043d, 0x119c, -1, 0x0dd4,
+	-1, 0x124b, -1, -1, 0x0c32, 0x0643, -1, -1, -1, -1, 0x0839, -1, -1, 0x0fff, -1, -1,
+	0x0d30, -1, 0x1172, 0x0cc7, -1, -1, 0x06ea, -1, 0x18dd, 0x0d3b, -1, -1, 0x18da, -1, 0x0a0d, 0x0ff2,
+	0x04a3, 0x0584, -1, 0x18db, -1, -1, 0x11c7, -1, -1, -1, -1, -1, 0x0624, -1, 0x18dc, 0x0dd7,
+	0x06fb, 0x0e94, -1, -1, -1, 0x09d3, -1, -1, 0x106d, 0x18de, 0x18e1, -1, -1, 0x079b, -1, 0x042c,
+	0x08db, -1, 0x199c, 0x18e2, -1, 0x18e5, -1, 0x18ea, -1, -1, -1, -1, 0x18eb, -1, 0x0e08, 0x0404,
+	-1, 0x084a, -1, -1, 0x0738, -1, -1, 0x18e0, -1, 0x0ef6, -1, 0x18e9, -1, -1, -1, -1,
+	0x0f55, -1, -1, -1, -1, 0x11ec, -1, 0x0a55, 0x18e3, -1, 0x0af5, -1, 0x077a, 0x1205, 0x18e7, -1,
+	-1, -1, 0x0dbf, 0x1185, -1, 0x18df, -1, 0x18e4, 0x18e6, -1, 0x0c78, 0x06a2, -1, 0x18e8, -1, 0x099c,
+	0x18f1, -1, -1, 0x18f6, -1, 0x18f4, 0x087b, 0x086e, 0x18fa, -1, -1, -1, -1, -1, -1, -1,
+	-1, 0x18fd, -1, 0x18f9, 0x0479, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x03f7,
+	0x0b52, 0x06eb, 0x0c41, 0x0cdd, 0x0d00, -1, -1, -1, 0x18ee, 0x18f3, 0x18f5, -1, 0x0770, 0x18f7, 0x12b4, -1,
+	0x0b9a, -1, 0x18fb, -1, -1, 0x04c8, -1, -1, -1, 0x18f0, -1, -1, 0x088c, -1, -1, 0x0981,
+	0x18ec, 0x1258, -1, 0x0a77, 0x04d0, -1, -1, -1, -1, -1, -1, -1, -1, 0x18fe, -1, 0x0da0,
+	0x18f8, -1, -1, -1, -1, 0x11a3, -1, 0x0469, 0x11ba, 0x18ed, 0x18ef, 0x060f, 0x18f2, -1, 0x18fc, 0x09d7,
+	-1, 0x0dd6, -1, 0x0de7, -1, -1, 0x0c3b, 0x0a14, -1, -1, -1, -1, -1, 0x1908, -1, -1,
+	-1, 0x0fc2, -1, -1, -1, -1, -1, -1, -1, 0x1911, 0x1249, -1, -1, -1, -1, -1,
+	-1, -1, 0x18ff, 0x1900, -1, -1, -1, 0x1078, 0x07aa, 0x0718, -1, -1, 0x0710, 0x1173, 0x190e, -1,
+	0x190d, 0x190b, 0x076f, 0x0e71, 0x1905, 0x0f05, 0x09b1, -1, 0x03d7, 0x1910, 0x190f, -1, 0x127f, -1, 0x1903, -1,
+	0x0ea8, 0x0d22, -1, 0x07f7, 0x08da, 0x1909, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x1901, 0x1902, 0x1904, 0x05b4, 0x1906, 0x0c84, 0x190a, 0x190c, 0x08af, 0x126d, -1, 0x1917, -1, -1, -1, -1,
+	-1, -1, -1, -1, 0x1914, -1, 0x0eaf, 0x1915, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, 0x0a39, -1, 0x1907, -1, 0x0af6, 0x040f, -1, -1, -1, -1, -1, -1, -1, -1, 0x1913,
+	-1, -1, -1, 0x1916, -1, -1, -1, 0x0722, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, 0x0d51, -1, -1, -1, 0x0b0d, 0x0f3f, 0x0a05, -1, -1, -1, -1, 0x0e32, -1, 0x0e7c,
+	0x0fd4, -1, -1, 0x0dc0, -1, 0x1912, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x088b, -1, 0x1918, -1, -1, -1, -1, -1, -1, 0x0b1d, -1, 0x0c44, -1, 0x0776, -1, -1,
+	-1, -1, 0x0413, -1, -1, 0x1297, -1, -1, 0x084b, -1, 0x0bee, -1, -1, -1, -1, -1,
+	0x0e63, -1, -1, 0x1925, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0d2c, -1,
+	0x1921, 0x0a01, -1, -1, -1, 0x0982, -1, -1, -1, 0x191d, 0x0756, -1, 0x191a, -1, -1, -1,
+	-1, 0x1923, -1, -1, -1, 0x10ad, -1, -1, -1, -1, -1, 0x1922, -1, 0x1211, 0x1274, -1,
+	0x1926, -1, 0x08b6, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x191b,
+	0x191c, -1, -1, -1, 0x1920, -1, -1, -1, -1, -1, -1, -1, -1, 0x0ed5, -1, -1,
+	0x108b, -1, -1, -1, 0x1931, -1, -1, -1, -1, 0x1928, -1, -1, -1, 0x0549, -1, 0x055a,
+	-1, -1, 0x192a, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, 0x0fc7, -1, -1, 0x1924, -1, -1, 0x053d, -1, -1, 0x192b, 0x0a02, -1,
+	0x1929, -1, -1, 0x1938, -1, -1, 0x1937, -1, -1, -1, -1, 0x192d, -1, -1, 0x1919, -1,
+	-1, 0x1927, -1, -1, -1, -1, -1, 0x096b, 0x1930, 0x1939, -1, -1, 0x0a84, -1, -1, -1,
+	0x192e, -1, 0x06e4, -1, 0x192c, -1, 0x111b, 0x1933, 0x1934, 0x1936, -1, -1, -1, 0x1946, -1, -1,
+	-1, -1, -1, -1, 0x09e5, 0x1944, -1, -1, 0x192f, -1, -1, 0x193a, 0x0410, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x193b, 0x11a4, -1, -1, 0x193d, -1, 0x193e, -1, -1,
+	-1, 0x1940, -1, -1, 0x0a70, -1, -1, 0x0cd5, -1, -1, -1, 0x193c, -1, -1, -1, -1,
+	-1, 0x1941, -1, -1, -1, -1, -1, -1, -1, -1, 0x1942, -1, 0x1932, -1, 0x1935, -1,
+	0x07f0, -1, -1, -1, 0x193f, -1, -1, -1, -1, -1, -1, 0x0896, -1, -1, -1, 0x1943,
+	0x1945, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1949, -1, -1,
+	-1, -1, 0x194d, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x04ba, -1, 0x1947,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x066d, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x194a, 0x194b, -1, -1, -1, -1, -1, -1, 0x11c0,
+	-1, 0x0b42, -1, -1, -1, -1, -1, -1, 0x1957, -1, 0x07c0, -1, -1, -1, -1, 0x1948,
+	-1, 0x10e2, -1, -1, -1, 0x1953, -1, -1, -1, -1, -1, -1, -1, 0x1956, 0x194f, -1,
+	-1, -1, -1, -1, 0x194e, -1, -1, 0x0c80, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x1951, -1, -1, -1, -1, -1, -1, -1, 0x1958, 0x0507, -1, 0x1952, -1, -1, -1, -1,
+	-1, 0x0faf, -1, -1, -1, 0x194c, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, 0x0536, -1, -1, -1, -1, -1, -1, -1, 0x1955, -1, -1, 0x1959, -1, -1, -1,
+	0x0e53, -1, -1, -1, 0x0f6c, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1954, -1,
+	0x195b, 0x195a, -1, -1, -1, -1, -1, 0x195d, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x1950, -1, -1, -1, -1, -1, -1, -1, -1, 0x195c, -1, 0x195e, 0x0b09, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x0c76, 0x056d, 0x07f5, 0x0fdf, 0x1a42, -1, -1, 0x0bb6, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, 0x113a, -1, -1, -1, -1, 0x1a43, -1, 0x1a44, 0x0c3a, -1, -1, -1, 0x09ad, -1,
+	-1, -1, -1, 0x1a45, -1, -1, 0x1a46, 0x0fc9, -1, 0x0c77, -1, -1, 0x0707, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1a47, -1, -1, -1, -1, -1, -1,
+	-1, -1, 0x1218, 0x11fc, 0x056a, 0x049c, 0x0f43, 0x0c46, -1, -1, 0x0ee0, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0594, -1, 0x0dff, 0x087e, -1, -1, -1,
+	-1, 0x1961, 0x1962, 0x1069, 0x1964, -1, 0x0598, 0x1963, -1, 0x1032, 0x0dc1, 0x04af, -1, 0x1967, -1, -1,
+	-1, -1, 0x1965, 0x1966, -1, -1, 0x1212, -1, -1, -1, 0x1968, 0x1969, -1, -1, -1, -1,
+	-1, 0x196a, -1, -1, -1, -1, -1, -1, -1, -1, 0x196b, -1, -1, -1, -1, -1,
+	-1, -1, -1, 0x1a4e, 0x0bb8, 0x062d, -1, 0x10d2, -1, -1, -1, -1, -1, -1, -1, 0x05ed,
+	-1, 0x0818, 0x1a50, -1, -1, 0x10c2, -1, -1, -1, -1, -1, 0x0f42, -1, 0x0b57, -1, 0x0afe,
+	-1, -1, 0x061d, 0x12d8, 0x0447, 0x044e, 0x0450, 0x0bfd, -1, 0x044f, -1, 0x0aea, -1, -1, -1, -1,
+	-1, 0x11b0, -1, -1, -1, -1, -1, -1, -1, -1, 0x19e3, 0x07a0, -1, -1, -1, 0x0e59,
+	-1, -1, -1, 0x19e4, -1, 0x19e6, -1, -1, -1, 0x19e7, -1, -1, -1, 0x19e5, -1, -1,
+	-1, -1, -1, -1, -1, 0x19e8, 0x19ea, 0x19e9, -1, -1, -1, -1, -1, 0x19eb, -1, 0x0dae,
+	0x12d4, 0x0b30, -1, 0x0ae4, 0x0c57, 0x19ec, 0x0b69, -1, 0x19ed, 0x19ee, 0x19ef, 0x0694, -1, -1, -1, 0x06b9,
+	-1, 0x19f0, 0x0ca1, -1, 0x19f2, -1, 0x0783, 0x1074, 0x03d0, 0x19f1, 0x19f3, -1, -1, -1, 0x05a9, 0x0aa7,
+	0x0ca7, -1, 0x19f4, -1, 0x0ded, 0x171e, -1, -1, 0x10ff, -1, -1, -1, -1, 0x135a, -1, -1,
+	0x0ea0, 0x120c, 0x0cb2, -1, -1, -1, 0x135f, 0x0822, -1, 0x0799, 0x1721, -1, -1, -1, -1, -1,
+	0x0f6a, -1, -1, -1, 0x171f, 0x0d46, -1, 0x0798, -1, -1, -1, 0x1033, 0x1720, 0x0d09, 0x072e, 0x08ac,
+	0x0517, 0x0f3a, -1, -1, 0x0e5f, -1, -1, -1, 0x1727, 0x1728, 0x0ef3, -1, -1, -1, -1, -1,
+	0x0e4c, -1, 0x0852, -1, 0x1729, -1, 0x172a, -1, -1, 0x0ff9, -1, -1, -1, 0x0c5a, 0x0699, -1,
+	-1, 0x0c9e, 0x10a9, 0x0f35, -1, 0x1723, 0x172b, -1, 0x0d71, 0x0500, -1, -1, 0x1726, -1, -1, 0x0c42,
+	0x1724, -1, -1, 0x1ab2, 0x1725, -1, -1, -1, -1, 0x0d32, -1, 0x0be5, -1, -1, -1, 0x0734,
+	-1, 0x0afa, -1, 0x1722, 0x0bbc, 0x0a23, 0x0ab8, 0x04b6, -1, 0x172c, 0x07e5, 0x0b4c, -1, 0x172f, 0x0939, -1,
+	-1, 0x173a, 0x1734, 0x07b2, -1, -1, -1, -1, 0x068f, 0x110c, -1, 0x122d, 0x11d2, 0x0740, 0x11b3, 0x1057,
+	-1, -1, -1, -1, 0x0fd8, 0x0cb5, -1, -1, -1, 0x0cc9, 0x0493, -1, 0x0b19, -1, -1, -1,
+	0x172d, -1, -1, 0x173b, 0x172e, 0x0667, 0x1736, 0x1733, -1, -1, -1, 0x0679, -1, -1, 0x0ba1, -1,
+	0x1735, 0x0bdc, 0x0487, 0x0c5b, -1, 0x0b7f, -1, -1, 0x1261, -1, 0x0a00, 0x1738, -1, -1, 0x1739, 0x173c,
+	0x0e48, 0x1732, -1, 0x10fd, -1, 0x0442, 0x1ab3, 0x1730, 0x1731, -1, 0x1737, 0x0fd9, 0x0c22, 0x1192, 0x173d, -1,
+	-1, 0x08df, -1, -1, 0x1746, -1, -1, 0x1745, -1, -1, -1, 0x1072, 0x1742, -1, 0x1748, -1,
+	-1, -1, 0x0d16, -1, -1, -1, -1, 0x0f73, -1, 0x1747, 0x174d, 0x0ac4, -1, -1, 0x0613, -1,
+	-1, -1, -1, -1, -1, 0x08f1, -1, 0x1741, -1, -1, 0x07c8, 0x1749, -1, -1, 0x174b, -1,
+	-1, 0x065c, 0x123d, 0x1751, -1, 0x174c, -1, -1, -1, 0x1740, -1, 0x082d, 0x0edc, 0x0c61, 0x0bc8, -1,
+	-1, 0x0a76, -1, 0x1743, -1, 0x0c72, 0x08ab, 0x08bc, 0x1744, -1, 0x1286, 0x04c1, -1, 0x174a, 0x0862, 0x174e,
+	-1, 0x082a, 0x174f, 0x0ba7, 0x1750, -1, -1, -1, -1, 0x11e2, 0x0962, -1, 0x1759, -1, 0x1756, -1,
+	0x175a, -1, -1, 0x175c, -1, -1, 0x0c35, -1, -1, 0x07a5, 0x09ea, -1, -1, -1, 0x06c0, 0x1753,
+	-1, -1, -1, -1, 0x1140, -1, -1, 0x0782, 0x08fd, -1, -1, -1, 0x175b, -1, -1, -1,
+	-1, -1, 0x0ebe, -1, -1, 0x0b98, -1, -1, 0x0fba, 0x0d65, -1, -1, 0x10fe, -1, 0x0f90, -1,
+	-1, 0x1752, -1, 0x1757, 0x1758, 0x0e88, -1, -1, -1, -1, -1, 0x0e6d, -1, 0x09f3, 0x1754, 0x0a2f,
+	0x1755, 0x0f2f, -1, 0x0800, 0x05d2, -1, 0x0d12, 0x08a6, 0x11c6, 0x0d2b, 0x06c1, 0x1769, -1, -1, 0x176b, 0x1043,
+	-1, -1, 0x1099, -1, -1, 0x078c, -1, -1, 0x07b3, -1, -1, -1, -1, -1, -1, 0x1762,
+	0x05ec, -1, -1, -1, 0x128c, 0x175f, 0x0fbc, 0x175e, -1, -1, -1, 0x0a5b, 0x0e69, -1, -1, -1,
+	-1, 0x0dc6, -1, -1, -1, -1, 0x0b76, -1, 0x0e73, 0x1767, -1, -1, -1, 0x1766, 0x1760, -1,
+	0x1763, 0x05ac, -1, -1, 0x1118, -1, 0x1765, -1, -1, -1, -1, 0x10d8, 0x0582, -1, 0x07f3, -1,
+	-1, 0x0d6d, -1, 0x055d, -1, -1, -1, 0x082b, -1, 0x104c, -1, 0x0e8c, 0x1ab4, -1, -1, -1,
+	-1, -1, -1, -1, -1, 0x0ca4, -1, -1, -1, -1, 0x1147, -1, 0x176a, 0x1294, 0x1761, -1,
+	0x08a4, 0x1764, -1, -1, 0x1125, -1, 0x1768, 0x0d77, -1, -1, 0x175d, -1, -1, 0x1124, -1, -1,
+	0x0cbd, 0x0628, -1, 0x119b, 0x0492, 0x1776, -1, -1, -1, 0x0f21, -1, 0x176c, -1, 0x0f1b, -1, 0x06fa,
+	-1, -1, 0x1775, -1, 0x0989, -1, -1, -1, 0x110d, -1, 0x0b2b, -1, -1, -1, -1, -1,
+	-1, -1, -1, 0x0bc7, 0x1777, -1, -1, -1, -1, -1, -1, -1, -1, 0x0ec3, 0x176e, -1,
+	-1, -1, -1, 0x1773, 0x1774, -1, 0x07dd, -1, 0x0fa5, -1, -1, 0x11be, -1, -1, -1, 0x1771,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x176f, -1, -1, 0x176d, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0ee4, 0x0d89,
+	-1, -1, -1, 0x09c2, -1, 0x08a5, 0x1772, -1, -1, 0x06e7, -1, -1, -1, -1, -1, 0x1786,
+	0x1153, -1, -1, -1, -1, -1, -1, -1, 0x177a, -1, -1, -1, 0x0a6e, -1, -1, 0x1788,
+	-1, -1, 0x10c6, -1, -1, 0x177e, -1, 0x177f, -1, -1, 0x0f69, -1, -1, -1, -1, 0x0e1c,
+	-1, 0x1779, 0x1770, -1, 0x1784, -1, 0x0cfb, 0x1782, -1, -1, 0x0b86, 0x1781, -1, 0x1780, -1, -1,
+	-1, 0x053b, 0x1787, -1, -1, -1, -1, 0x05e0, -1, -1, -1, 0x128b, -1, -1, -1, 0x1785,
+	-1, 0x07eb, -1, 0x1291, 0x0e6e, 0x1a37, -1, 0x1783, -1, -1, 0x0775, -1, -1, -1, 0x122c, 0x1778,
+	0x177b, 0x0ad9, 0x177d, -1, 0x0aaa, 0x09e3, 0x0aaf, -1, 0x0474, 0x0e51, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, 0x05cd, -1, -1, -1, -1, 0x178e, -1, -1, -1, -1, -1, -1,
+	-1, -1, 0x0c0e, -1, -1, -1, 0x0c40, -1, -1, 0x1794, -1, -1, -1, -1, -1, 0x0a88,
+	-1, -1, -1, 0x0a0b, 0x105c, 0x178d, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x0b4d, -1, -1, -1, 0x178c, -1, -1, -1, -1, 0x1795, 0x1793, 0x0add, -1, 0x177c, -1, 0x178f,
+	-1, 0x0ddd, -1, 0x11c3, -1, -1, 0x1790, -1, -1, -1, -1, -1, -1, -1, 0x1079, -1,
+	-1, -1, -1, -1, -1, -1, 0x178a, 0x178b, -1, -1, -1, 0x1791, -1, 0x0f0a, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, 0x0bca, -1, -1, -1, 0x0c70, -1, 0x0a99, -1,
+	-1, -1, 0x1789, -1, -1, -1, 0x0a49, -1, -1, -1, -1, -1, -1, 0x0e55, 0x04f0, -1,
+	-1, -1, 0x179a, -1, 0x1792, -1, -1, -1, 0x1799, -1, 0x179c, -1, 0x179b, -1, -1, -1,
+	-1, -1, -1, -1, 0x050d, -1, -1, -1, 0x04f9, 0x1796, -1, -1, 0x1798, 0x1797, 0x0beb, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x09dc, -1, -1, -1,
+	-1, 0x1187, -1, -1, -1, -1, -1, 0x17a1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, 0x03e3, -1, -1, 0x17a3, -1, -1, 0x17a2, -1, -1, -1, -1, -1, -1,
+	0x0843, -1, 0x17a4, -1, -1, -1, -1, -1, -1, 0x17a0, -1, -1, -1, -1, -1, -1,
+	-1, 0x179d, 0x0473, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x17a7, -1,
+	0x17a8, 0x17a5, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x17a6, 0x17a9,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, 0x0c38, -1, -1, -1, -1, -1, -1, -1, -1, 0x17aa, 0x17ac, -1, -1, -1, -1,
+	-1, -1, -1, 0x17ab, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, 0x17ae, -1, -1, -1, 0x17ad, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x075f, -1, -1, 0x17af,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x17b0, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x082f, 0x1a80, 0x0b2f, -1, 0x05c5,
+	0x0811, -1, -1, -1, -1, 0x0a68, 0x118d, -1, 0x0923, -1, -1, -1, 0x1285, -1, 0x1175, 0x04b2,
+	0x1a5d, -1, -1, -1, -1, 0x19a0, -1, -1, -1, 0x0a8e, 0x0555, -1, -1, -1, 0x1056, -1,
+	-1, -1, 0x04f3, -1, 0x0cd1, 0x097a, 0x1a5f, -1, -1, 0x122a, -1, -1, 0x1a5e, 0x1a60, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1a64, 0x0945, 0x0e5e, 0x0bd9, 0x091b,
+	-1, 0x1a65, -1, 0x047d, -1, -1, -1, 0x1a63, 0x11a7, 0x05e2, -1, 0x1a61, 0x0a35, 0x0522, -1, -1,
+	0x1a62, 0x0df7, 0x09e2, 0x0e9d, -1, -1, -1, -1, 0x0a51, -1, 0x1a67, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, 0x07c5, 0x09f2, -1, 0x1251, -1, -1, -1, 0x104b,
+	-1, -1, -1, -1, 0x097d, -1, 0x0672, 0x0d54, 0x1a66, 0x0821, -1, 0x0e6b, 0x08fc, 0x0ce7, -1, 0x0f68,
+	-1, -1, -1, -1, -1, -1, -1, 0x0ee8, -1, 0x0bea, -1, -1, -1, 0x06a8, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1048, 0x0797, -1, -1, -1, -1, -1,
+	0x1a68, -1, -1, 0x1a69, -1, 0x07ff, 0x1a6a, -1, 0x1a81, 0x0439, 0x0698, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, 0x08b8, -1, -1, -1, -1, -1, -1, -1, -1, 0x1a6b,
+	0x1065, 0x1a6c, -1, -1, -1, -1, 0x0cda, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, 0x1a70, -1, -1, -1, -1, 0x1a72, -1, 0x080c, -1, 0x0884, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1a6e, -1, 0x0d36, -1,
+	-1, -1, -1, -1, 0x0af9, -1, 0x1a82, 0x11d4, 0x1a6f, -1, -1, -1, -1, -1, 0x1252, -1,
+	-1, -1, 0x1a71, 0x1a6d, -1, -1, -1, -1, 0x1a73, -1, 0x1a74, -1, -1, 0x0d3e, -1, -1,
+	-1, -1, -1, -1, 0x0f67, -1, -1, -1, -1, -1, 0x0ffb, -1, -1, -1, -1, 0x102b,
+	-1, -1, -1, -1, 0x0cfa, -1, -1, -1, 0x1a75, 0x0f54, -1, -1, -1, -1, -1, 0x0dcb,
+	0x1a79, -1, -1, -1, -1, -1, -1, -1, 0x1a78, -1, -1, -1, 0x03dd, -1, -1, -1,
+	-1, -1, -1, 0x1a76, -1, 0x1a77, -1, -1, -1, 0x1a83, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, 0x0cdb, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0a46, -1,
+	-1, -1, -1, -1, 0x1a7b, 0x105f, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x1a7a, -1, -1, -1, -1, 0x118e, -1, 0x1a7c, -1, -1, -1, -1, -1, -1, 0x1405, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1a7d, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, 0x042b, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1a7e, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, 0x1a7f, -1, 0x1265, -1, 0x0bbf, -1, -1, -1,
+	0x19fb, 0x03cd, -1, -1, -1, 0x0953, 0x06d7, 0x108e, 0x03f5, 0x05f8, -1, 0x12d2, -1, 0x0deb, -1, 0x171c,
+	-1, -1, -1, -1, -1, -1, -1, 0x0c0b, 0x0405, -1, -1, -1, 0x0bc5, 0x19f8, -1, -1,
+	-1, -1, 0x19f9, -1, -1, -1, 0x19fa, -1, -1, 0x103f, -1, 0x0ba2, -1, 0x19d1, -1, 0x0b51,
+	-1, 0x0b54, 0x09ed, -1, -1, -1, 0x19d2, 0x0b60, -1, 0x0f4e, -1, -1, -1, -1, 0x19cf, 0x19d3,
+	-1, -1, 0x0d7b, -1, -1, 0x0c62, -1, -1, -1, 0x0e77, 0x0f5d, -1, -1, -1, 0x19d4, 0x19d5,
+	0x0f6b, 0x0a06, -1, -1, 0x19d6, -1, -1, -1, -1, -1, 0x061e, 0x19d7, -1, 0x19d8, -1, 0x19d9,
+	-1, -1, 0x19da, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x19d0,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0ccd, 0x166a, -1, 0x0677,
+	0x166b, -1, -1, -1, 0x166c, -1, 0x1273, 0x166d, 0x166e, 0x110b, -1, -1, -1, -1, -1, -1,
+	-1, 0x1670, 0x09b0, 0x166f, 0x05d1, -1, -1, -1, 0x0436, -1, -1, -1, -1, 0x1672, 0x1671, -1,
+	0x07db, -1, 0x1673, -1, -1, -1, -1, 0x0736, -1, 0x0930, -1, -1, -1, -1, 0x0b9b, -1,
+	0x07bc, 0x08c5, -1, -1, -1, -1, -1, -1, 0x1674, 0x1676, -1, -1, 0x061f, 0x0f80, 0x0d87, 0x1675,
+	0x11f7, 0x113b, 0x1677, 0x167b, 0x1678, -1, -1, 0x1679, 0x0a09, -1, 0x167d, 0x1680, 0x09e6, -1, -1, -1,
+	-1, 0x167a, -1, 0x167c, -1, -1, -1, -1, -1, -1, 0x1684, -1, -1, -1, 0x0a53, -1,
+	-1, -1, -1, 0x1682, -1, 0x1687, 0x04dd, 0x1681, -1, -1, -1, 0x0b0c, 0x04a1, 0x1686, 0x1685, -1,
+	-1, 0x1683, 0x1688, -1, -1, 0x168a, -1, -1, -1, 0x0fe8, 0x124c, 0x0ae7, 0x168b, -1, 0x0f96, -1,
+	-1, 0x168d, -1, -1, 0x07ce, -1, -1, 0x195f, 0x168c, 0x1689, -1, -1, -1, -1, 0x07ea, 0x1152,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x168f, -1, -1,
+	0x168e, -1, 0x1960, -1, -1, -1, -1, 0x1690, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x1691, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1692, 0x0e40, -1, 0x1693,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1694, -1,
+	-1, -1, -1, -1, 0x101d, -1, -1, 0x0aa9, -1, 0x1130, -1, 0x0ef4, -1, -1, 0x189f, -1,
+	-1, 0x18a0, -1, -1, -1, -1, 0x0920, -1, -1, -1, -1, 0x0ac9, -1, -1, -1, 0x18a3,
+	-1, -1, 0x18a2, -1, -1, -1, -1, -1, -1, 0x0ee5, -1, 0x0af4, -1, -1, 0x18a1, 0x07f6,
+	0x0f95, -1, 0x0a60, 0x18a8, -1, -1, -1, 0x18a7, -1, -1, 0x18b3, 0x0482, -1, -1, -1, -1,
+	0x18a9, -1, 0x18a5, -1, -1, -1, -1, -1, 0x18ab, 0x18aa, 0x0d39, -1, -1, 0x11e3, -1, 0x18a4,
+	0x0668, 0x18a6, -1, -1, -1, -1, -1, -1, -1, 0x18ad, -1, -1, -1, -1, 0x18b2, -1,
+	0x1248, -1, -1, -1, -1, 0x18ac, -1, 0x18b1, -1, 0x18b0, -1, -1, -1, 0x03ff, -1, -1,
+	-1, -1, 0x18b4, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, 0x0cb1, -1, 0x09e4, 0x0a0c, -1, -1, 0x0a6f, 0x18af, -1, -1, -1, -1, 0x18b5,
+	0x0e35, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x18c1, 0x18c0, -1, -1, -1, -1,
+	-1, -1, 0x1280, -1, -1, 0x18b9, 0x18b8, -1, 0x18ba, -1, 0x18b6, -1, 0x18bd, -1, 0x18bc, -1,
+	0x18bb, -1, -1, 0x0a54, 0x0c98, 0x0bfc, 0x0bc2, -1, -1, -1, -1, -1, 0x0cac, -1, -1, -1,
+	-1, 0x18c2, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, 0x18c5, -1, 0x18c4, -1, 0x18c6, 0x07d6, 0x18b7, 0x18c3, -1, 0x0d0f, 0x0d29,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x18c8, -1, -1,
+	0x0764, -1, -1, -1, -1, -1, 0x107d, 0x18c7, -1, -1, -1, -1, -1, -1, 0x18c9, -1,
+	0x18cc, 0x18cd, -1, 0x0a26, -1, -1, -1, 0x18ce, -1, -1, -1, 0x18cf, -1, -1, 0x18cb, -1,
+	0x18d3, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x18ca, -1, 0x18d0, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x18d4, 0x18d1, 0x18d2, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x18d6, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, 0x18d5, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x074e, -1, 0x1bcb, -1,
+	0x1bcc, -1, 0x0c0f, 0x0409, 0x0cde, -1, 0x0ede, -1, -1, -1, -1, -1, -1, -1, 0x0f2c, 0x1990,
+	-1, -1, -1, -1, 0x1991, -1, 0x0c1e, 0x0568, -1, -1, -1, -1, -1, -1, -1, 0x1992,
+	-1, -1, -1, -1, 0x11e6, -1, -1, -1, -1, -1, -1, -1, -1, 0x14f8, -1, 0x1993,
+	-1, 0x1994, -1, 0x1995, -1, -1, -1, -1, 0x06e9, 0x156f, 0x0d74, -1, 0x0e8f, -1, -1, 0x0d79,
+	-1, -1, -1, -1, -1, 0x0d7a, -1, -1, 0x1102, 0x0de5, -1, 0x06c5, 0x1bcd, 0x0441, -1, 0x17b7,
+	0x0e8e, 0x1108, 0x0876, 0x0d69, -1, 0x05e6, -1, 0x0b6f, 0x05e8, -1, 0x1b0d, 0x07ec, -1, -1, 0x189d, -1,
+	0x1b0e, -1, -1, -1, -1, 0x04e8, -1, -1, 0x1b11, -1, -1, 0x1b10, 0x08e6, -1, 0x1b0f, 0x0f16,
+	-1, -1, -1, -1, 0x0bce, -1, -1, -1, -1, 0x0a73, 0x140d, 0x1b12, 0x1012, -1, -1, -1,
+	-1, -1, -1, -1, -1, 0x0ab3, 0x0c47, -1, -1, -1, 0x066b, -1, -1, -1, -1, -1,
+	-1, -1, 0x1b13, -1, 0x052a, -1, -1, -1, 0x083a, 0x1b14, -1, -1, -1, -1, -1, 0x189b,
+	-1, -1, -1, 0x1b15, -1, -1, 0x08ad, -1, -1, -1, -1, 0x1c61, -1, -1, -1, 0x0dc7,
+	-1, 0x10a8, 0x1bf9, -1, 0x1bfa, -1, 0x1bfb, 0x0a45, -1, 0x070c, 0x092d, -1, -1, 0x1bfd, -1, 0x0bb0,
+	0x1bfc, 0x1070, -1, 0x1bff, 0x03eb, 0x08e9, -1, -1, -1, -1, -1, 0x10be, 0x1bfe, -1, 0x054e, 0x06a7,
+	0x1c05, 0x1c04, 0x0c02, 0x1c00, 0x1c01, 0x0562, -1, -1, 0x1c02, 0x11ed, -1, -1, 0x0e7a, 0x0931, 0x0851, -1,
+	-1, -1, 0x1c07, 0x1c06, 0x1c03, 0x0480, -1, 0x1201, 0x10f7, 0x0916, 0x0ccb, -1, -1, 0x1c09, -1, -1,
+	-1, -1, 0x1076, -1, 0x122b, 0x07ba, 0x1c08, -1, 0x061a, -1, -1, 0x0eb3, -1, -1, 0x0c05, -1,
+	-1, -1, 0x0a20, 0x1c0a, 0x1c0d, -1, 0x1c0c, 0x1c0f, 0x1c0b, -1, 0x07fd, 0x1c0e, -1, -1, -1, -1,
+	0x0e54, 0x1c11, -1, -1, 0x0514, -1, -1, -1, -1, 0x0453, -1, -1, 0x1c12, -1, -1, 0x1c13,
+	0x1c15, 0x0580, -1, 0x1c10, -1, 0x1c16, -1, -1, -1, -1, 0x1c19, -1, 0x1c17, -1, -1, -1,
+	0x1c14, -1, -1, -1, -1, 0x1c1c, -1, 0x1c18, 0x1c1b, 0x1c1d, -1, 0x1c20, -1, -1, -1, 0x0f20,
+	0x1c23, -1, 0x1c22, -1, 0x0a75, 0x1c1a, 0x0dbc, -1, -1, 0x0590, 0x0470, 0x0e50, -1, 0x1c25, -1, -1,
+	0x1c26, -1, -1, 0x1c2b, 0x11cd, 0x1c28, -1, -1, 0x0cd2, -1, -1, -1, 0x1c21, -1, 0x1c2a, 0x1c27,
+	0x1c24, -1, -1, 0x1c29, -1, -1, -1, -1, -1, -1, -1, -1, 0x03c8, 0x1c2c, -1, -1,
+	-1, -1, -1, -1, 0x1c2e, -1, 0x1c30, -1, -1, -1, -1, -1, 0x1c2f, -1, 0x1c2d, -1,
+	-1, -1, -1, 0x1021, -1, -1, -1, -1, -1, -1, -1, 0x1c31, -1, -1, -1, 0x1c32,
+	-1, -1, -1, -1, -1, -1, -1, -1, 0x076e, -1, -1, 0x05c6, -1, 0x03f6, 0x03f8, -1,
+	-1, -1, 0x118c, -1, 0x05c3, -1, 0x08d3, 0x0809, 0x1bc6, -1, -1, 0x06fd, -1, -1, 0x1bc7, -1,
+	-1, 0x03c7, -1, 0x1bc8, -1, -1, 0x0eed, -1, -1, 0x1bc9, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, 0x1bca, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0c03, -1,
+	-1, 0x1244, 0x1c63, -1, 0x1c64, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0b32,
+	-1, -1, 0x111a, -1, -1, 0x1230, 0x0be7, -1, 0x10ee, -1, 0x10c5, -1, -1, 0x1b20, 0x03da, 0x11b4,
+	0x104d, 0x087f, 0x07af, -1, 0x09b7, -1, 0x06e6, 0x05c0, 0x0bcb, -1, -1, 0x0d81, -1, -1, -1, 0x0b0a,
+	-1, -1, -1, -1, -1, 0x1b21, -1, -1, -1, -1, -1, -1, -1, -1, 0x0b5e, 0x0601,
+	-1, 0x1aeb, 0x0ae3, -1, 0x1210, -1, -1, -1, 0x0f9f, 0x1aee, -1, -1, 0x0bcd, -1, 0x063b, -1,
+	-1, 0x0d80, -1, -1, 0x1aec, -1, -1, 0x1aef, 0x1af0, 0x0afb, -1, 0x0973, -1, 0x1aed, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1af3, 0x1af1, -1, -1, -1, -1, 0x11e5,
+	0x0b1e, -1, 0x1af2, -1, -1, -1, 0x1af5, -1, 0x11a2, 0x1022, -1, -1, -1, 0x1af4, -1, 0x0b0f,
+	-1, -1, -1, -1, -1, 0x1af6, 0x09b3, 0x094b, 0x1af7, -1, 0x0e96, -1, 0x105a, -1, -1, -1,
+	0x1284, 0x11f5, -1, 0x1afb, -1, -1, -1, 0x1afa, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x1af8, 0x1af9, -1, -1, -1, -1, -1, -1, -1, -1, 0x1afc, 0x0905, -1, -1, -1, -1,
+	-1, 0x0dee, 0x1b00, 0x061c, -1, 0x1b01, 0x0b5f, -1, 0x1afd, -1, -1, 0x08dd, 0x04a6, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0622, -1, -1, -1, 0x1b04, 0x12d9, 0x1b02,
+	0x1b05, -1, -1, -1, 0x0b28, 0x0531, -1, -1, -1, -1, -1, -1, 0x1b06, 0x1b03, 0x0f78, -1,
+	-1, 0x1b07, 0x0ad6, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1b08,
+	0x1b09, -1, 0x150a, -1, -1, 0x0c12, -1, 0x0c85, -1, 0x1254, 0x05c8, -1, 0x0df1, -1, -1, -1,
+	0x1b0a, -1, -1, 0x0eaa, -1, 0x1b0b, -1, -1, -1, -1, -1, 0x11af, -1, 0x1b0c, -1, 0x1e23,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1406, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x0542, -1, -1, -1, 0x0aeb, 0x1c65, -1, -1, -1,
+	-1, -1, 0x0d97, 0x10b2, -1, 0x1209, -1, 0x1bb1, -1, 0x0937, -1, 0x08c2, 0x1bb2, 0x062b, 0x03ca, -1,
+	-1, -1, -1, 0x0d8e, -1, -1, 0x1ab5, -1, 0x1ab6, -1, -1, -1, -1, 0x0f58, 0x066e, 0x09b2,
+	0x1ab7, 0x0aca, 0x0d2f, -1, -1, -1, -1, -1, -1, 0x1ab8, -1, -1, 0x0c55, 0x0972, -1, -1,
+	-1, 0x1abb, 0x0bf7, -1, 0x104f, -1, 0x1268, 0x1ab9, 0x1aba, -1, 0x1061, -1, 0x1abe, 0x1abf, -1, 0x1ac3,
+	-1, -1, -1, 0x1ac7, -1, 0x1ac5, 0x1acd, 0x11e7, -1, 0x1ac8, -1, -1, 0x1ac6, 0x1abd, -1, -1,
+	0x0be8, -1, -1, -1, 0x0c25, -1, -1, 0x0d68, 0x1171, 0x1ac0, 0x1ac1, 0x1ac2, 0x1ac4, -1, 0x0a18, -1,
+	0x0540, -1, -1, -1, -1, 0x0767, -1, 0x1acf, -1, -1, -1, -1, 0x1ad0, -1, 0x1ac9, -1,
+	0x1ace, -1, 0x0f57, -1, -1, 0x0df5, 0x1acb, 0x1acc, -1, -1, -1, -1, -1, 0x0fb4, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1ad1, 0x0a71, 0x10f1, 0x1aca, 0x0cd6, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x088e, -1, -1, -1, -1, 0x0bef, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x1ad5, -1, 0x05ee, -1, -1, 0x0a94, 0x03cc, 0x0e28, -1,
+	-1, 0x042d, -1, 0x1ad3, -1, -1, -1, 0x0eea, 0x05e1, -1, 0x1ad4, 0x1ad2, 0x1ad6, -1, -1, 0x05f9,
+	-1, 0x1ad7, -1, 0x1ad8, -1, 0x1adb, -1, 0x044b, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x0bf5, 0x088d, 0x1ad9, 0x0e5b, 0x04c9, -1, -1, -1, -1, 0x1ada, -1, -1, -1, -1, 0x0b8a, -1,
+	-1, 0x0564, -1, -1, -1, 0x0414, -1, -1, -1, 0x1ade, 0x09f9, 0x0587, -1, -1, -1, -1,
+	0x0bcc, -1, -1, -1, 0x1adc, 0x0983, -1, -1, -1, 0x1add, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, 0x0b44, -1, -1, -1, 0x1adf, -1, -1, -1,
+	-1, -1, 0x1ae0, -1, 0x1ae2, -1, -1, 0x0a56, -1, -1, 0x0806, -1, -1, -1, -1, -1,
+	-1, 0x08b7, -1, -1, -1, 0x1ae1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, 0x1ae3, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1ae5, -1,
+	-1, -1, -1, -1, 0x1ae4, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, 0x1ae6, -1, -1, -1, -1, -1, 0x0d9d, 0x1a89, 0x0a10, -1, 0x0d66, -1,
+	0x1a8a, 0x0c4d, -1, -1, -1, -1, 0x1a8b, -1, 0x0c4c, 0x1a8c, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, 0x1a8f, -1, -1, 0x12a5, 0x1a92, -1, -1, 0x1a90, 0x1a8d, 0x1a8e, 0x1262, 0x0d70, 0x0e2d,
+	0x1a93, -1, 0x1a91, -1, -1, 0x0fa8, -1, 0x1a95, 0x0c10, -1, -1, -1, -1, 0x085f, -1, 0x1a94,
+	-1, -1, -1, -1, -1, -1, -1, 0x05ba, 0x0835, -1, 0x1a96, -1, -1, -1, -1, -1,
+	0x1376, 0x08fa, -1, -1, 0x0a9a, 0x1a97, -1, -1, -1, -1, 0x1a98, -1, -1, -1, -1, 0x06c2,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1a99, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x1a9a, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, 0x1a9b, -1, -1, -1, -1, -1, 0x112c, 0x12cd, 0x0a0a, -1, 0x0c9c, 0x07ab, -1,
+	0x1002, 0x0dfc, -1, 0x0eb9, -1, -1, 0x06f0, -1, -1, 0x047b, -1, 0x0cae, -1, 0x1235, -1, -1,
+	-1, 0x0985, 0x0b2a, -1, -1, 0x1bb4, -1, -1, 0x0b17, -1, -1, -1, -1, -1, -1, 0x12a1,
+	-1, -1, -1, 0x1bb6, 0x0512, -1, 0x0c97, 0x106c, -1, 0x1227, -1, 0x1bb7, -1, 0x1bb5, -1, 0x083c,
+	0x0505, -1, -1, -1, -1, -1, -1, -1, 0x08d4, -1, -1, 0x10a5, -1, 0x081f, -1, -1,
+	0x0f60, -1, 0x1bbb, 0x1bba, -1, -1, 0x1bb8, -1, -1, -1, -1, 0x050b, -1, 0x0d53, 0x0def, -1,
+	-1, -1, -1, -1, 0x1bbd, -1, -1, 0x03fd, -1, -1, 0x1228, -1, -1, -1, 0x1bbc, -1,
+	0x052c, -1, -1, 0x1e34, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, 0x0f27, -1, -1, -1, 0x1bc1, -1, 0x1bc0, -1, 0x05bd, 0x0879, 0x083b, -1, 0x0704,
+	-1, -1, -1, -1, -1, -1, 0x0b61, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, 0x1bc2, -1, -1, -1, -1, -1, 0x0e2a, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x1bc5, -1, -1, -1, 0x1027, -1, 0x091e, 0x0cad, 0x1c35, 0x1c36, 0x0994, -1, -1, -1, -1, 0x0548,
+	0x1c37, 0x0eba, -1, 0x0c93, 0x11ac, -1, 0x1c38, -1, 0x1c39, -1, -1, -1, -1, 0x0c8e, -1, -1,
+	-1, 0x1083, 0x122e, -1, -1, 0x1c3a, 0x08d0, 0x054f, 0x091c, -1, -1, -1, 0x057b, 0x0f30, -1, 0x099e,
+	0x1c3c, -1, -1, -1, -1, 0x09b9, 0x1c3b, -1, 0x1c3e, -1, -1, -1, 0x1c3d, 0x1c3f, -1, -1,
+	-1, -1, -1, 0x1c40, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0a7d,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0a21, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, 0x0dd9, -1, -1, 0x11bd, -1, -1, -1, -1, 0x0919, 0x0918,
+	0x11c1, -1, -1, 0x0961, -1, 0x0eae, 0x1c34, -1, -1, -1, -1, -1, -1, 0x08de, -1, 0x062a,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1250, 0x1d02, -1, -1, 0x1d03, -1, 0x06ec,
+	-1, -1, -1, 0x1d05, 0x1d06, -1, 0x03e9, -1, 0x1d04, -1, 0x1d08, 0x0e30, -1, -1, -1, 0x1d0a,
+	-1, 0x0fc5, -1, -1, 0x0449, 0x1d07, -1, -1, -1, 0x1d0e, -1, 0x05d0, -1, -1, 0x1d16, -1,
+	0x1d11, -1, -1, -1, 0x1d13, 0x1d12, 0x06ba, -1, 0x043e, -1, 0x1d0d, 0x1d09, 0x05d9, -1, 0x1d0f, -1,
+	-1, 0x1d10, -1, 0x1d14, -1, -1, -1, -1, 0x1d0c, -1, 0x0882, -1, 0x0a7c, -1, 0x1d15, -1,
+	-1, -1, -1, -1, -1, 0x1d19, -1, 0x1d0b, -1, 0x05c7, -1, 0x08ed, 0x1d1b, -1, -1, 0x0663,
+	0x09af, 0x125d, 0x0eb1, -1, 0x058f, -1, 0x04be, -1, 0x1d17, -1, 0x1d18, 0x0d37, -1, 0x1d1c, -1, -1,
+	0x1d1d, -1, 0x1d20, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1d1e, -1,
+	-1, 0x1d22, 0x1d21, -1, -1, 0x1d1a, -1, 0x09a9, -1, 0x052e, -1, 0x1d1f, -1, -1, 0x0c68, -1,
+	0x0891, -1, -1, -1, -1, 0x1d2a, -1, -1, -1, -1, -1, -1, -1, 0x073e, -1, -1,
+	0x1d23, -1, -1, -1, 0x048c, 0x083d, -1, 0x0e22, -1, -1, -1, -1, 0x1d2c, 0x1d28, -1, -1,
+	-1, 0x075b, 0x1d2d, -1, -1, -1, 0x1d24, 0x1d25, 0x1d29, 0x0ac0, 0x1d2b, 0x1d2e, 0x1d27, 0x089c, -1, -1,
+	-1, 0x0fa3, -1, -1, 0x1d2f, -1, -1, -1, 0x1d26, -1, -1, -1, -1, -1, -1, -1,
+	-1, 0x1d31, -1, -1, -1, -1, 0x126c, 0x0c09, -1, -1, -1, -1, 0x1d32, -1, -1, -1,
+	-1, 0x1d30, -1, 0x0a87, -1, -1, -1, -1, -1, 0x06fc, 0x1d34, -1, -1, 0x1d33, -1, -1,
+	-1, 0x057a, -1, -1, -1, 0x1d35, 0x1d36, -1, -1, -1, 0x1d37, -1, -1, -1, 0x09d9, -1,
+	-1, 0x0a08, -1, -1, -1, -1, -1, 0x0bf0, -1, -1, -1, -1, 0x1d3a, -1, 0x1d39, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x0577, -1, -1, -1, 0x1d3d, 0x1d38, -1, -1, 0x1d3b,
+	-1, -1, -1, -1, -1, -1, 0x1d3c, -1, -1, -1, -1, -1, -1, -1, -1, 0x1d40,
+	-1, -1, -1, -1, -1, -1, 0x1d42, 0x0808, -1, -1, 0x1d41, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, 0x1d43, -1, -1, -1, -1, -1, -1, 0x049d,
+	0x1d45, 0x1d44, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x084d, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, 0x0b16, 0x1360, -1, -1, -1, -1, -1, -1, 0x09ff, 0x1d6b, 0x1290, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, 0x069a, -1, -1, -1, -1, -1, -1,
+	-1, 0x1d6d, 0x0a22, -1, -1, 0x0c28, -1, 0x0575, 0x11b2, -1, -1, -1, 0x1d6f, 0x1d6e, 0x1d70, 0x0e19,
+	-1, -1, 0x1d71, -1, 0x1160, 0x123f, -1, -1, -1, -1, 0x069f, -1, -1, -1, 0x0a37, -1,
+	-1, 0x0a3a, 0x1d72, 0x090b, -1, -1, -1, -1, -1, 0x0581, -1, -1, 0x1d73, 0x1d74, 0x090a, -1,
+	-1, 0x1d75, -1, -1, -1, 0x1d7a, -1, 0x1d76, 0x1d79, -1, 0x07dc, -1, 0x1d77, 0x1d78, -1, -1,
+	-1, -1, -1, -1, -1, 0x0701, 0x0e66, 0x1d7b, -1, 0x04b9, -1, -1, 0x0b12, -1, -1, 0x1182,
+	0x0976, -1, -1, -1, -1, -1, -1, -1, 0x1d7c, -1, -1, -1, -1, -1, -1, 0x0bb3,
+	-1, -1, -1, -1, -1, -1, -1, -1, 0x1d88, -1, -1, 0x0f74, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0f28, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x0e17, -1, 0x0e36, -1, -1, -1, -1, 0x08f3, -1, -1, -1, 0x128e, -1, -1, -1, 0x09fa,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1014, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x1d89, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, 0x1d8a, -1, -1, -1, -1, -1, -1, -1, 0x1d8b, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1ea2, -1, -1, -1, -1,
+	-1, 0x0670, -1, -1, -1, -1, -1, 0x1d8c, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, 0x12aa, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1d8d, -1, -1, -1, 0x1858,
+	0x091f, 0x1859, 0x07cb, 0x185a, 0x0f8a, 0x185b, 0x115b, 0x0855, 0x185c, 0x185d, 0x086c, 0x0cf1, 0x0f12, 0x1860, -1, 0x055e,
+	0x1861, 0x0d33, 0x06f8, 0x0b68, -1, 0x129c, 0x0ab9, 0x0696, 0x121c, 0x0f25, 0x0683, -1, -1, 0x0ba5, 0x1862, 0x0f9e,
+	0x1863, 0x1864, 0x1865, 0x0a36, 0x12a8, 0x0d6f, 0x0f77, 0x120e, 0x1234, 0x1866, 0x040c, 0x1867, 0x1868, 0x0d5b, 0x10ce, 0x090c,
+	0x1869, 0x0412, 0x0cfd, 0x08e0, 0x186a, 0x0ce5, -1, 0x186b, 0x0825, 0x0717, 0x1023, 0x186c, 0x0ac6, 0x0957, 0x08c9, 0x0eb2,
+	0x186d, 0x186e, 0x094d, 0x1004, -1, 0x0e26, 0x0e6f, 0x086b, 0x186f, 0x0847, 0x1016, 0x1870, -1, 0x1017, 0x1871, 0x1872,
+	0x0561, 0x1873, 0x1874, 0x0d7d, 0x0f0b, 0x0b1f, 0x1876, 0x0440, 0x0530, -1, 0x1877, 0x1878, 0x129a, 0x11bf, 0x1879, 0x0aab,
+	0x1279, 0x187a, 0x187b, 0x187c, 0x0889, 0x0b24, 0x09e1, 0x187d, 0x187e, 0x0848, -1, 0x187f, 0x1880, 0x1875, 0x062f, 0x1881,
+	-1, 0x1882, 0x1883, 0x07f9, 0x05dd, 0x0aa5, 0x045f, 0x1884, 0x1154, 0x1885, 0x06de, 0x1887, 0x1886, 0x06ab, -1, 0x1888,
+	0x04d7, 0x1889, 0x188a, 0x188b, 0x188c, 0x188d, 0x188e, 0x188f, 0x10e6, 0x0e34, 0x1890, 0x1891, 0x1892, 0x1893, 0x0d48, 0x1894,
+	0x1895, 0x1896, 0x1897, 0x1898, 0x08c8, 0x1899, 0x1cfd, -1, 0x06f6, -1, 0x0cd0, -1, -1, -1, -1, -1,
+	-1, -1, 0x1cfe, -1, 0x1cff, 0x1d00, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x075d, 0x0ef7, -1, -1, 0x1307, 0x0790, -1, 0x0abd, 0x1b16, -1, 0x0662, -1, -1, -1, -1, 0x1b18,
+	-1, 0x1b17, 0x03f4, -1, -1, -1, -1, -1, 0x1b1a, 0x11d5, 0x12ae, -1, -1, -1, 0x1222, -1,
+	-1, 0x1b1c, 0x0dcf, -1, 0x1b1b, -1, -1, -1, -1, 0x1b1d, -1, -1, -1, -1, 0x1b1f, -1,
+	-1, 0x1b1e, -1, -1, -1, -1, -1, -1, -1, -1, 0x1071, -1, 0x0c7b, -1, 0x0aff, -1,
+	-1, -1, -1, -1, 0x0700, -1, -1, -1, -1, -1, 0x0a66, -1, -1, 0x1d65, 0x0ffe, 0x1d66,
+	-1, 0x0f9a, -1, -1, 0x0cd9, -1, -1, 0x1d67, -1, -1, -1, -1, -1, -1, -1, 0x1d68,
+	0x1d69, -1, 0x1d6a, -1, -1, -1, -1, -1, 0x1379, 0x071d, -1, -1, 0x17f0, 0x112f, -1, 0x1d7f,
+	-1, 0x0f2b, -1, -1, -1, 0x0520, -1, -1, -1, -1, 0x1c33, -1, 0x10cd, -1, 0x1d80, -1,
+	-1, -1, -1, -1, 0x0fa7, 0x1d81, -1, -1, 0x0c8b, -1, -1, -1, -1, -1, -1, 0x05d3,
+	0x0583, 0x1d83, -1, -1, -1, 0x1d82, 0x1d84, -1, -1, 0x1d85, -1, -1, -1, -1, 0x1d86, -1,
+	0x0791, 0x03de, -1, 0x1d87, -1, -1, -1, -1, -1, -1, -1, 0x066c, 0x10cc, -1, -1, -1,
+	0x108a, 0x09ee, -1, 0x097b, 0x19e2, 0x11de, 0x19a7, -1, -1, -1, -1, 0x1c72, 0x0657, 0x0de2, -1, -1,
+	0x0b6c, -1, 0x1c66, -1, 0x1c67, 0x071a, 0x1c68, 0x07a3, 0x1164, 0x03f1, -1, -1, 0x1c69, -1, -1, -1,
+	0x1c6a, -1, 0x1c6b, -1, -1, 0x1c6c, 0x1c6d, 0x1c6e, 0x1c70, 0x1c6f, 0x0bd4, -1, -1, -1, -1, -1,
+	-1, 0x1c71, -1, 0x0659, -1, 0x1c73, 0x108d, 0x1526, 0x0e09, -1, -1, 0x051b, -1, 0x05a1, -1, 0x0721,
+	-1, -1, 0x0b93, 0x1c74, -1, -1, 0x1c75, -1, -1, -1, 0x0a43, 0x0a7a, 0x120f, 0x1c76, -1, -1,
+	-1, -1, 0x1c77, -1, 0x0a29, -1, -1, -1, 0x0c17, -1, 0x093a, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1c78, 0x056e, -1, -1, -1, -1, -1,
+	-1, 0x1c79, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1ab1,
+	0x1ab0, -1, -1, 0x0e1f, 0x10bd, -1, 0x0e00, 0x11d7, -1, 0x0d01, -1, 0x09fe, 0x0840, -1, -1, -1,
+	-1, -1, -1, 0x1a00, -1, -1, 0x0fc3, -1, 0x1241, -1, 0x0626, 0x06f7, 0x19ff, 0x06ed, -1, 0x19fe,
+	0x04e5, 0x0748, 0x120a, -1, 0x06b3, 0x0689, -1, -1, -1, 0x0886, 0x067d, 0x1a05, -1, 0x1a06, 0x03d8, 0x098e,
+	-1, 0x1a04, 0x113c, -1, 0x1a07, -1, -1, 0x1a08, -1, -1, 0x068d, -1, 0x1a01, 0x1a03, 0x0d75, 0x1236,
+	0x11cc, 0x0fd1, 0x1a0e, 0x0f17, 0x1a0f, -1, 0x05a7, -1, -1, -1, -1, -1, 0x0432, 0x1a11, 0x0e44, -1,
+	-1, -1, -1, -1, -1, -1, 0x0bd5, 0x1a12, -1, 0x1a10, 0x0bde, 0x1a0d, 0x0d83, 0x1a14, 0x041a, -1,
+	-1, 0x07d9, -1, -1, 0x12d6, 0x1c62, -1, 0x1a09, 0x1a0a, 0x1a0b, 0x1a0c, 0x1a15, 0x181f, 0x1a18, -1, 0x09a7,
+	0x10a7, 0x1a16, 0x1a1b, 0x070b, 0x1a17, -1, 0x08b9, -1, 0x0ff7, -1, 0x03d6, -1, 0x1a1c, 0x0b7b, -1, -1,
+	-1, -1, 0x120b, -1, -1, -1, 0x057f, -1, -1, 0x0ad5, 0x0858, -1, -1, 0x1a19, 0x1a1a, 0x117f,
+	0x0c49, 0x0b73, 0x1a1e, 0x0ba6, 0x1374, -1, 0x0490, -1, 0x1a23, -1, 0x08c4, -1, -1, -1, 0x1a21, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1a22, -1, -1, 0x06cb,
+	-1, 0x0ed0, 0x1a24, -1, -1, -1, 0x1a20, -1, 0x0a32, -1, -1, -1, -1, -1, 0x0c01, -1,
+	-1, -1, -1, -1, -1, -1, 0x0e92, -1, 0x1a25, -1, 0x09cf, 0x1097, 0x1a26, -1, -1, -1,
+	0x06ce, 0x06cc, -1, 0x1a27, 0x0c7a, 0x0ef2, -1, -1, -1, 0x1a29, 0x1a2a, -1, -1, -1, -1, -1,
+	0x1a2c, -1, -1, -1, -1, 0x0fe7, -1, 0x1a31, -1, 0x1a2d, -1, -1, -1, 0x1a30, 0x0d18, -1,
+	0x107b, 0x1a2b, -1, -1, 0x1a28, -1, -1, -1, -1, 0x06d8, 0x0f98, 0x0b84, 0x1a2e, 0x1a2f, 0x0e79, 0x0ec7,
+	0x0411, -1, 0x1a35, -1, -1, -1, -1, -1, 0x1a34, -1, 0x0491, -1, -1, -1, -1, 0x06ff,
+	-1, 0x1a36, -1, -1, -1, -1, -1, -1, 0x046b, -1, -1, 0x0e64, 0x0b43, 0x0f64, -1, -1,
+	-1, -1, -1, 0x1a38, -1, -1, 0x1a41, -1, 0x0bf1, -1, 0x1a39, -1, -1, -1, -1, -1,
+	-1, -1, -1, 0x0d44, -1, -1, -1, -1, -1, -1, 0x16be, 0x1a3d, -1, -1, -1, -1,
+	0x0ecd, 0x1a40, 0x045a, 0x10f6, -1, -1, 0x10bb, -1, -1, -1, 0x1a3c, -1, 0x1a3a, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, 0x04fb, -1, -1, -1, 0x198f, -1, -1, 0x1293, -1, 0x1d4a, 0x0533, -1, -1,
+	-1, -1, -1, 0x1220, 0x1221, -1, -1, -1, -1, -1, -1, 0x11e8, 0x0929, -1, 0x1d46, -1,
+	0x1087, 0x1d47, 0x1d48, -1, 0x1d49, 0x092a, 0x111e, -1, -1, -1, -1, -1, 0x0d5f, 0x0d60, -1, -1,
+	0x1d01, -1, 0x0dc5, -1, 0x0e91, -1, -1, -1, -1, -1, -1, 0x1695, 0x0df3, -1, 0x0f47, 0x123a,
+	-1, 0x1d4c, 0x1d4d, 0x1d4e, -1, -1, -1, -1, 0x1d51, -1, 0x079c, 0x1d52, 0x0402, 0x1d4f, -1, 0x1d50,
+	0x08a1, 0x04b4, -1, 0x1d55, 0x1d56, 0x0645, 0x048f, 0x0f8e, 0x1d53, 0x054b, -1, 0x1d54, -1, -1, 0x1d57, -1,
+	-1, -1, -1, -1, 0x1d58, -1, -1, 0x0ea6, -1, 0x1d59, -1, 0x1d5a, -1, -1, -1, 0x1d5b,
+	-1, -1, -1, -1, -1, -1, -1, -1, 0x0e11, -1, 0x1d5c, -1, -1, -1, -1, 0x1d5d,
+	-1, -1, -1, -1, -1, -1, -1, -1, 0x1d5e, -1, -1, -1, -1, -1, 0x1d7d, 0x0a3b,
+	0x0887, -1, 0x0d2a, 0x105d, 0x17f4, -1, -1, -1, -1, 0x1452, 0x10b4, -1, -1, 0x1453, 0x03cb, 0x1454,
+	-1, -1, 0x08d9, -1, 0x1458, -1, -1, -1, 0x12e1, -1, 0x1456, 0x1132, -1, 0x0d55, 0x1459, 0x1455,
+	-1, 0x145a, 0x0ae1, -1, -1, -1, -1, 0x145b, 0x1464, 0x145c, -1, -1, 0x0f3e, 0x1204, -1, 0x146e,
+	-1, 0x146c, -1, -1, 0x1471, 0x08e5, 0x0a8a, -1, 0x1457, 0x146a, 0x146d, 0x145d, 0x0691, 0x03e4, 0x1466, 0x0fdd,
+	0x1460, 0x07e7, -1, 0x067b, 0x146b, -1, -1, 0x1465, 0x145e, 0x0c9a, -1, -1, -1, 0x103e, 0x145f, -1,
+	-1, 0x1469, -1, -1, 0x146f, -1, -1, 0x0f0c, 0x1461, -1, 0x1462, 0x1467, 0x1468, 0x04b3, 0x1470, 0x0e14,
+	-1, 0x1156, 0x147a, 0x147e, 0x0e45, 0x1485, -1, 0x0b26, 0x147b, -1, -1, 0x0980, 0x1478, -1, 0x0419, 0x0735,
+	0x1484, 0x1472, -1, 0x1463, 0x1475, 0x0d13, 0x099f, -1, -1, -1, -1, 0x0d3a, -1, -1, -1, 0x043c,
+	-1, 0x10e1, -1, -1, 0x1479, -1, -1, 0x1474, -1, 0x0c1a, -1, 0x147d, -1, -1, -1, -1,
+	-1, 0x1281, 0x0aee, 0x0675, 0x0c90, 0x0ae8, 0x1481, 0x1477, 0x148a, 0x1473, -1, -1, 0x147c, -1, 0x0904, 0x1476,
+	-1, 0x147f, -1, -1, 0x1482, 0x1483, -1, 0x1497, -1, -1, 0x1480, 0x14a1, 0x1486, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x088a, 0x0563, -1, -1, 0x0ae2, 0x04c6, 0x1499, -1, 0x1491,
+	-1, 0x148e, -1, 0x149b, 0x148d, 0x10cf, 0x04c7, -1, 0x0cf6, 0x0d02, 0x149a, -1, 0x148c, -1, -1, -1,
+	0x1496, -1, -1, 0x1494, -1, -1, 0x0902, 0x1493, -1, 0x04bc, -1, -1, -1, -1, -1, 0x1492,
+	0x0895, 0x1487, 0x0803, -1, 0x0a12, -1, -1, -1, -1, -1, 0x0873, 0x1488, 0x1489, -1, 0x1490, 0x1495,
+	0x1498, 0x05b3, -1, 0x0cf8, 0x0826, 0x149d, 0x149c, 0x10ea, 0x14a0, 0x14a2, 0x14a4, 0x10d0, 0x14a3, 0x14a5, 0x14a6, 0x1088,
+	-1, -1, -1, -1, -1, -1, -1, 0x07a8, 0x14a8, -1, -1, 0x14b5, 0x14b0, 0x14b3, -1, -1,
+	-1, -1, -1, -1, -1, 0x14af, 0x0c2d, -1, -1, 0x0a11, -1, -1, -1, -1, 0x0d2e, -1,
+	-1, -1, 0x148b, 0x14ad, -1, -1, -1, -1, 0x14b6, -1, -1, 0x148f, 0x14ae, -1, 0x14b7, -1,
+	0x14ab, -1, -1, -1, -1, -1, -1, -1, 0x14b8, 0x14b2, 0x14ac, 0x0b49, -1, -1, -1, -1,
+	0x14a7, 0x09d2, 0x0a2a, 0x14a9, 0x14aa, -1, 0x14b1, 0x0830, 0x14b4, 0x10e7, 0x14b9, -1, 0x14ba, 0x0ae6, -1, -1,
+	0x14d1, 0x14bb, -1, -1, -1, 0x14d0, -1, 0x073c, -1, -1, 0x0934, -1, 0x0959, -1, -1, 0x07a9,
+	-1, -1, -1, -1, 0x14c9, -1, 0x14c4, -1, 0x14be, -1, -1, -1, 0x04aa, 0x14c2, -1, 0x14ca,
+	0x0483, 0x14d4, -1, -1, -1, 0x14bd, -1, -1, -1, 0x0c2f, 0x14cf, -1, -1, -1, -1, -1,
+	0x14d3, 0x0a61, 0x0685, -1, -1, -1, -1, -1, 0x14cd, 0x14ce, -1, -1, -1, 0x14c3, -1, -1,
+	-1, 0x14bc, -1, 0x14cc, 0x0e70, -1, 0x14c8, -1, -1, -1, -1, 0x14c1, 0x0b07, 0x0c1b, 0x0f0d, 0x14cb,
+	-1, 0x14c7, -1, -1, -1, -1, -1, -1, 0x14c0, -1, -1, -1, 0x14c5, 0x0abb, -1, -1,
+	-1, -1, -1, -1, 0x10e8, 0x10e9, 0x14d2, 0x0fb3, 0x0d17, -1, -1, -1, -1, -1, -1, -1,
+	-1, 0x14e5, -1, -1, -1, -1, -1, -1, 0x14c6, -1, -1, -1, 0x14df, 0x0ac3, -1, -1,
+	-1, -1, -1, -1, -1, -1, 0x14e0, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, 0x14d6, -1, -1, -1, -1, -1, 0x1257, -1, 0x14d8, 0x14d7, 0x070f, 0x14d5, -1, -1, -1,
+	-1, 0x0c2e, -1, 0x060c, -1, -1, -1, -1, -1, 0x14e1, -1, 0x07d8, 0x1180, 0x14e6, -1, -1,
+	-1, 0x056f, -1, 0x14d9, -1, 0x09ba, 0x14e2, -1, 0x14de, -1, 0x14dc, -1, -1, -1, -1, -1,
+	-1, -1, 0x05d8, -1, -1, -1, -1, 0x14da, 0x14db, 0x14dd, -1, 0x08ae, 0x14e3, -1, 0x14e4, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x14f4, -1, 0x0b08, -1, -1, 0x0e21, -1, -1, -1,
+	-1, 0x14f1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1300,
+	-1, -1, 0x0c30, -1, 0x14f3, -1, -1, -1, 0x11f3, 0x14f2, 0x14ef, -1, -1, 0x14eb, -1, 0x14ee,
+	-1, 0x14e7, -1, -1, 0x100d, -1, -1, -1, -1, 0x0cf7, 0x14ed, -1, -1, 0x14e8, -1, -1,
+	0x14e9, 0x0e31, -1, 0x14ec, -1, -1, 0x044c, -1, -1, -1, -1, -1, -1, 0x09d5, -1, 0x085a,
+	0x14f0, -1, -1, 0x14f6, -1, 0x14f5, 0x14ea, -1, -1, -1, -1, -1, 0x0bed, -1, -1, -1,
+	0x14fa, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1503, -1, -1, 0x1502,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x14f7, -1, -1, -1,
+	-1, 0x0b2e, -1, 0x0ada, -1, -1, -1, 0x11e0, -1, -1, 0x0f14, -1, -1, -1, -1, 0x14fc,
+	-1, 0x04ab, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0b87, 0x0dbe, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x0c7d, 0x14f9, 0x14fb, 0x14fd, 0x1501, 0x03c9, 0x044d, -1, -1,
+	-1, -1, -1, 0x150b, -1, -1, -1, -1, 0x1505, 0x08b5, 0x0d0e, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, 0x1500, -1, -1, 0x1504, -1, -1, -1, -1, 0x1508, -1,
+	-1, -1, -1, -1, 0x1507, -1, -1, -1, 0x1506, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, 0x150c, -1, 0x116b, -1, -1, -1, -1, 0x1512, 0x1509, 0x150d, -1, -1, 0x09f8, -1,
+	-1, -1, -1, -1, 0x0420, 0x1515, -1, 0x1510, -1, -1, -1, -1, -1, -1, -1, 0x1511,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1025, 0x1514, -1, -1, -1,
+	-1, -1, -1, -1, 0x150e, -1, -1, -1, 0x150f, -1, 0x0fdc, -1, -1, -1, 0x1513, 0x0dcc,
+	0x1518, -1, -1, -1, -1, -1, -1, 0x1517, -1, 0x1516, -1, -1, -1, -1, -1, -1,
+	-1, 0x151a, -1, -1, -1, -1, -1, -1, -1, 0x08e4, -1, -1, -1, -1, -1, 0x04b7,
+	0x0b29, -1, -1, 0x1519, -1, 0x0bb9, -1, -1, -1, -1, -1, -1, 0x151b, -1, -1, -1,
+	-1, -1, -1, -1, 0x0e78, -1, -1, -1, -1, 0x0669, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1184, -1, -1, -1, 0x151c,
+	-1, -1, -1, -1, -1, 0x151e, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, 0x0b41, -1, -1, -1, -1, 0x1520, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x151d, -1, 0x151f, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, 0x11b9, -1, -1, -1, 0x1521, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1c8d, 0x07e1, 0x0a91,
+	0x0baf, 0x0aa6, -1, -1, 0x1c8e, -1, -1, -1, -1, -1, 0x1008, -1, -1, -1, 0x111c, -1,
+	-1, -1, 0x19fc, -1, -1, -1, -1, -1, -1, -1, -1, 0x0525, 0x1c8f, -1, 0x1c90, -1,
+	-1, 0x0d8c, -1, -1, -1, -1, -1, -1, -1, 0x07c6, 0x1c92, 0x1c94, 0x1c93, 0x0e23, 0x0f79, 0x1c91,
+	0x0d93, 0x10ae, 0x0acb, -1, -1, -1, -1, -1, -1, -1, 0x0f22, 0x1c97, 0x0415, 0x1c96, -1, -1,
+	-1, -1, -1, 0x1c9d, -1, 0x04ae, -1, -1, -1, -1, -1, -1, 0x1040, 0x1c99, -1, -1,
+	-1, -1, -1, 0x1c9b, 0x1188, -1, -1, 0x1c9a, 0x1c95, 0x1c9e, 0x1c9c, -1, 0x1c98, -1, -1, 0x1ca6,
+	0x1ca3, 0x1ca5, -1, -1, 0x1ca9, 0x1ca1, 0x1c9f, -1, -1, -1, 0x1ca4, -1, -1, -1, -1, -1,
+	0x125a, -1, -1, -1, 0x1ca0, -1, 0x0cb8, 0x0d5e, -1, 0x1ca7, 0x0745, 0x05af, -1, -1, 0x1ca2, 0x1ca8,
+	0x1caf, 0x1cb5, -1, -1, 0x0816, -1, -1, -1, 0x1cb4, 0x0edb, -1, 0x124a, -1, -1, 0x1cb1, 0x1cb3,
+	-1, -1, -1, -1, 0x0711, -1, -1, -1, -1, 0x1caa, -1, -1, -1, 0x1cad, 0x0ad8, -1,
+	0x11dc, 0x1cab, 0x1cac, 0x1cae, 0x1cb2, -1, -1, -1, 0x1cb8, 0x10fb, -1, -1, -1, -1, 0x0649, -1,
+	0x0dd0, -1, 0x06a3, 0x1cb6, -1, -1, -1, 0x1cb7, 0x1cb9, 0x1cbc, 0x1cba, -1, -1, 0x1cbb, -1, -1,
+	-1, -1, 0x1050, 0x1cb0, -1, 0x0ec8, -1, 0x0f2e, 0x1208, -1, 0x1cc2, -1, 0x0b1a, -1, 0x1cbf, -1,
+	-1, 0x09ce, 0x1ccb, 0x1cbd, -1, 0x1cc0, -1, -1, -1, 0x1cc7, -1, -1, -1, -1, 0x1cc1, -1,
+	-1, 0x1cc6, -1, -1, 0x1cc5, -1, -1, 0x1cc8, -1, -1, -1, 0x1cbe, -1, -1, 0x1cc3, 0x1cc9,
+	-1, -1, -1, -1, -1, -1, -1, 0x10eb, 0x1cc4, 0x04d4, -1, -1, 0x1cd1, -1, 0x0fca, -1,
+	-1, -1, -1, 0x1cd4, -1, -1, -1, 0x0807, -1, 0x1cd8, -1, -1, -1, -1, -1, -1,
+	0x1ccf, -1, -1, 0x1cd5, 0x1cd7, 0x1cd9, -1, -1, -1, -1, -1, -1, -1, -1, 0x1cd2, -1,
+	0x1cd0, -1, -1, -1, 0x07da, -1, 0x05fa, -1, -1, -1, -1, 0x1cce, 0x1cd6, 0x1ccc, 0x1ccd, -1,
+	-1, -1, 0x1cca, 0x1ce5, -1, 0x1ce2, -1, -1, 0x1ce1, -1, -1, 0x1cd3, -1, 0x0cf9, -1, -1,
+	-1, -1, -1, 0x1cda, -1, -1, -1, 0x1ce4, -1, -1, -1, -1, -1, -1, -1, 0x0b37,
+	-1, -1, -1, -1, -1, -1, -1, -1, 0x1cdc, -1, -1, 0x1ce6, 0x1ce8, 0x1ce3, -1, 0x1cdb,
+	-1, -1, -1, 0x1cea, -1, 0x1ce9, -1, -1, -1, -1, 0x0abc, -1, -1, 0x1ced, -1, -1,
+	0x1cef, -1, -1, -1, -1, -1, 0x1ce0, -1, -1, -1, 0x1cf0, 0x1ceb, -1, -1, -1, -1,
+	-1, 0x1cee, 0x1cdd, 0x1cec, -1, -1, -1, -1, -1, -1, -1, 0x1cf1, -1, -1, -1, -1,
+	0x1cf3, -1, -1, -1, -1, 0x1ce7, -1, -1, -1, -1, 0x1cf2, -1, -1, -1, 0x1cf4, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0fd6, -1, -1, -1, -1, 0x1cf7, -1,
+	-1, -1, -1, 0x1378, -1, -1, -1, -1, -1, -1, 0x1cf8, -1, -1, -1, -1, -1,
+	-1, -1, -1, 0x1cf6, -1, 0x0d03, 0x1cf5, -1, -1, -1, -1, 0x1cf9, -1, -1, -1, -1,
+	-1, 0x1cfa, 0x055f, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, 0x1b22, -1, -1, -1, -1, -1, -1, 0x1cfb, -1, -1, 0x1cfc, -1, -1, -1,
+	0x1029, -1, -1, -1, 0x1d4b, 0x0fe5, -1, -1, -1, -1, -1, -1, 0x0fef, 0x105b, -1, -1,
+	-1, -1, -1, -1, 0x0f8d, -1, -1, 0x08d5, -1, 0x1042, -1, -1, -1, -1, -1, -1,
+	-1, 0x07c1, 0x1668, 0x10a1, 0x1c41, 0x0498, -1, -1, 0x046c, 0x1c42, -1, 0x0d3f, 0x0503, -1, 0x1371, -1,
+	0x0de4, -1, 0x1c43, -1, -1, -1, -1, 0x1233, -1, -1, -1, -1, -1, 0x1c44, 0x1d5f, 0x1c45,
+	-1, 0x114b, 0x1c46, -1, 0x03df, 0x1d60, -1, -1, 0x1d61, -1, -1, 0x059b, -1, 0x0bda, -1, -1,
+	-1, -1, 0x0e5a, -1, -1, -1, 0x1003, -1, -1, -1, -1, -1, 0x0edf, -1, -1, -1,
+	-1, -1, 0x1c47, -1, 0x1372, -1, -1, -1, -1, -1, -1, 0x043a, -1, 0x0f6d, -1, -1,
+	-1, 0x06c3, -1, -1, -1, -1, -1, 0x1c49, -1, -1, -1, -1, 0x1c4a, -1, -1, -1,
+	-1, 0x04a2, 0x0a50, -1, -1, 0x126f, 0x1c48, -1, -1, 0x1c4b, -1, -1, -1, -1, 0x1c4d, -1,
+	-1, -1, 0x1375, -1, 0x10c0, 0x1142, -1, -1, 0x1d62, 0x0cd8, -1, -1, -1, -1, -1, 0x1d63,
+	-1, -1, 0x1c4c, 0x1c4e, 0x09a2, 0x1c4f, -1, -1, 0x1c53, -1, -1, -1, -1, -1, -1, -1,
+	0x1c55, 0x1c50, -1, 0x0d50, 0x0be0, -1, -1, -1, 0x0ac2, 0x077b, -1, -1, 0x1c52, -1, 0x1c54, -1,
+	-1, -1, 0x0752, -1, -1, -1, -1, -1, -1, -1, 0x1c5a, -1, -1, -1, -1, -1,
+	0x07b5, -1, 0x041c, 0x1c58, -1, -1, -1, -1, -1, 0x1c57, 0x1c51, 0x1c59, -1, -1, -1, -1,
+	-1, 0x1c56, -1, -1, -1, 0x0d0b, -1, -1, -1, -1, 0x0ec9, 0x1c5c, -1, -1, -1, -1,
+	0x17bc, -1, -1, -1, 0x1c5b, -1, 0x1c5d, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, 0x1c5e, -1, -1, 0x0fa4, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1d64, 0x08f2,
+	-1, -1, -1, -1, -1, -1, 0x1c5f, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1c60, -1, -1, -1, 0x0f56,
+	-1, 0x1089, -1, 0x1c7a, -1, -1, 0x06d1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, 0x089a, 0x075a, -1, 0x0765, 0x0b18, 0x0db2, 0x19c7, 0x09df, 0x0954, 0x19c8, 0x19c9, 0x19ca, -1, 0x19cb, 0x19cc,
+	0x19cd, 0x19ce, 0x08c6, -1, -1, -1, 0x1dfa, -1, -1, -1, 0x1dfc, -1, 0x1dfd, -1, 0x1dfb, -1,
+	-1, -1, -1, 0x08e1, -1, 0x1e00, 0x0544, -1, -1, -1, -1, 0x1e01, -1, -1, -1, 0x1e02,
+	-1, -1, -1, 0x1a51, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x1053, -1, -1, -1, -1, -1, -1, 0x1369, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1e03, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, 0x1b19, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, 0x11b1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, 0x113d, 0x0e7b, -1, -1, -1, -1, -1,
+	-1, -1, -1, 0x0da3, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x17bf, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, 0x1e04, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, 0x090e, -1, -1, -1, -1, -1, 0x0c08, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x1384, 0x0865, 0x0608, 0x06db, 0x0cee, 0x0844, 0x1385, 0x1386, 0x0e75, 0x0ce2, 0x1387, 0x0c5c, -1, 0x1034, 0x10c8, 0x1035,
+	0x0866, -1, 0x08b1, 0x0823, 0x1388, 0x1389, 0x1047, 0x138a, 0x100c, 0x064e, 0x0aba, -1, 0x0e0e, 0x06ac, 0x0d67, 0x0682,
+	0x0956, 0x1203, 0x138b, 0x138c, 0x0c1f, 0x12a6, 0x0d95, -1, 0x11a8, 0x0e1e, 0x11ee, 0x138d, 0x123e, 0x0569, 0x138f, 0x138e,
+	-1, 0x10ca, 0x1390, 0x1391, 0x1392, 0x0db3, 0x1393, 0x0d8a, 0x1394, 0x1395, 0x050e, 0x124e, 0x1396, 0x07ef, 0x05ad, 0x1397,
+	0x1398, 0x076d, 0x102e, 0x10c7, 0x1399, 0x06e2, 0x0fa9, 0x04ce, 0x139a, 0x139b, -1, 0x08ea, 0x0f3b, 0x112e, 0x139c, 0x0f52,
+	0x139d, 0x1114, 0x0824, 0x139e, 0x0df4, 0x0e0f, 0x139f, 0x0caa, 0x124d, 0x13a0, 0x0bb4, 0x0620, 0x13a1, 0x068b, 0x098d, 0x13a2,
+	0x13a3, 0x0dec, 0x13a4, 0x05f6, 0x13a5, 0x0a41, 0x127a, 0x13a6, 0x0e57, -1, 0x10c9, 0x0b50, 0x13a7, 0x05fc, 0x0810, 0x13a8,
+	0x0fd2, 0x13a9, 0x13aa, 0x0f1c, 0x13ab, 0x13ac, 0x13ad, 0x04d6, 0x13b0, 0x13ae, 0x1067, 0x13af, 0x0b14, 0x13b1, -1, 0x13b2,
+	0x13b3, 0x13b4, 0x0fda, 0x1084, 0x0418, 0x13b5, 0x0c6a, 0x13b6, 0x08f6, 0x0ae0, 0x13b7, 0x13b8, 0x0b3c, 0x0e56, 0x13b9, 0x13ba,
+	0x09dd, 0x0c36, 0x13bb, 0x13bc, 0x0c73, 0x13bd, 0x13be, 0x0747, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, 0x082c, -1, -1, -1, -1, 0x0618, 0x1d97, -1, 0x1d98, -1, -1, 0x0ee2, -1, -1, -1,
+	-1, -1, -1, -1, -1, 0x1db8, -1, -1, -1, -1, 0x1a1f, -1, -1, -1, -1, -1,
+	-1, 0x0fb2, 0x07fe, -1, -1, -1, -1, -1, -1, -1, 0x079f, 0x1144, -1, -1, -1, -1,
+	-1, -1, -1, 0x1658, -1, -1, -1, -1, 0x1df3, 0x0429, 0x04d1, -1, -1, -1, -1, -1,
+	-1, -1, 0x1df4, -1, -1, 0x1df6, -1, -1, -1, 0x07b0, 0x1df5, -1, 0x0af1, -1, -1, -1,
+	-1, -1, -1, -1, 0x1df8, -1, -1, -1, 0x1df7, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0433, 0x11e9, 0x06d9,
+	-1, 0x0730, 0x04a5, 0x118f, 0x0f8c, 0x03fb, 0x11ca, 0x0834, 0x1229, 0x0676, 0x0e4f, 0x0c15, 0x0460, 0x0739, 0x125b, 0x0760,
+	0x0660, 0x0899, 0x19b9, 0x19ba, 0x0e98, 0x0772, 0x19bb, 0x059a, 0x0af2, 0x0690, 0x07b7, 0x19bc, 0x1193, 0x19bd, 0x0875, 0x081e,
+	0x19be, 0x0a5d, 0x0a97, 0x117e, 0x1289, 0x19bf, 0x19c0, 0x19c3, 0x19c1, 0x19c2, 0x0d5d, 0x06d2, 0x0623, 0x19c4, 0x0dc9, 0x0d4c,
+	0x056c, -1, -1, 0x16b8, 0x0be1, 0x19c5, 0x09d4, -1, 0x1277, 0x19c6, 0x126b, 0x0d1b, 0x12f2, 0x12ec, 0x117d, -1,
+	0x1198, 0x0d43, 0x10ed, 0x06f2, 0x051f, -1, 0x0d61, 0x1d95, -1, -1, -1, 0x07b4, -1, 0x1d96, -1, -1,
+	0x129e, -1, -1, 0x1d90, 0x06cf, 0x11d3, 0x06ee, 0x0c4f, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, 0x0502, -1, -1, 0x1d91, 0x04eb, -1, -1, -1, -1, 0x115c, 0x0cb6, -1, -1, -1, -1,
+	-1, 0x1d93, -1, -1, 0x1d92, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0e6a,
+	-1, -1, -1, 0x0cc3, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, 0x1d94, -1, 0x12a2, 0x0bbe, 0x1dbf, -1, -1, 0x1dba, -1, 0x1dc2, -1, 0x1dc1, -1, 0x1219, 0x1dc0,
+	-1, -1, -1, 0x115d, 0x1dc3, -1, 0x1dcb, -1, -1, -1, -1, 0x03ee, 0x05f7, -1, 0x1dc8, 0x1dc9,
+	-1, 0x0bdb, -1, -1, -1, -1, 0x1dc4, 0x1dc5, -1, -1, 0x1dc6, 0x1dca, -1, 0x093f, 0x1dc7, 0x0719,
+	-1, -1, -1, 0x1dcf, 0x1dd2, -1, -1, -1, 0x09a6, -1, 0x0771, 0x1dbb, 0x1dcc, -1, -1, 0x0a96,
+	-1, -1, -1, 0x0e97, -1, 0x0898, -1, 0x1dcd, 0x1dce, 0x1dd0, 0x0644, 0x1dd1, -1, 0x1dd4, -1, -1,
+	-1, -1, -1, -1, -1, 0x1dbc, -1, -1, -1, 0x1dd3, 0x10fa, -1, 0x052b, -1, -1, 0x0e43,
+	-1, -1, -1, -1, 0x1dd5, -1, -1, -1, -1, -1, -1, -1, -1, 0x1dd6, 0x0940, 0x1dd7,
+	-1, -1, 0x0e7e, 0x1dda, -1, -1, -1, -1, -1, 0x04a7, 0x1298, -1, 0x1dd8, -1, 0x1dd9, 0x1ddb,
+	-1, 0x1de1, -1, -1, -1, 0x1ddf, -1, -1, -1, 0x1dde, 0x1ddc, -1, -1, 0x1de0, -1, -1,
+	0x1ddd, 0x1de3, 0x1de4, -1, 0x0e82, -1, -1, 0x17be, 0x05b7, 0x1de2, 0x1de7, 0x0e42, -1, -1, -1, -1,
+	-1, 0x1de5, 0x1de6, -1, -1, -1, -1, -1, -1, 0x1dbd, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, 0x0443, -1, -1, 0x1dbe, -1, -1, 0x05c4, 0x04c3, -1, 0x1deb,
+	0x1de8, -1, 0x0636, -1, 0x1dec, -1, 0x1de9, -1, -1, -1, -1, -1, 0x1dea, -1, -1, 0x0579,
+	-1, 0x1189, -1, -1, -1, 0x1ded, -1, 0x0538, -1, -1, -1, -1, -1, -1, -1, 0x1dee,
+	0x1df0, -1, -1, -1, 0x1def, -1, -1, -1, -1, -1, -1, -1, 0x1df1, -1, 0x1df2, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0d6c, 0x0729, -1, -1, 0x0cba,
+	-1, -1, 0x0642, -1, -1, -1, -1, -1, -1, -1, 0x0e68, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1985, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, 0x04f4, 0x119d, 0x076b, 0x1018, -1, 0x196c, 0x1269, 0x196d, 0x0ab5, 0x0d0c,
+	0x07c3, 0x196e, 0x196f, 0x1970, 0x1240, 0x1971, 0x1972, 0x1974, 0x1973, 0x1975, 0x1976, 0x0ca0, 0x1977, 0x1177, 0x1978, 0x08cd,
+	-1, 0x1979, 0x197a, 0x08ce, 0x197b, 0x06c7, 0x0a3d, 0x197c, 0x0431, 0x0813, 0x0774, 0x197d, -1, 0x1980, 0x1981, 0x1982,
+	0x06b7, 0x084c, -1, 0x0dc3, 0x15ed, 0x114e, 0x0f7c, 0x11b6, 0x1983, 0x11dd, 0x1984, 0x0fe0, 0x073b, -1, 0x0566, 0x0458,
+	-1, -1, -1, 0x09d0, -1, -1, -1, -1, 0x0465, 0x0466, -1, 0x0467, -1, -1, -1, -1,
+	0x04fc, 0x0d07, -1, -1, -1, -1, 0x17c0, -1, -1, 0x045e, -1, -1, -1, 0x0a48, 0x058e, -1,
+	-1, 0x0c67, 0x1117, -1, 0x0c58, 0x1037, -1, 0x077c, 0x0ad4, -1, -1, -1, -1, -1, 0x10ec, -1,
+	0x116a, 0x08fb, -1, 0x17c1, 0x0674, 0x17c2, -1, -1, 0x07f8, 0x11e1, -1, 0x08f7, 0x1155, 0x0f04, 0x0a2b, 0x0518,
+	-1, -1, 0x0e95, -1, 0x17c5, 0x17c3, 0x17c7, -1, 0x17c9, 0x17c6, 0x05ce, 0x0c27, -1, 0x05fb, 0x17c4, -1,
+	0x0dd5, -1, -1, 0x17c8, -1, -1, -1, 0x0b13, 0x0444, 0x0842, -1, -1, -1, 0x1276, -1, -1,
+	0x0eca, 0x0e0c, 0x0da9, 0x0e72, 0x17cb, 0x17ca, 0x0b85, -1, -1, 0x1020, 0x1036, 0x17cc, -1, 0x17cf, -1, 0x0eb7,
+	0x124f, 0x17ce, 0x05dc, -1, 0x0ebd, -1, 0x17d0, 0x0619, -1, -1, 0x0ea7, 0x0763, -1, 0x0da4, 0x0510, 0x0e18,
+	0x118b, 0x17d1, 0x06a9, -1, -1, -1, 0x17cd, -1, -1, -1, -1, -1, -1, 0x17d4, 0x059d, 0x17d5,
+	-1, -1, -1, -1, -1, 0x17d2, 0x17d3, -1, 0x10bc, -1, -1, 0x0abe, 0x0445, -1, 0x1121, -1,
+	-1, 0x063c, 0x0e2b, -1, 0x17d6, -1, -1, 0x1135, -1, -1, -1, -1, -1, 0x0468, -1, 0x0653,
+	0x17d9, 0x17d7, 0x17d8, 0x05bf, -1, -1, -1, 0x10a4, 0x17db, -1, -1, 0x17dd, -1, -1, -1, -1,
+	-1, -1, 0x17dc, 0x0c71, -1, 0x1082, -1, -1, 0x17da, -1, -1, -1, -1, 0x1181, 0x11d9, -1,
+	-1, -1, -1, -1, 0x17df, 0x12b0, -1, -1, -1, -1, -1, -1, -1, 0x17e0, -1, 0x045b,
+	0x107a, -1, 0x17e1, 0x17e3, -1, -1, -1, -1, 0x17e2, -1, -1, 0x17e4, -1, -1, -1, -1,
+	-1, 0x10b7, -1, 0x05ca, -1, 0x189e, -1, 0x13d4, -1, 0x13d7, -1, 0x13d5, -1, 0x13d6, -1, -1,
+	-1, 0x13d9, 0x0fee, 0x0b66, -1, -1, 0x040d, -1, -1, -1, 0x0fcf, -1, 0x13d8, -1, 0x1109, 0x0789,
+	0x13e1, 0x0cb0, -1, 0x13db, 0x13da, 0x0d5a, 0x13dc, -1, 0x13e0, 0x129d, 0x13dd, 0x0a59, -1, -1, 0x13e4, -1,
+	-1, 0x1133, -1, -1, 0x13e6, 0x13e3, -1, 0x13e7, -1, -1, 0x08bb, -1, -1, -1, 0x09e8, 0x13e2,
+	0x13e5, 0x1202, -1, 0x13e8, -1, -1, -1, 0x13ec, -1, -1, -1, 0x13ed, 0x13eb, 0x07a1, -1, -1,
+	-1, 0x0963, 0x13ea, -1, -1, -1, 0x13e9, 0x1166, 0x049e, -1, -1, 0x13ee, -1, 0x0778, -1, 0x13ef,
+	-1, -1, -1, -1, 0x04fa, -1, -1, -1, 0x05a5, -1, -1, -1, -1, 0x061b, 0x13f0, -1,
+	-1, -1, 0x0654, -1, 0x13f1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0448, -1, -1, -1, -1, 0x13f3, -1,
+	-1, -1, 0x13f2, 0x13f4, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x13f6,
+	-1, 0x13f5, -1, -1, -1, -1, -1, -1, -1, 0x13f7, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, 0x13f8, -1, -1, 0x13f9, -1, -1, 0x110e, 0x1d99, 0x0cb4, 0x1282, 0x0be3, 0x1d9b, 0x1d9c,
+	0x1d9a, -1, 0x0925, -1, -1, -1, -1, 0x100e, -1, -1, 0x0692, -1, -1, 0x116c, 0x0e49, -1,
+	-1, 0x1da1, 0x1da0, 0x0787, 0x1d9d, 0x0e15, -1, -1, -1, 0x1da3, 0x09f1, -1, 0x0529, -1, 0x0ea9, 0x1da4,
+	0x1da2, 0x08b3, 0x1da7, -1, 0x1da8, 0x08cc, 0x0af7, 0x09a0, 0x0e20, 0x1da9, -1, -1, -1, 0x1da5, 0x1da6, 0x0b8f,
+	-1, -1, -1, -1, -1, 0x1dab, -1, 0x055b, -1, 0x12ac, -1, 0x0576, 0x1daa, 0x1dad, -1, -1,
+	0x1dac, 0x1dae, 0x0ff0, -1, -1, -1, -1, -1, -1, -1, 0x0b10, 0x0cc8, -1, -1, -1, -1,
+	-1, -1, 0x1daf, 0x1db0, -1, -1, -1, -1, -1, -1, 0x1db1, -1, -1, 0x1db2, 0x1db3, 0x1db4,
+	-1, -1, -1, -1, 0x1db6, 0x1db5, -1, -1, -1, -1, 0x1db7, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x04a8, -1, 0x1113, 0x0dac, -1, 0x0a0e, 0x1237, 0x108f, 0x0a3e,
+	-1, 0x08ef, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x06c9, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, 0x0897, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1e25, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1e26, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, 0x1e27, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1e28, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1e29, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1e2b,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1e2a, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, 0x1ea8, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1e2c, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1e2d, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, 0x1b23, 0x1b24, 0x1b25, 0x11ea, 0x0603, 0x1b27, 0x1b26, 0x1b28, 0x1b29, 0x0c64, 0x1b2a,
+	0x1b2b, -1, 0x066f, 0x05f5, 0x1b2c, 0x1b2e, -1, 0x1b2d, -1, 0x06e5, 0x1b2f, 0x1b30, 0x1b31, 0x063a, 0x04ed, 0x1232,
+	0x0b65, 0x0434, 0x06f5, 0x1b32, 0x1b33, 0x115e, 0x0c94, 0x095a, 0x0f38, 0x0732, 0x1b35, 0x1b34, 0x1b37, 0x1b36, 0x0ba4, 0x1b38,
+	0x1b39, 0x0c6d, 0x1b3a, 0x0c6e, 0x1b3b, 0x0486, 0x1b3c, 0x1b3d, 0x1b3e, 0x1b3f, 0x1b40, 0x12a9, 0x1b41, 0x1b42, 0x0877, 0x1b43,
+	0x110a, 0x0e99, 0x048b, 0x0a64, 0x1b44, 0x0c65, 0x0aec, -1, 0x1b45, 0x1b46, 0x1b47, 0x1b48, 0x1b49, 0x1b4a, 0x1b4b, -1,
+	0x1b4c, 0x1b4d, 0x1b4e, -1, -1, 0x1b4f, 0x1b50, 0x1b51, 0x1b53, 0x1b52, -1, 0x1b54, 0x0eac, 0x0aa0, 0x1b55, 0x1b56,
+	0x1b57, 0x11a0, 0x1b58, 0x0f72, 0x1b59, 0x1b5a, -1, 0x1b5b, 0x1b5c, 0x1b60, 0x1b5d, 0x1b61, 0x0714, 0x0b39, 0x1b62, 0x1b63,
+	0x08c1, 0x109e, 0x04d8, 0x1b64, 0x1b65, 0x1b66, 0x10d7, 0x1b67, 0x125c, 0x1b68, 0x0c2b, -1, 0x1b69, 0x1b6a, 0x0a33, 0x1b6b,
+	0x0fb9, 0x0e37, 0x1b6d, 0x1b6c, 0x0539, 0x0777, 0x1b6e, 0x1b6f, 0x1001, 0x1b70, 0x1b71, 0x06a5, 0x0fde, 0x1b72, 0x1b73, 0x1b74,
+	0x0d10, 0x0e7f, 0x1b75, 0x1b76, 0x1b77, 0x1b78, 0x1b79, 0x11df, 0x1b7a, 0x058c, 0x0ae9, 0x1b7b, -1, 0x1b7c, 0x1b7d, 0x1b7e,
+	-1, 0x0f5c, 0x1b7f, 0x0abf, 0x0557, 0x1275, 0x08f4, -1, 0x0f86, 0x1b82, 0x1b80, 0x1b81, 0x1b83, 0x0606, 0x089b, 0x0941,
+	0x0b0b, 0x1b84, 0x1b85, -1, 0x1b86, 0x1b8e, 0x1b87, 0x1b88, 0x1b89, 0x0c81, 0x1bb0, 0x062c, 0x1b8a, -1, 0x1b8b, 0x1b8c,
+	0x0625, 0x0afd, 0x1b8d, -1, 0x1b8f, 0x1b90, 0x1b91, 0x11f1, -1, 0x1b92, 0x0b96, -1, 0x1b93, 0x0b97, 0x1b94, 0x1b95,
+	0x0703, 0x0416, 0x1b96, 0x1b97, 0x1b98, -1, 0x1b99, 0x1b9a, 0x1b9b, 0x1b9c, -1, 0x1b9d, 0x0914, 0x1ba0, 0x1b9e, 0x1b9f,
+	-1, 0x1ba1, 0x1ba2, 0x0a4c, 0x1ba3, 0x1ba4, 0x1ba5, 0x1ba6, 0x1ba7, 0x1ba8, 0x1ba9, 0x1baa, 0x1bab, 0x09f7, -1, 0x1bac,
+	0x0a2c, 0x1bad, 0x1bae, 0x1baf, -1, -1, 0x0fa1, -1, -1, -1, -1, -1, -1, -1, -1, 0x04e3,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, 0x0b04, 0x1704, 0x0d40, 0x1705, -1, 0x0454, 0x0f29, 0x0552,
+	0x0d11, 0x1706, 0x0f8f, 0x1707, 0x0883, 0x1708, 0x1709, 0x0b05, 0x11a1, 0x0b75, 0x076a, 0x0f24, 0x170a, 0x0b35, 0x170b, -1,
+	0x0666, 0x0712, 0x07b1, 0x170c, 0x170d, 0x1163, 0x170e, -1, 0x170f, 0x104a, 0x1710, 0x1711, 0x1712, 0x1713, 0x1055, 0x1714,
+	0x04da, 0x09da, 0x1715, -1, 0x09ca, 0x1716, 0x1717, 0x1718, -1, 0x1719, 0x171a, -1, 0x06d6, 0x13c1, -1, 0x0632,
+	-1, 0x13c3, 0x13c2, -1, -1, -1, -1, -1, -1, -1, 0x13c5, -1, -1, -1, 0x0d0d, -1,
+	-1, 0x13c4, 0x067f, 0x1073, 0x10d4, 0x11f2, 0x08d6, -1, -1, -1, -1, 0x12a7, 0x13c7, 0x13c6, -1, 0x03c1,
+	0x0ed2, -1, 0x13c8, -1, 0x06dc, 0x0869, 0x0a9c, 0x0a83, 0x0501, 0x13c9, -1, 0x0a89, 0x0b4f, 0x08b4, -1, -1,
+	0x0f9d, -1, -1, -1, 0x13ca, 0x0d41, -1, -1, -1, -1, -1, 0x045c, -1, -1, -1, 0x13cb,
+	-1, 0x0617, 0x1159, -1, 0x053c, -1, -1, 0x13cc, 0x1168, 0x0f94, 0x0be2, -1, 0x13cd, -1, -1, -1,
+	-1, -1, 0x13ce, -1, 0x13cf, 0x0a69, 0x0e74, 0x0f9c, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, 0x1126, 0x0a80, -1, 0x13d0, -1, -1, 0x0e24, -1, 0x13d1, -1, 0x0e25,
+	0x10dd, -1, -1, -1, 0x0713, -1, -1, 0x13d2, 0x03ce, 0x0f75, -1, -1, 0x11ce, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, 0x0e2c, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x13d3, -1, -1, 0x1703, -1, -1, 0x0a24, -1, -1, 0x1e1e, -1, -1, 0x1e1f, 0x1e20, 0x0b70, -1,
+	0x0cd7, 0x1062, -1, -1, 0x0ffa, 0x1044, 0x084e, 0x074c, -1, 0x1bb3, -1, -1, 0x0565, 0x10f9, 0x1e21, 0x053a,
+	-1, -1, 0x1e22, -1, -1, 0x05f0, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x1e24, -1, -1, -1, -1, -1, -1, -1, 0x1129, 0x1e06, 0x1028, -1, -1, -1, -1, 0x1e08,
+	-1, -1, -1, 0x1e07, -1, -1, 0x0a62, 0x09f6, -1, 0x0421, -1, -1, -1, -1, 0x0f4c, -1,
+	0x1007, 0x1e0a, -1, -1, 0x0fb5, -1, 0x1e09, 0x11ef, 0x1e0b, 0x0af8, -1, -1, -1, 0x0833, 0x1e0d, 0x1e0c,
+	-1, -1, -1, 0x0b7d, -1, -1, 0x0a57, -1, -1, -1, -1, -1, 0x0de9, -1, 0x0f7b, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1e0e, -1, -1, 0x1e0f, -1, -1,
+	0x1e10, -1, 0x0a95, -1, -1, -1, -1, -1, 0x03f3, 0x0bf8, -1, -1, -1, -1, 0x1e11, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, 0x0c9f, 0x1e05, -1, -1, 0x0917, -1, -1, 0x0911, -1, 0x05e4, -1, -1, 0x0686, -1,
+	0x097e, 0x0b11, 0x0b25, -1, -1, 0x12eb, -1, -1, -1, 0x070e, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, 0x08f8, 0x1024, -1, 0x03ef, -1, -1, -1, -1, -1, 0x1e6f, -1, -1, -1,
+	-1, -1, -1, -1, -1, 0x1e70, -1, -1, -1, -1, -1, 0x0fcb, -1, 0x03cf, -1, -1,
+	-1, 0x1e71, 0x1e72, -1, 0x1e73, -1, -1, -1, 0x0c89, -1, -1, -1, -1, -1, -1, -1,
+	0x092e, -1, -1, 0x1e76, -1, -1, -1, -1, -1, -1, -1, 0x1e75, -1, 0x045d, -1, 0x1e74,
+	-1, -1, 0x1e77, -1, 0x1e78, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, 0x0f03, 0x0cec, -1, 0x078a, 0x18d7, 0x18d8, 0x18d9, 0x0921, -1, -1,
+	-1, -1, -1, 0x10d3, -1, 0x116e, 0x0d57, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, 0x1092, 0x0604, 0x0ca9, 0x1c7b, 0x0fad, 0x0df2, 0x100a, 0x18ae, 0x0ee6, 0x074a, 0x0638,
+	0x1c7c, 0x0403, 0x0e0b, 0x1c7d, 0x1143, 0x0a8c, 0x0a6b, 0x0c23, 0x0910, 0x1c80, 0x0874, -1, 0x1c81, 0x1c82, -1, 0x1c83,
+	0x10a2, 0x0c14, -1, 0x0ec6, 0x1c84, -1, 0x10f0, 0x0984, 0x0e81, -1, 0x1c85, 0x1c86, 0x1054, 0x064d, 0x1c87, 0x1c88,
+	0x05de, 0x1c89, 0x1c8a, -1, 0x04db, 0x1c8b, 0x1c8c, 0x0cc6, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x06a6, -1,
+	-1, 0x1a48, 0x1a49, 0x1a4a, -1, 0x1a4b, -1, -1, 0x0c0d, 0x1a4c, 0x1a4d, -1, -1, -1, 0x0688, 0x0d92,
+	-1, -1, -1, -1, -1, -1, -1, 0x1697, 0x1e8e, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1e8f, -1, -1,
+	0x04ac, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1e90, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, 0x1e92, 0x1e91, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, 0x169a, -1, 0x0841, -1, 0x169b, 0x169c, 0x169d, 0x169e, 0x169f, 0x16a0, 0x0678, 0x10da, 0x08a3,
+	0x0dad, 0x0424, 0x0e06, -1, 0x16a1, 0x065b, 0x0ce3, 0x16a2, -1, -1, 0x08c7, -1, 0x047c, 0x16a3, -1, 0x0655,
+	0x16a4, 0x0b78, -1, -1, 0x16a5, 0x0f99, 0x075c, 0x16a6, 0x09c0, -1, 0x16a7, 0x04d5, -1, 0x16a8, -1, 0x0a72,
+	0x16a9, 0x16aa, 0x0ad7, 0x16ab, 0x16ac, 0x16ad, 0x0db6, 0x12d7, 0x1364, 0x0fa2, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, 0x1bc4, -1, -1, 0x144f, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0acc, 0x1145, 0x0ed3, 0x1030,
+	0x051a, 0x0cbc, -1, 0x0494, 0x0a9e, 0x183f, 0x0d9a, 0x1840, 0x1841, 0x0933, 0x1842, 0x1263, 0x0ed4, 0x1844, 0x087c, 0x1843,
+	0x1845, 0x1846, 0x0acd, -1, 0x08bd, 0x1847, 0x0ac5, 0x0786, 0x1848, -1, 0x1849, 0x0511, 0x1068, -1, -1, 0x0964,
+	0x184a, 0x0c4e, 0x184b, 0x184c, -1, -1, 0x184d, 0x0c0c, 0x184e, -1, 0x0d26, 0x184f, 0x1850, 0x1851, 0x17b8, 0x1852,
+	0x1853, 0x0ac1, 0x1854, 0x1855, 0x1247, 0x1856, -1, 0x1857, 0x0746, -1, -1, -1, -1, -1, -1, -1,
+	0x1e7a, 0x1e79, -1, -1, -1, -1, 0x1e7d, 0x1e7b, 0x0780, -1, 0x1e7e, -1, 0x1e7f, -1, -1, -1,
+	0x1e81, 0x1e80, 0x1e83, -1, -1, 0x1e82, -1, -1, -1, -1, -1, 0x1e84, 0x1e85, -1, -1, -1,
+	-1, 0x1e86, -1, 0x0e27, -1, -1, -1, -1, 0x06fe, -1, -1, -1, -1, -1, -1, 0x1e93,
+	-1, 0x1e94, -1, -1, -1, -1, 0x1e95, -1, -1, -1, -1, 0x1e97, -1, 0x1e99, -1, 0x1e96,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1e9a, -1, 0x1e98, -1, -1, -1, -1,
+	-1, -1, -1, 0x1296, -1, -1, -1, -1, 0x1e9b, -1, -1, -1, -1, -1, -1, 0x1e9c,
+	-1, -1, -1, 0x1e9d, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1e9e,
+	-1, -1, -1, 0x1e9f, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x140a,
+	-1, -1, 0x12c9, -1, -1, -1, -1, -1, -1, -1, -1, 0x17f6, 0x076c, -1, -1, -1,
+	-1, 0x09bc, 0x0829, 0x1e88, 0x0c26, 0x1e87, -1, 0x1e89, 0x1e8b, 0x1e8a, -1, -1, -1, 0x1e8c, -1, 0x0f19,
+	-1, 0x1e8d, -1, -1, 0x0b46, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1122, -1, -1, 0x1e2e,
+	-1, 0x0a92, 0x1e2f, -1, -1, 0x1e30, 0x1e31, 0x1e32, 0x1e33, -1, -1, 0x1e35, -1, 0x042a, 0x1e36, -1,
+	0x1e37, 0x1e38, 0x1e39, -1, 0x1e3a, 0x1e3b, -1, -1, -1, -1, 0x1e3c, 0x1e3d, 0x0f89, -1, 0x1e3e, 0x1e3f,
+	0x1e40, 0x1e41, 0x1e42, 0x1e43, 0x0a0f, 0x1e44, 0x1e45, 0x1e46, 0x1e47, 0x1e48, -1, 0x1e49, -1, 0x1e4a, 0x1e4b, -1,
+	0x1e4c, 0x1e4d, 0x1e4e, 0x1e4f, 0x1e50, 0x1e51, 0x1e52, 0x1e53, 0x0907, -1, 0x1e54, 0x1e55, 0x1e56, 0x1e57, -1, -1,
+	-1, -1, -1, 0x0d19, 0x1e58, 0x1e59, 0x1e5a, 0x1e5b, -1, -1, 0x1e5c, 0x1e5d, 0x1e60, 0x1e61, 0x1e62, 0x1e63,
+	0x1e64, -1, -1, 0x1e65, 0x1e66, 0x1e67, 0x046d, 0x1e68, 0x1e69, 0x1e6a, -1, -1, 0x1e6b, 0x1e6c, 0x0a5a, 0x1e6d,
+	-1, -1, 0x1e6e, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0b90,
+	0x1bce, 0x0845, 0x1bcf, 0x0b38, -1, 0x0bb7, 0x103a, -1, 0x1bd0, 0x1bd1, 0x1bd2, 0x1bd3, 0x1bd4, 0x103b, -1, 0x106b,
+	-1, 0x1bd6, 0x1bd5, 0x1146, -1, 0x0ed1, 0x1bd7, 0x1bd9, 0x1bd8, 0x1bda, 0x1bdb, -1, -1, 0x070a, 0x1bdc, 0x07c7,
+	-1, 0x1bdd, 0x1bde, 0x0948, 0x1bdf, 0x064b, 0x1be0, 0x1be1, 0x1be2, 0x1be3, 0x0cd4, 0x1be4, 0x1be5, -1, 0x1be6, 0x0bf3,
+	-1, 0x1be7, -1, -1, -1, 0x1be8, -1, 0x1be9, 0x1e7c, -1, 0x1bea, 0x1beb, 0x1bec, -1, 0x1bed, -1,
+	-1, -1, -1, 0x1bee, 0x07b6, -1, 0x1bef, 0x1bf0, 0x1bf1, 0x1bf2, 0x1bf3, 0x1bf4, 0x1bf5, 0x1bf7, -1, -1,
+	0x10e4, 0x1bf6, -1, 0x1bf8, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1db9, 0x0a98,
+	-1, -1, 0x1ea3, -1, -1, -1, -1, 0x1ea4, 0x1ea5, -1, -1, 0x1ea6, -1, -1, -1, -1,
+	-1, -1, 0x1ea7, 0x0a93, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1ea9, -1, 0x1eaa,
+	-1, -1, -1, -1, -1, -1, 0x0ad2, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, 0x1d8f, -1, -1, -1, 0x1d8e, -1, -1, 0x0ac8, -1, 0x1ea0, 0x1ea1, -1,
+	-1, -1, -1, -1, 0x0805, -1, -1, -1, -1, 0x1363, -1, -1, -1, 0x0dd1, 0x0a07, 0x1bc3,
+	-1, 0x07b9, -1, -1, 0x0c6c, -1, -1, -1, 0x0b4b, -1, -1, 0x1eab, 0x1eac, 0x1ead, -1, 0x1eaf,
+	0x1eae, -1, 0x1eb0, -1, -1, 0x1eb3, -1, 0x1eb2, -1, 0x1eb1, 0x1eb4, -1, -1, -1, -1, 0x1eb5,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1ae8, -1, 0x1ae9, 0x1aea, -1, 0x1e1b, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1e1c, -1, 0x1e1d, 0x0605, -1,
+	0x12dd, -1, -1, 0x0743, -1, -1, -1, 0x12da, -1, 0x1450, -1, -1, -1, -1, -1, -1,
+	0x0dd2, -1, 0x1eb6, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x1eb7, -1, -1, 0x1eb8,
+	-1, -1, -1, -1, -1, -1, -1, 0x1eba, -1, 0x1eb9, -1, 0x0446, -1, 0x1ebb, 0x1ebc, -1,
+	-1, -1, -1, -1, 0x1ebd, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	0x0c4a, 0x1a53, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x051c,
+	0x1e12, -1, -1, 0x1e13, 0x0a63, 0x1e14, 0x1e15, 0x1e16, 0x1e17, 0x1e18, 0x1e19, 0x0cc2, 0x1e1a, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0a79, 0x0727, 0x1ae7, -1, -1, -1, 0x0769,
+	0x135e,
+}
+
+const hanziEncode4Low, hanziEncode4High = 65281, 65509
+
+var hanziEncode4 = [...]int16{
+	0x00c0, 0x00c1, 0x00c2, 0x0046, 0x00c4, 0x00c5, 0x00c6, 0x00c7, 0x00c8, 0x00c9, 0x00ca, 0x00cb, 0x00cc, 0x00cd, 0x00ce, 0x00cf,
+	0x00d0, 0x00d1, 0x00d2, 0x00d3, 0x00d4, 0x00d5, 0x00d6, 0x00d7, 0x00d8, 0x00d9, 0x00da, 0x00db, 0x00dc, 0x00dd, 0x00de, 0x00df,
+	0x00e0, 0x00e1, 0x00e2, 0x00e3, 0x00e4, 0x00e5, 0x00e6, 0x00e7, 0x00e8, 0x00e9, 0x00ea, 0x00eb, 0x00ec, 0x00ed, 0x00ee, 0x00ef,
+	0x00f0, 0x00f1, 0x00f2, 0x00f3, 0x00f4, 0x00f5, 0x00f6, 0x00f7, 0x00f8, 0x00f9, 0x00fa, 0x00fb, 0x00fc, 0x00fd, 0x00fe, 0x00ff,
+	0x0100, 0x0101, 0x0102, 0x0103, 0x0104, 0x0105, 0x0106, 0x0107, 0x0108, 0x0109, 0x010a, 0x010b, 0x010c, 0x010d, 0x010e, 0x010f,
+	0x0110, 0x0111, 0x0112, 0x0113, 0x0114, 0x0115, 0x0116, 0x0117, 0x0118, 0x0119, 0x011a, 0x011b, 0x011c, 0x000a, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1,
+	-1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, 0x0048,
+	0x0049, -1, 0x011d, -1, 0x00c3,
+}
+
+var hanziDecode = [...]uint16{
+	0x0000: 0x3000, //
+	0x0001: 0x3001, // 、
+	0x0002: 0x3002, // 。
+	0x0003: 0x00b7, // ·
+	0x0004: 0x02c9, // ˉ
+	0x0005: 0x02c7, // ˇ
+	0x0006: 0x00a8, // ¨
+	0x0007: 0x3003, // 〃
+	0x0008: 0x3005, // 々
+	0x0009: 0x2014, // —
+	0x000a: 0xff5e, // ～
+	0x000b: 0x2016, // ‖
+	0x000c: 0x2026, // …
+	0x000d: 0x2018, // ‘
+	0x000e: 0x2019, // ’
+	0x000f: 0x201c, // “
+	0x0010: 0x201d, // ”
+	0x0011: 0x3014, // 〔
+	0x0012: 0x3015, // 〕
+	0x0013: 0x3008, // 〈
+	0x0014: 0x3009, // 〉
+	0x0015: 0x300a, // 《
+	0x0016: 0x300b, // 》
+	0x0017: 0x300c, // 「
+	0x0018: 0x300d, // 」
+	0x0019: 0x300e, // 『
+	0x001a: 0x300f, // 』
+	0x001b: 0x3016, // 〖
+	0x001c: 0x3017, // 〗
+	0x001d: 0x3010, // 【
+	0x001e: 0x3011, // 】
+	0x001f: 0x00b1, // ±
+	0x0020: 0x00d7, // ×
+	0x0021: 0x00f7, // ÷
+	0x0022: 0x2236, // ∶
+	0x0023: 0x2227, // ∧
+	0x0024: 0x2228, // ∨
+	0x0025: 0x2211, // ∑
+	0x0026: 0x220f, // ∏
+	0x0027: 0x222a, // ∪
+	0x0028: 0x2229, // ∩
+	0x0029: 0x2208, // ∈
+	0x002a: 0x2237, // ∷
+	0x002b: 0x221a, // √
+	0x002c: 0x22a5, // ⊥
+	0x002d: 0x2225, // ∥
+	0x002e: 0x2220, // ∠
+	0x002f: 0x2312, // ⌒
+	0x0030: 0x2299, // ⊙
+	0x0031: 0x222b, // ∫
+	0x0032: 0x222e, // ∮
+	0x0033: 0x2261, // ≡
+	0x0034: 0x224c, // ≌
+	0x0035: 0x2248, // ≈
+	0x0036: 0x223d, // ∽
+	0x0037: 0x221d, // ∝
+	0x0038: 0x2260, // ≠
+	0x0039: 0x226e, // ≮
+	0x003a: 0x226f, // ≯
+	0x003b: 0x2264, // ≤
+	0x003c: 0x2265, // ≥
+	0x003d: 0x221e, // ∞
+	0x003e: 0x2235, // ∵
+	0x003f: 0x2234, // ∴
+	0x0040: 0x2642, // ♂
+	0x0041: 0x2640, // ♀
+	0x0042: 0x00b0, // °
+	0x0043: 0x2032, // ′
+	0x0044: 0x2033, // ″
+	0x0045: 0x2103, // ℃
+	0x0046: 0xff04, // ＄
+	0x0047: 0x00a4, // ¤
+	0x0048: 0xffe0, // ￠
+	0x0049: 0xffe1, // ￡
+	0x004a: 0x2030, // ‰
+	0x004b: 0x00a7, // §
+	0x004c: 0x2116, // №
+	0x004d: 0x2606, // ☆
+	0x004e: 0x2605, // ★
+	0x004f: 0x25cb, // ○
+	0x0050: 0x25cf, // ●
+	0x0051: 0x25ce, // ◎
+	0x0052: 0x25c7, // ◇
+	0x0053: 0x25c6, // ◆
+	0x0054: 0x25a1, // □
+	0x0055: 0x25a0, // ■
+	0x0056: 0x25b3, // △
+	0x0057: 0x25b2, // ▲
+	0x0058: 0x203b, // ※
+	0x0059: 0x2192, // →
+	0x005a: 0x2190, // ←
+	0x005b: 0x2191, // ↑
+	0x005c: 0x2193, // ↓
+	0x005d: 0x3013, // 〓
+	0x0070: 0x2488, // ⒈
+	0x0071: 0x2489, // ⒉
+	0x0072: 0x248a, // ⒊
+	0x0073: 0x248b, // ⒋
+	0x0074: 0x248c, // ⒌
+	0x0075: 0x248d, // ⒍
+	0x0076: 0x248e, // ⒎
+	0x0077: 0x248f, // ⒏
+	0x0078: 0x2490, // ⒐
+	0x0079: 0x2491, // ⒑
+	0x007a: 0x2492, // ⒒
+	0x007b: 0x2493, // ⒓
+	0x007c: 0x2494, // ⒔
+	0x007d: 0x2495, // ⒕
+	0x007e: 0x2496, // ⒖
+	0x007f: 0x2497, // ⒗
+	0x0080: 0x2498, // ⒘
+	0x0081: 0x2499, // ⒙
+	0x0082: 0x249a, // ⒚
+	0x0083: 0x249b, // ⒛
+	0x0084: 0x2474, // ⑴
+	0x0085: 0x2475, // ⑵
+	0x0086: 0x2476, // ⑶
+	0x0087: 0x2477, // ⑷
+	0x0088: 0x2478, // ⑸
+	0x0089: 0x2479, // ⑹
+	0x008a: 0x247a, // ⑺
+	0x008b: 0x247b, // ⑻
+	0x008c: 0x247c, // ⑼
+	0x008d: 0x247d, // ⑽
+	0x008e: 0x247e, // ⑾
+	0x008f: 0x247f, // ⑿
+	0x0090: 0x2480, // ⒀
+	0x0091: 0x2481, // ⒁
+	0x0092: 0x2482, // ⒂
+	0x0093: 0x2483, // ⒃
+	0x0094: 0x2484, // ⒄
+	0x0095: 0x2485, // ⒅
+	0x0096: 0x2486, // ⒆
+	0x0097: 0x2487, // ⒇
+	0x0098: 0x2460, // ①
+	0x0099: 0x2461, // ②
+	0x009a: 0x2462, // ③
+	0x009b: 0x2463, // ④
+	0x009c: 0x2464, // ⑤
+	0x009d: 0x2465, // ⑥
+	0x009e: 0x2466, // ⑦
+	0x009f: 0x2467, // ⑧
+	0x00a0: 0x2468, // ⑨
+	0x00a1: 0x2469, // ⑩
+	0x00a4: 0x3220, // ㈠
+	0x00a5: 0x3221, // ㈡
+	0x00a6: 0x3222, // ㈢
+	0x00a7: 0x3223, // ㈣
+	0x00a8: 0x3224, // ㈤
+	0x00a9: 0x3225, // ㈥
+	0x00aa: 0x3226, // ㈦
+	0x00ab: 0x3227, // ㈧
+	0x00ac: 0x3228, // ㈨
+	0x00ad: 0x3229, // ㈩
+	0x00b0: 0x2160, // Ⅰ
+	0x00b1: 0x2161, // Ⅱ
+	0x00b2: 0x2162, // Ⅲ
+	0x00b3: 0x2163, // Ⅳ
+	0x00b4: 0x2164, // Ⅴ
+	0x00b5: 0x2165, // Ⅵ
+	0x00b6: 0x2166, // Ⅶ
+	0x00b7: 0x2167, // Ⅷ
+	0x00b8: 0x2168, // Ⅸ
+	0x00b9: 0x2169, // Ⅹ
+	0x00ba: 0x216a, // Ⅺ
+	0x00bb: 0x216b, // Ⅻ
+	0x00c0: 0xff01, // ！
+	0x00c1: 0xff02, // ＂
+	0x00c2: 0xff03, // ＃
+	0x00c3: 0xffe5, // ￥
+	0x00c4: 0xff05, // ％
+	0x00c5: 0xff06, // ＆
+	0x00c6: 0xff07, // ＇
+	0x00c7: 0xff08, // （
+	0x00c8: 0xff09, // ）
+	0x00c9: 0xff0a, // ＊
+	0x00ca: 0xff0b, // ＋
+	0x00cb: 0xff0c, // ，
+	0x00cc: 0xff0d, // －
+	0x00cd: 0xff0e, // ．
+	0x00ce: 0xff0f, // ／
+	0x00cf: 0xff10, // ０
+	0x00d0: 0xff11, // １
+	0x00d1: 0xff12, // ２
+	0x00d2: 0xff13, // ３
+	0x00d3: 0xff14, // ４
+	0x00d4: 0xff15, // ５
+	0x00d5: 0xff16, // ６
+	0x00d6: 0xff17, // ７
+	0x00d7: 0xff18, // ８
+	0x00d8: 0xff19, // ９
+	0x00d9: 0xff1a, // ：
+	0x00da: 0xff1b, // ；
+	0x00db: 0xff1c, // ＜
+	0x00dc: 0xff1d, // ＝
+	0x00dd: 0xff1e, // ＞
+	0x00de: 0xff1f, // ？
+	0x00df: 0xff20, // ＠
+	0x00e0: 0xff21, // Ａ
+	0x00e1: 0xff22, // Ｂ
+	0x00e2: 0xff23, // Ｃ
+	0x00e3: 0xff24, // Ｄ
+	0x00e4: 0xff25, // Ｅ
+	0x00e5: 0xff26, // Ｆ
+	0x00e6: 0xff27, // Ｇ
+	0x00e7: 0xff28, // Ｈ
+	0x00e8: 0xff29, // Ｉ
+	0x00e9: 0xff2a, // Ｊ
+	0x00ea: 0xff2b, // Ｋ
+	0x00eb: 0xff2c, // Ｌ
+	0x00ec: 0xff2d, // Ｍ
+	0x00ed: 0xff2e, // Ｎ
+	0x00ee: 0xff2f, // Ｏ
+	0x00ef: 0xff30, // Ｐ
+	0x00f0: 0xff31, // Ｑ
+	0x00f1: 0xff32, // Ｒ
+	0x00f2: 0xff33, // Ｓ
+	0x00f3: 0xff34, // Ｔ
+	0x00f4: 0xff35, // Ｕ
+	0x00f5: 0xff36, // Ｖ
+	0x00f6: 0xff37, // Ｗ
+	0x00f7: 0xff38, // Ｘ
+	0x00f8: 0xff39, // Ｙ
+	0x00f9: 0xff3a, // Ｚ
+	0x00fa: 0xff3b, // ［
+	0x00fb: 0xff3c, // ＼
+	0x00fc: 0xff3d, // ］
+	0x00fd: 0xff3e, // ＾
+	0x00fe: 0xff3f, // ＿
+	0x00ff: 0xff40, // ｀
+	0x0100: 0xff41, // ａ
+	0x0101: 0xff42, // ｂ
+	0x0102: 0xff43, // ｃ
+	0x0103: 0xff44, // ｄ
+	0x0104: 0xff45, // ｅ
+	0x0105: 0xff46, // ｆ
+	0x0106: 0xff47, // ｇ
+	0x0107: 0xff48, // ｈ
+	0x0108: 0xff49, // ｉ
+	0x0109: 0xff4a, // ｊ
+	0x010a: 0xff4b, // ｋ
+	0x010b: 0xff4c, // ｌ
+	0x010c: 0xff4d, // ｍ
+	0x010d: 0xff4e, // ｎ
+	0x010e: 0xff4f, // ｏ
+	0x010f: 0xff50, // ｐ
+	0x0110: 0xff51, // ｑ
+	0x0111: 0xff52, // ｒ
+	0x0112: 0xff53, // ｓ
+	0x0113: 0xff54, // ｔ
+	0x0114: 0xff55, // ｕ
+	0x0115: 0xff56, // ｖ
+	0x0116: 0xff57, // ｗ
+	0x0117: 0xff58, // ｘ
+	0x0118: 0xff59, // ｙ
+	0x0119: 0xff5a, // ｚ
+	0x011a: 0xff5b, // ｛
+	0x011b: 0xff5c, // ｜
+	0x011c: 0xff5d, // ｝
+	0x011d: 0xffe3, // ￣
+	0x0120: 0x3041, // ぁ
+	0x0121: 0x3042, // あ
+	0x0122: 0x3043, // ぃ
+	0x0123: 0x3044, // い
+	0x0124: 0x3045, // ぅ
+	0x0125: 0x3046, // う
+	0x0126: 0x3047, // ぇ
+	0x0127: 0x3048, // え
+	0x0128: 0x3049, // ぉ
+	0x0129: 0x304a, // お
+	0x012a: 0x304b, // か
+	0x012b: 0x304c, // が
+	0x012c: 0x304d, // き
+	0x012d: 0x304e, // ぎ
+	0x012e: 0x304f, // く
+	0x012f: 0x3050, // ぐ
+	0x0130: 0x3051, // け
+	0x0131: 0x3052, // げ
+	0x0132: 0x3053, // こ
+	0x0133: 0x3054, // ご
+	0x0134: 0x3055, // さ
+	0x0135: 0x3056, // ざ
+	0x0136: 0x3057, // し
+	0x0137: 0x3058, // じ
+	0x0138: 0x3059, // す
+	0x0139: 0x305a, // ず
+	0x013a: 0x305b, // せ
+	0x013b: 0x305c, // ぜ
+	0x013c: 0x305d, // そ
+	0x013d: 0x305e, // ぞ
+	0x013e: 0x305f, // た
+	0x013f: 0x3060, // だ
+	0x0140: 0x3061, // ち
+	0x0141: 0x3062, // ぢ
+	0x0142: 0x3063, // っ
+	0x0143: 0x3064, // つ
+	0x0144: 0x3065, // づ
+	0x0145: 0x3066, // て
+	0x0146: 0x3067, // で
+	0x0147: 0x3068, // と
+	0x0148: 0x3069, // ど
+	0x0149: 0x306a, // な
+	0x014a: 0x306b, // に
+	0x014b: 0x306c, // ぬ
+	0x014c: 0x306d, // ね
+	0x014d: 0x306e, // の
+	0x014e: 0x306f, // は
+	0x014f: 0x3070, // ば
+	0x0150: 0x3071, // ぱ
+	0x0151: 0x3072, // ひ
+	0x0152: 0x3073, // び
+	0x0153: 0x3074, // ぴ
+	0x0154: 0x3075, // ふ
+	0x0155: 0x3076, // ぶ
+	0x0156: 0x3077, // ぷ
+	0x0157: 0x3078, // へ
+	0x0158: 0x3079, // べ
+	0x0159: 0x307a, // ぺ
+	0x015a: 0x307b, // ほ
+	0x015b: 0x307c, // ぼ
+	0x015c: 0x307d, // ぽ
+	0x015d: 0x307e, // ま
+	0x015e: 0x307f, // み
+	0x015f: 0x3080, // む
+	0x0160: 0x3081, // め
+	0x0161: 0x3082, // も
+	0x0162: 0x3083, // ゃ
+	0x0163: 0x3084, // や
+	0x0164: 0x3085, // ゅ
+	0x0165: 0x3086, // ゆ
+	0x0166: 0x3087, // ょ
+	0x0167: 0x3088, // よ
+	0x0168: 0x3089, // ら
+	0x0169: 0x308a, // り
+	0x016a: 0x308b, // る
+	0x016b: 0x308c, // れ
+	0x016c: 0x308d, // ろ
+	0x016d: 0x308e, // ゎ
+	0x016e: 0x308f, // わ
+	0x016f: 0x3090, // ゐ
+	0x0170: 0x3091, // ゑ
+	0x0171: 0x3092, // を
+	0x0172: 0x3093, // ん
+	0x0180: 0x30a1, // ァ
+	0x0181: 0x30a2, // ア
+	0x0182: 0x30a3, // ィ
+	0x0183: 0x30a4, // イ
+	0x0184: 0x30a5, // ゥ
+	0x0185: 0x30a6, // ウ
+	0x0186: 0x30a7, // ェ
+	0x0187: 0x30a8, // エ
+	0x0188: 0x30a9, // ォ
+	0x0189: 0x30aa, // オ
+	0x018a: 0x30ab, // カ
+	0x018b: 0x30ac, // ガ
+	0x018c: 0x30ad, // キ
+	0x018d: 0x30ae, // ギ
+	0x018e: 0x30af, // ク
+	0x018f: 0x30b0, // グ
+	0x0190: 0x30b1, // ケ
+	0x0191: 0x30b2, // ゲ
+	0x0192: 0x30b3, // コ
+	0x0193: 0x30b4, // ゴ
+	0x0194: 0x30b5, // サ
+	0x0195: 0x30b6, // ザ
+	0x0196: 0x30b7, // シ
+	0x0197: 0x30b8, // ジ
+	0x0198: 0x30b9, // ス
+	0x0199: 0x30ba, // ズ
+	0x019a: 0x30bb, // セ
+	0x019b: 0x30bc, // ゼ
+	0x019c: 0x30bd, // ソ
+	0x019d: 0x30be, // ゾ
+	0x019e: 0x30bf, // タ
+	0x019f: 0x30c0, // ダ
+	0x01a0: 0x30c1, // チ
+	0x01a1: 0x30c2, // ヂ
+	0x01a2: 0x30c3, // ッ
+	0x01a3: 0x30c4, // ツ
+	0x01a4: 0x30c5, // ヅ
+	0x01a5: 0x30c6, // テ
+	0x01a6: 0x30c7, // デ
+	0x01a7: 0x30c8, // ト
+	0x01a8: 0x30c9, // ド
+	0x01a9: 0x30ca, // ナ
+	0x01aa: 0x30cb, // ニ
+	0x01ab: 0x30cc, // ヌ
+	0x01ac: 0x30cd, // ネ
+	0x01ad: 0x30ce, // ノ
+	0x01ae: 0x30cf, // ハ
+	0x01af: 0x30d0, // バ
+	0x01b0: 0x30d1, // パ
+	0x01b1: 0x30d2, // ヒ
+	0x01b2: 0x30d3, // ビ
+	0x01b3: 0x30d4, // ピ
+	0x01b4: 0x30d5, // フ
+	0x01b5: 0x30d6, // ブ
+	0x01b6: 0x30d7, // プ
+	0x01b7: 0x30d8, // ヘ
+	0x01b8: 0x30d9, // ベ
+	0x01b9: 0x30da, // ペ
+	0x01ba: 0x30db, // ホ
+	0x01bb: 0x30dc, // ボ
+	0x01bc: 0x30dd, // ポ
+	0x01bd: 0x30de, // マ
+	0x01be: 0x30df, // ミ
+	0x01bf: 0x30e0, // ム
+	0x01c0: 0x30e1, // メ
+	0x01c1: 0x30e2, // モ
+	0x01c2: 0x30e3, // ャ
+	0x01c3: 0x30e4, // ヤ
+	0x01c4: 0x30e5, // ュ
+	0x01c5: 0x30e6, // ユ
+	0x01c6: 0x30e7, // ョ
+	0x01c7: 0x30e8, // ヨ
+	0x01c8: 0x30e9, // ラ
+	0x01c9: 0x30ea, // リ
+	0x01ca: 0x30eb, // ル
+	0x01cb: 0x30ec, // レ
+	0x01cc: 0x30ed, // ロ
+	0x01cd: 0x30ee, // ヮ
+	0x01ce: 0x30ef, // ワ
+	0x01cf: 0x30f0, // ヰ
+	0x01d0: 0x30f1, // ヱ
+	0x01d1: 0x30f2, // ヲ
+	0x01d2: 0x30f3, // ン
+	0x01d3: 0x30f4, // ヴ
+	0x01d4: 0x30f5, // ヵ
+	0x01d5: 0x30f6, // ヶ
+	0x01e0: 0x0391, // Α
+	0x01e1: 0x0392, // Β
+	0x01e2: 0x0393, // Γ
+	0x01e3: 0x0394, // Δ
+	0x01e4: 0x0395, // Ε
+	0x01e5: 0x0396, // Ζ
+	0x01e6: 0x0397, // Η
+	0x01e7: 0x0398, // Θ
+	0x01e8: 0x0399, // Ι
+	0x01e9: 0x039a, // Κ
+	0x01ea: 0x039b, // Λ
+	0x01eb: 0x039c, // Μ
+	0x01ec: 0x039d, // Ν
+	0x01ed: 0x039e, // Ξ
+	0x01ee: 0x039f, // Ο
+	0x01ef: 0x03a0, // Π
+	0x01f0: 0x03a1, // Ρ
+	0x01f1: 0x03a3, // Σ
+	0x01f2: 0x03a4, // Τ
+	0x01f3: 0x03a5, // Υ
+	0x01f4: 0x03a6, // Φ
+	0x01f5: 0x03a7, // Χ
+	0x01f6: 0x03a8, // Ψ
+	0x01f7: 0x03a9, // Ω
+	0x0200: 0x03b1, // α
+	0x0201: 0x03b2, // β
+	0x0202: 0x03b3, // γ
+	0x0203: 0x03b4, // δ
+	0x0204: 0x03b5, // ε
+	0x0205: 0x03b6, // ζ
+	0x0206: 0x03b7, // η
+	0x0207: 0x03b8, // θ
+	0x0208: 0x03b9, // ι
+	0x0209: 0x03ba, // κ
+	0x020a: 0x03bb, // λ
+	0x020b: 0x03bc, // μ
+	0x020c: 0x03bd, // ν
+	0x020d: 0x03be, // ξ
+	0x020e: 0x03bf, // ο
+	0x020f: 0x03c0, // π
+	0x0210: 0x03c1, // ρ
+	0x0211: 0x03c3, // σ
+	0x0212: 0x03c4, // τ
+	0x0213: 0x03c5, // υ
+	0x0214: 0x03c6, // φ
+	0x0215: 0x03c7, // χ
+	0x0216: 0x03c8, // ψ
+	0x0217: 0x03c9, // ω
+	0x0240: 0x0410, // А
+	0x0241: 0x0411, // Б
+	0x0242: 0x0412, // В
+	0x0243: 0x0413, // Г
+	0x0244: 0x0414, // Д
+	0x0245: 0x0415, // Е
+	0x0246: 0x0401, // Ё
+	0x0247: 0x0416, // Ж
+	0x0248: 0x0417, // З
+	0x0249: 0x0418, // И
+	0x024a: 0x0419, // Й
+	0x024b: 0x041a, // К
+	0x024c: 0x041b, // Л
+	0x024d: 0x041c, // М
+	0x024e: 0x041d, // Н
+	0x024f: 0x041e, // О
+	0x0250: 0x041f, // П
+	0x0251: 0x0420, // Р
+	0x0252: 0x0421, // С
+	0x0253: 0x0422, // Т
+	0x0254: 0x0423, // У
+	0x0255: 0x0424, // Ф
+	0x0256: 0x0425, // Х
+	0x0257: 0x0426, // Ц
+	0x0258: 0x0427, // Ч
+	0x0259: 0x0428, // Ш
+	0x025a: 0x0429, // Щ
+	0x025b: 0x042a, // Ъ
+	0x025c: 0x042b, // Ы
+	0x025d: 0x042c, // Ь
+	0x025e: 0x042d, // Э
+	0x025f: 0x042e, // Ю
+	0x0260: 0x042f, // Я
+	0x0270: 0x0430, // а
+	0x0271: 0x0431, // б
+	0x0272: 0x0432, // в
+	0x0273: 0x0433, // г
+	0x0274: 0x0434, // д
+	0x0275: 0x0435, // е
+	0x0276: 0x0451, // ё
+	0x0277: 0x0436, // ж
+	0x0278: 0x0437, // з
+	0x0279: 0x0438, // и
+	0x027a: 0x0439, // й
+	0x027b: 0x043a, // к
+	0x027c: 0x043b, // л
+	0x027d: 0x043c, // м
+	0x027e: 0x043d, // н
+	0x027f: 0x043e, // о
+	0x0280: 0x043f, // п
+	0x0281: 0x0440, // р
+	0x0282: 0x0441, // с
+	0x0283: 0x0442, // т
+	0x0284: 0x0443, // у
+	0x0285: 0x0444, // ф
+	0x0286: 0x0445, // х
+	0x0287: 0x0446, // ц
+	0x0288: 0x0447, // ч
+	0x0289: 0x0448, // ш
+	0x028a: 0x0449, // щ
+	0x028b: 0x044a, // ъ
+	0x028c: 0x044b, // ы
+	0x028d: 0x044c, // ь
+	0x028e: 0x044d, // э
+	0x028f: 0x044e, // ю
+	0x0290: 0x044f, // я
+	0x02a0: 0x0101, // ā
+	0x02a1: 0x00e1, // á
+	0x02a2: 0x01ce, // ǎ
+	0x02a3: 0x00e0, // à
+	0x02a4: 0x0113, // ē
+	0x02a5: 0x00e9, // é
+	0x02a6: 0x011b, // ě
+	0x02a7: 0x00e8, // è
+	0x02a8: 0x012b, // ī
+	0x02a9: 0x00ed, // í
+	0x02aa: 0x01d0, // ǐ
+	0x02ab: 0x00ec, // ì
+	0x02ac: 0x014d, // ō
+	0x02ad: 0x00f3, // ó
+	0x02ae: 0x01d2, // ǒ
+	0x02af: 0x00f2, // ò
+	0x02b0: 0x016b, // ū
+	0x02b1: 0x00fa, // ú
+	0x02b2: 0x01d4, // ǔ
+	0x02b3: 0x00f9, // ù
+	0x02b4: 0x01d6, // ǖ
+	0x02b5: 0x01d8, // ǘ
+	0x02b6: 0x01da, // ǚ
+	0x02b7: 0x01dc, // ǜ
+	0x02b8: 0x00fc, // ü
+	0x02b9: 0x00ea, // ê
+	0x02c4: 0x3105, // ㄅ
+	0x02c5: 0x3106, // ㄆ
+	0x02c6: 0x3107, // ㄇ
+	0x02c7: 0x3108, // ㄈ
+	0x02c8: 0x3109, // ㄉ
+	0x02c9: 0x310a, // ㄊ
+	0x02ca: 0x310b, // ㄋ
+	0x02cb: 0x310c, // ㄌ
+	0x02cc: 0x310d, // ㄍ
+	0x02cd: 0x310e, // ㄎ
+	0x02ce: 0x310f, // ㄏ
+	0x02cf: 0x3110, // ㄐ
+	0x02d0: 0x3111, // ㄑ
+	0x02d1: 0x3112, // ㄒ
+	0x02d2: 0x3113, // ㄓ
+	0x02d3: 0x3114, // ㄔ
+	0x02d4: 0x3115, // ㄕ
+	0x02d5: 0x3116, // ㄖ
+	0x02d6: 0x3117, // ㄗ
+	0x02d7: 0x3118, // ㄘ
+	0x02d8: 0x3119, // ㄙ
+	0x02d9: 0x311a, // ㄚ
+	0x02da: 0x311b, // ㄛ
+	0x02db: 0x311c, // ㄜ
+	0x02dc: 0x311d, // ㄝ
+	0x02dd: 0x311e, // ㄞ
+	0x02de: 0x311f, // ㄟ
+	0x02df: 0x3120, // ㄠ
+	0x02e0: 0x3121, // ㄡ
+	0x02e1: 0x3122, // ㄢ
+	0x02e2: 0x3123, // ㄣ
+	0x02e3: 0x3124, // ㄤ
+	0x02e4: 0x3125, // ㄥ
+	0x02e5: 0x3126, // ㄦ
+	0x02e6: 0x3127, // ㄧ
+	0x02e7: 0x3128, // ㄨ
+	0x02e8: 0x3129, // ㄩ
+	0x0303: 0x2500, // ─
+	0x0304: 0x2501, // ━
+	0x0305: 0x2502, // │
+	0x0306: 0x2503, // ┃
+	0x0307: 0x2504, // ┄
+	0x0308: 0x2505, // ┅
+	0x0309: 0x2506, // ┆
+	0x030a: 0x2507, // ┇
+	0x030b: 0x2508, // ┈
+	0x030c: 0x2509, // ┉
+	0x030d: 0x250a, // ┊
+	0x030e: 0x250b, // ┋
+	0x030f: 0x250c, // ┌
+	0x0310: 0x250d, // ┍
+	0x0311: 0x250e, // ┎
+	0x0312: 0x250f, // ┏
+	0x0313: 0x2510, // ┐
+	0x0314: 0x2511, // ┑
+	0x0315: 0x2512, // ┒
+	0x0316: 0x2513, // ┓
+	0x0317: 0x2514, // └
+	0x0318: 0x2515, // ┕
+	0x0319: 0x2516, // ┖
+	0x031a: 0x2517, // ┗
+	0x031b: 0x2518, // ┘
+	0x031c: 0x2519, // ┙
+	0x031d: 0x251a, // ┚
+	0x031e: 0x251b, // ┛
+	0x031f: 0x251c, // ├
+	0x0320: 0x251d, // ┝
+	0x0321: 0x251e, // ┞
+	0x0322: 0x251f, // ┟
+	0x0323: 0x2520, // ┠
+	0x0324: 0x2521, // ┡
+	0x0325: 0x2522, // ┢
+	0x0326: 0x2523, // ┣
+	0x0327: 0x2524, // ┤
+	0x0328: 0x2525, // ┥
+	0x0329: 0x2526, // ┦
+	0x032a: 0x2527, // ┧
+	0x032b: 0x2528, // ┨
+	0x032c: 0x2529, // ┩
+	0x032d: 0x252a, // ┪
+	0x032e: 0x252b, // ┫
+	0x032f: 0x252c, // ┬
+	0x0330: 0x252d, // ┭
+	0x0331: 0x252e, // ┮
+	0x0332: 0x252f, // ┯
+	0x0333: 0x2530, // ┰
+	0x0334: 0x2531, // ┱
+	0x0335: 0x2532, // ┲
+	0x0336: 0x2533, // ┳
+	0x0337: 0x2534, // ┴
+	0x0338: 0x2535, // ┵
+	0x0339: 0x2536, // ┶
+	0x033a: 0x2537, // ┷
+	0x033b: 0x2538, // ┸
+	0x033c: 0x2539, // ┹
+	0x033d: 0x253a, // ┺
+	0x033e: 0x253b, // ┻
+	0x033f: 0x253c, // ┼
+	0x0340: 0x253d, // ┽
+	0x0341: 0x253e, // ┾
+	0x0342: 0x253f, // ┿
+	0x0343: 0x2540, // ╀
+	0x0344: 0x2541, // ╁
+	0x0345: 0x2542, // ╂
+	0x0346: 0x2543, // ╃
+	0x0347: 0x2544, // ╄
+	0x0348: 0x2545, // ╅
+	0x0349: 0x2546, // ╆
+	0x034a: 0x2547, // ╇
+	0x034b: 0x2548, // ╈
+	0x034c: 0x2549, // ╉
+	0x034d: 0x254a, // ╊
+	0x034e: 0x254b, // ╋
+	0x03c0: 0x554a, // 啊
+	0x03c1: 0x963f, // 阿
+	0x03c2: 0x57c3, // 埃
+	0x03c3: 0x6328, // 挨
+	0x03c4: 0x54ce, // 哎
+	0x03c5: 0x5509, // 唉
+	0x03c6: 0x54c0, // 哀
+	0x03c7: 0x7691, // 皑
+	0x03c8: 0x764c, // 癌
+	0x03c9: 0x853c, // 蔼
+	0x03ca: 0x77ee, // 矮
+	0x03cb: 0x827e, // 艾
+	0x03cc: 0x788d, // 碍
+	0x03cd: 0x7231, // 爱
+	0x03ce: 0x9698, // 隘
+	0x03cf: 0x978d, // 鞍
+	0x03d0: 0x6c28, // 氨
+	0x03d1: 0x5b89, // 安
+	0x03d2: 0x4ffa, // 俺
+	0x03d3: 0x6309, // 按
+	0x03d4: 0x6697, // 暗
+	0x03d5: 0x5cb8, // 岸
+	0x03d6: 0x80fa, // 胺
+	0x03d7: 0x6848, // 案
+	0x03d8: 0x80ae, // 肮
+	0x03d9: 0x6602, // 昂
+	0x03da: 0x76ce, // 盎
+	0x03db: 0x51f9, // 凹
+	0x03dc: 0x6556, // 敖
+	0x03dd: 0x71ac, // 熬
+	0x03de: 0x7ff1, // 翱
+	0x03df: 0x8884, // 袄
+	0x03e0: 0x50b2, // 傲
+	0x03e1: 0x5965, // 奥
+	0x03e2: 0x61ca, // 懊
+	0x03e3: 0x6fb3, // 澳
+	0x03e4: 0x82ad, // 芭
+	0x03e5: 0x634c, // 捌
+	0x03e6: 0x6252, // 扒
+	0x03e7: 0x53ed, // 叭
+	0x03e8: 0x5427, // 吧
+	0x03e9: 0x7b06, // 笆
+	0x03ea: 0x516b, // 八
+	0x03eb: 0x75a4, // 疤
+	0x03ec: 0x5df4, // 巴
+	0x03ed: 0x62d4, // 拔
+	0x03ee: 0x8dcb, // 跋
+	0x03ef: 0x9776, // 靶
+	0x03f0: 0x628a, // 把
+	0x03f1: 0x8019, // 耙
+	0x03f2: 0x575d, // 坝
+	0x03f3: 0x9738, // 霸
+	0x03f4: 0x7f62, // 罢
+	0x03f5: 0x7238, // 爸
+	0x03f6: 0x767d, // 白
+	0x03f7: 0x67cf, // 柏
+	0x03f8: 0x767e, // 百
+	0x03f9: 0x6446, // 摆
+	0x03fa: 0x4f70, // 佰
+	0x03fb: 0x8d25, // 败
+	0x03fc: 0x62dc, // 拜
+	0x03fd: 0x7a17, // 稗
+	0x03fe: 0x6591, // 斑
+	0x03ff: 0x73ed, // 班
+	0x0400: 0x642c, // 搬
+	0x0401: 0x6273, // 扳
+	0x0402: 0x822c, // 般
+	0x0403: 0x9881, // 颁
+	0x0404: 0x677f, // 板
+	0x0405: 0x7248, // 版
+	0x0406: 0x626e, // 扮
+	0x0407: 0x62cc, // 拌
+	0x0408: 0x4f34, // 伴
+	0x0409: 0x74e3, // 瓣
+	0x040a: 0x534a, // 半
+	0x040b: 0x529e, // 办
+	0x040c: 0x7eca, // 绊
+	0x040d: 0x90a6, // 邦
+	0x040e: 0x5e2e, // 帮
+	0x040f: 0x6886, // 梆
+	0x0410: 0x699c, // 榜
+	0x0411: 0x8180, // 膀
+	0x0412: 0x7ed1, // 绑
+	0x0413: 0x68d2, // 棒
+	0x0414: 0x78c5, // 磅
+	0x0415: 0x868c, // 蚌
+	0x0416: 0x9551, // 镑
+	0x0417: 0x508d, // 傍
+	0x0418: 0x8c24, // 谤
+	0x0419: 0x82de, // 苞
+	0x041a: 0x80de, // 胞
+	0x041b: 0x5305, // 包
+	0x041c: 0x8912, // 褒
+	0x041d: 0x5265, // 剥
+	0x0420: 0x8584, // 薄
+	0x0421: 0x96f9, // 雹
+	0x0422: 0x4fdd, // 保
+	0x0423: 0x5821, // 堡
+	0x0424: 0x9971, // 饱
+	0x0425: 0x5b9d, // 宝
+	0x0426: 0x62b1, // 抱
+	0x0427: 0x62a5, // 报
+	0x0428: 0x66b4, // 暴
+	0x0429: 0x8c79, // 豹
+	0x042a: 0x9c8d, // 鲍
+	0x042b: 0x7206, // 爆
+	0x042c: 0x676f, // 杯
+	0x042d: 0x7891, // 碑
+	0x042e: 0x60b2, // 悲
+	0x042f: 0x5351, // 卑
+	0x0430: 0x5317, // 北
+	0x0431: 0x8f88, // 辈
+	0x0432: 0x80cc, // 背
+	0x0433: 0x8d1d, // 贝
+	0x0434: 0x94a1, // 钡
+	0x0435: 0x500d, // 倍
+	0x0436: 0x72c8, // 狈
+	0x0437: 0x5907, // 备
+	0x0438: 0x60eb, // 惫
+	0x0439: 0x7119, // 焙
+	0x043a: 0x88ab, // 被
+	0x043b: 0x5954, // 奔
+	0x043c: 0x82ef, // 苯
+	0x043d: 0x672c, // 本
+	0x043e: 0x7b28, // 笨
+	0x043f: 0x5d29, // 崩
+	0x0440: 0x7ef7, // 绷
+	0x0441: 0x752d, // 甭
+	0x0442: 0x6cf5, // 泵
+	0x0443: 0x8e66, // 蹦
+	0x0444: 0x8ff8, // 迸
+	0x0445: 0x903c, // 逼
+	0x0446: 0x9f3b, // 鼻
+	0x0447: 0x6bd4, // 比
+	0x0448: 0x9119, // 鄙
+	0x0449: 0x7b14, // 笔
+	0x044a: 0x5f7c, // 彼
+	0x044b: 0x78a7, // 碧
+	0x044c: 0x84d6, // 蓖
+	0x044d: 0x853d, // 蔽
+	0x044e: 0x6bd5, // 毕
+	0x044f: 0x6bd9, // 毙
+	0x0450: 0x6bd6, // 毖
+	0x0451: 0x5e01, // 币
+	0x0452: 0x5e87, // 庇
+	0x0453: 0x75f9, // 痹
+	0x0454: 0x95ed, // 闭
+	0x0455: 0x655d, // 敝
+	0x0456: 0x5f0a, // 弊
+	0x0457: 0x5fc5, // 必
+	0x0458: 0x8f9f, // 辟
+	0x0459: 0x58c1, // 壁
+	0x045a: 0x81c2, // 臂
+	0x045b: 0x907f, // 避
+	0x045c: 0x965b, // 陛
+	0x045d: 0x97ad, // 鞭
+	0x045e: 0x8fb9, // 边
+	0x045f: 0x7f16, // 编
+	0x0460: 0x8d2c, // 贬
+	0x0461: 0x6241, // 扁
+	0x0462: 0x4fbf, // 便
+	0x0463: 0x53d8, // 变
+	0x0464: 0x535e, // 卞
+	0x0465: 0x8fa8, // 辨
+	0x0466: 0x8fa9, // 辩
+	0x0467: 0x8fab, // 辫
+	0x0468: 0x904d, // 遍
+	0x0469: 0x6807, // 标
+	0x046a: 0x5f6a, // 彪
+	0x046b: 0x8198, // 膘
+	0x046c: 0x8868, // 表
+	0x046d: 0x9cd6, // 鳖
+	0x046e: 0x618b, // 憋
+	0x046f: 0x522b, // 别
+	0x0470: 0x762a, // 瘪
+	0x0471: 0x5f6c, // 彬
+	0x0472: 0x658c, // 斌
+	0x0473: 0x6fd2, // 濒
+	0x0474: 0x6ee8, // 滨
+	0x0475: 0x5bbe, // 宾
+	0x0476: 0x6448, // 摈
+	0x0477: 0x5175, // 兵
+	0x0478: 0x51b0, // 冰
+	0x0479: 0x67c4, // 柄
+	0x047a: 0x4e19, // 丙
+	0x047b: 0x79c9, // 秉
+	0x047c: 0x997c, // 饼
+	0x047d: 0x70b3, // 炳
+	0x0480: 0x75c5, // 病
+	0x0481: 0x5e76, // 并
+	0x0482: 0x73bb, // 玻
+	0x0483: 0x83e0, // 菠
+	0x0484: 0x64ad, // 播
+	0x0485: 0x62e8, // 拨
+	0x0486: 0x94b5, // 钵
+	0x0487: 0x6ce2, // 波
+	0x0488: 0x535a, // 博
+	0x0489: 0x52c3, // 勃
+	0x048a: 0x640f, // 搏
+	0x048b: 0x94c2, // 铂
+	0x048c: 0x7b94, // 箔
+	0x048d: 0x4f2f, // 伯
+	0x048e: 0x5e1b, // 帛
+	0x048f: 0x8236, // 舶
+	0x0490: 0x8116, // 脖
+	0x0491: 0x818a, // 膊
+	0x0492: 0x6e24, // 渤
+	0x0493: 0x6cca, // 泊
+	0x0494: 0x9a73, // 驳
+	0x0495: 0x6355, // 捕
+	0x0496: 0x535c, // 卜
+	0x0497: 0x54fa, // 哺
+	0x0498: 0x8865, // 补
+	0x0499: 0x57e0, // 埠
+	0x049a: 0x4e0d, // 不
+	0x049b: 0x5e03, // 布
+	0x049c: 0x6b65, // 步
+	0x049d: 0x7c3f, // 簿
+	0x049e: 0x90e8, // 部
+	0x049f: 0x6016, // 怖
+	0x04a0: 0x64e6, // 擦
+	0x04a1: 0x731c, // 猜
+	0x04a2: 0x88c1, // 裁
+	0x04a3: 0x6750, // 材
+	0x04a4: 0x624d, // 才
+	0x04a5: 0x8d22, // 财
+	0x04a6: 0x776c, // 睬
+	0x04a7: 0x8e29, // 踩
+	0x04a8: 0x91c7, // 采
+	0x04a9: 0x5f69, // 彩
+	0x04aa: 0x83dc, // 菜
+	0x04ab: 0x8521, // 蔡
+	0x04ac: 0x9910, // 餐
+	0x04ad: 0x53c2, // 参
+	0x04ae: 0x8695, // 蚕
+	0x04af: 0x6b8b, // 残
+	0x04b0: 0x60ed, // 惭
+	0x04b1: 0x60e8, // 惨
+	0x04b2: 0x707f, // 灿
+	0x04b3: 0x82cd, // 苍
+	0x04b4: 0x8231, // 舱
+	0x04b5: 0x4ed3, // 仓
+	0x04b6: 0x6ca7, // 沧
+	0x04b7: 0x85cf, // 藏
+	0x04b8: 0x64cd, // 操
+	0x04b9: 0x7cd9, // 糙
+	0x04ba: 0x69fd, // 槽
+	0x04bb: 0x66f9, // 曹
+	0x04bc: 0x8349, // 草
+	0x04bd: 0x5395, // 厕
+	0x04be: 0x7b56, // 策
+	0x04bf: 0x4fa7, // 侧
+	0x04c0: 0x518c, // 册
+	0x04c1: 0x6d4b, // 测
+	0x04c2: 0x5c42, // 层
+	0x04c3: 0x8e6d, // 蹭
+	0x04c4: 0x63d2, // 插
+	0x04c5: 0x53c9, // 叉
+	0x04c6: 0x832c, // 茬
+	0x04c7: 0x8336, // 茶
+	0x04c8: 0x67e5, // 查
+	0x04c9: 0x78b4, // 碴
+	0x04ca: 0x643d, // 搽
+	0x04cb: 0x5bdf, // 察
+	0x04cc: 0x5c94, // 岔
+	0x04cd: 0x5dee, // 差
+	0x04ce: 0x8be7, // 诧
+	0x04cf: 0x62c6, // 拆
+	0x04d0: 0x67f4, // 柴
+	0x04d1: 0x8c7a, // 豺
+	0x04d2: 0x6400, // 搀
+	0x04d3: 0x63ba, // 掺
+	0x04d4: 0x8749, // 蝉
+	0x04d5: 0x998b, // 馋
+	0x04d6: 0x8c17, // 谗
+	0x04d7: 0x7f20, // 缠
+	0x04d8: 0x94f2, // 铲
+	0x04d9: 0x4ea7, // 产
+	0x04da: 0x9610, // 阐
+	0x04db: 0x98a4, // 颤
+	0x04dc: 0x660c, // 昌
+	0x04dd: 0x7316, // 猖
+	0x04e0: 0x573a, // 场
+	0x04e1: 0x5c1d, // 尝
+	0x04e2: 0x5e38, // 常
+	0x04e3: 0x957f, // 长
+	0x04e4: 0x507f, // 偿
+	0x04e5: 0x80a0, // 肠
+	0x04e6: 0x5382, // 厂
+	0x04e7: 0x655e, // 敞
+	0x04e8: 0x7545, // 畅
+	0x04e9: 0x5531, // 唱
+	0x04ea: 0x5021, // 倡
+	0x04eb: 0x8d85, // 超
+	0x04ec: 0x6284, // 抄
+	0x04ed: 0x949e, // 钞
+	0x04ee: 0x671d, // 朝
+	0x04ef: 0x5632, // 嘲
+	0x04f0: 0x6f6e, // 潮
+	0x04f1: 0x5de2, // 巢
+	0x04f2: 0x5435, // 吵
+	0x04f3: 0x7092, // 炒
+	0x04f4: 0x8f66, // 车
+	0x04f5: 0x626f, // 扯
+	0x04f6: 0x64a4, // 撤
+	0x04f7: 0x63a3, // 掣
+	0x04f8: 0x5f7b, // 彻
+	0x04f9: 0x6f88, // 澈
+	0x04fa: 0x90f4, // 郴
+	0x04fb: 0x81e3, // 臣
+	0x04fc: 0x8fb0, // 辰
+	0x04fd: 0x5c18, // 尘
+	0x04fe: 0x6668, // 晨
+	0x04ff: 0x5ff1, // 忱
+	0x0500: 0x6c89, // 沉
+	0x0501: 0x9648, // 陈
+	0x0502: 0x8d81, // 趁
+	0x0503: 0x886c, // 衬
+	0x0504: 0x6491, // 撑
+	0x0505: 0x79f0, // 称
+	0x0506: 0x57ce, // 城
+	0x0507: 0x6a59, // 橙
+	0x0508: 0x6210, // 成
+	0x0509: 0x5448, // 呈
+	0x050a: 0x4e58, // 乘
+	0x050b: 0x7a0b, // 程
+	0x050c: 0x60e9, // 惩
+	0x050d: 0x6f84, // 澄
+	0x050e: 0x8bda, // 诚
+	0x050f: 0x627f, // 承
+	0x0510: 0x901e, // 逞
+	0x0511: 0x9a8b, // 骋
+	0x0512: 0x79e4, // 秤
+	0x0513: 0x5403, // 吃
+	0x0514: 0x75f4, // 痴
+	0x0515: 0x6301, // 持
+	0x0516: 0x5319, // 匙
+	0x0517: 0x6c60, // 池
+	0x0518: 0x8fdf, // 迟
+	0x0519: 0x5f1b, // 弛
+	0x051a: 0x9a70, // 驰
+	0x051b: 0x803b, // 耻
+	0x051c: 0x9f7f, // 齿
+	0x051d: 0x4f88, // 侈
+	0x051e: 0x5c3a, // 尺
+	0x051f: 0x8d64, // 赤
+	0x0520: 0x7fc5, // 翅
+	0x0521: 0x65a5, // 斥
+	0x0522: 0x70bd, // 炽
+	0x0523: 0x5145, // 充
+	0x0524: 0x51b2, // 冲
+	0x0525: 0x866b, // 虫
+	0x0526: 0x5d07, // 崇
+	0x0527: 0x5ba0, // 宠
+	0x0528: 0x62bd, // 抽
+	0x0529: 0x916c, // 酬
+	0x052a: 0x7574, // 畴
+	0x052b: 0x8e0c, // 踌
+	0x052c: 0x7a20, // 稠
+	0x052d: 0x6101, // 愁
+	0x052e: 0x7b79, // 筹
+	0x052f: 0x4ec7, // 仇
+	0x0530: 0x7ef8, // 绸
+	0x0531: 0x7785, // 瞅
+	0x0532: 0x4e11, // 丑
+	0x0533: 0x81ed, // 臭
+	0x0534: 0x521d, // 初
+	0x0535: 0x51fa, // 出
+	0x0536: 0x6a71, // 橱
+	0x0537: 0x53a8, // 厨
+	0x0538: 0x8e87, // 躇
+	0x0539: 0x9504, // 锄
+	0x053a: 0x96cf, // 雏
+	0x053b: 0x6ec1, // 滁
+	0x053c: 0x9664, // 除
+	0x053d: 0x695a, // 楚
+	0x0540: 0x7840, // 础
+	0x0541: 0x50a8, // 储
+	0x0542: 0x77d7, // 矗
+	0x0543: 0x6410, // 搐
+	0x0544: 0x89e6, // 触
+	0x0545: 0x5904, // 处
+	0x0546: 0x63e3, // 揣
+	0x0547: 0x5ddd, // 川
+	0x0548: 0x7a7f, // 穿
+	0x0549: 0x693d, // 椽
+	0x054a: 0x4f20, // 传
+	0x054b: 0x8239, // 船
+	0x054c: 0x5598, // 喘
+	0x054d: 0x4e32, // 串
+	0x054e: 0x75ae, // 疮
+	0x054f: 0x7a97, // 窗
+	0x0550: 0x5e62, // 幢
+	0x0551: 0x5e8a, // 床
+	0x0552: 0x95ef, // 闯
+	0x0553: 0x521b, // 创
+	0x0554: 0x5439, // 吹
+	0x0555: 0x708a, // 炊
+	0x0556: 0x6376, // 捶
+	0x0557: 0x9524, // 锤
+	0x0558: 0x5782, // 垂
+	0x0559: 0x6625, // 春
+	0x055a: 0x693f, // 椿
+	0x055b: 0x9187, // 醇
+	0x055c: 0x5507, // 唇
+	0x055d: 0x6df3, // 淳
+	0x055e: 0x7eaf, // 纯
+	0x055f: 0x8822, // 蠢
+	0x0560: 0x6233, // 戳
+	0x0561: 0x7ef0, // 绰
+	0x0562: 0x75b5, // 疵
+	0x0563: 0x8328, // 茨
+	0x0564: 0x78c1, // 磁
+	0x0565: 0x96cc, // 雌
+	0x0566: 0x8f9e, // 辞
+	0x0567: 0x6148, // 慈
+	0x0568: 0x74f7, // 瓷
+	0x0569: 0x8bcd, // 词
+	0x056a: 0x6b64, // 此
+	0x056b: 0x523a, // 刺
+	0x056c: 0x8d50, // 赐
+	0x056d: 0x6b21, // 次
+	0x056e: 0x806a, // 聪
+	0x056f: 0x8471, // 葱
+	0x0570: 0x56f1, // 囱
+	0x0571: 0x5306, // 匆
+	0x0572: 0x4ece, // 从
+	0x0573: 0x4e1b, // 丛
+	0x0574: 0x51d1, // 凑
+	0x0575: 0x7c97, // 粗
+	0x0576: 0x918b, // 醋
+	0x0577: 0x7c07, // 簇
+	0x0578: 0x4fc3, // 促
+	0x0579: 0x8e7f, // 蹿
+	0x057a: 0x7be1, // 篡
+	0x057b: 0x7a9c, // 窜
+	0x057c: 0x6467, // 摧
+	0x057d: 0x5d14, // 崔
+	0x057e: 0x50ac, // 催
+	0x057f: 0x8106, // 脆
+	0x0580: 0x7601, // 瘁
+	0x0581: 0x7cb9, // 粹
+	0x0582: 0x6dec, // 淬
+	0x0583: 0x7fe0, // 翠
+	0x0584: 0x6751, // 村
+	0x0585: 0x5b58, // 存
+	0x0586: 0x5bf8, // 寸
+	0x0587: 0x78cb, // 磋
+	0x0588: 0x64ae, // 撮
+	0x0589: 0x6413, // 搓
+	0x058a: 0x63aa, // 措
+	0x058b: 0x632b, // 挫
+	0x058c: 0x9519, // 错
+	0x058d: 0x642d, // 搭
+	0x058e: 0x8fbe, // 达
+	0x058f: 0x7b54, // 答
+	0x0590: 0x7629, // 瘩
+	0x0591: 0x6253, // 打
+	0x0592: 0x5927, // 大
+	0x0593: 0x5446, // 呆
+	0x0594: 0x6b79, // 歹
+	0x0595: 0x50a3, // 傣
+	0x0596: 0x6234, // 戴
+	0x0597: 0x5e26, // 带
+	0x0598: 0x6b86, // 殆
+	0x0599: 0x4ee3, // 代
+	0x059a: 0x8d37, // 贷
+	0x059b: 0x888b, // 袋
+	0x059c: 0x5f85, // 待
+	0x059d: 0x902e, // 逮
+	0x05a0: 0x6020, // 怠
+	0x05a1: 0x803d, // 耽
+	0x05a2: 0x62c5, // 担
+	0x05a3: 0x4e39, // 丹
+	0x05a4: 0x5355, // 单
+	0x05a5: 0x90f8, // 郸
+	0x05a6: 0x63b8, // 掸
+	0x05a7: 0x80c6, // 胆
+	0x05a8: 0x65e6, // 旦
+	0x05a9: 0x6c2e, // 氮
+	0x05aa: 0x4f46, // 但
+	0x05ab: 0x60ee, // 惮
+	0x05ac: 0x6de1, // 淡
+	0x05ad: 0x8bde, // 诞
+	0x05ae: 0x5f39, // 弹
+	0x05af: 0x86cb, // 蛋
+	0x05b0: 0x5f53, // 当
+	0x05b1: 0x6321, // 挡
+	0x05b2: 0x515a, // 党
+	0x05b3: 0x8361, // 荡
+	0x05b4: 0x6863, // 档
+	0x05b5: 0x5200, // 刀
+	0x05b6: 0x6363, // 捣
+	0x05b7: 0x8e48, // 蹈
+	0x05b8: 0x5012, // 倒
+	0x05b9: 0x5c9b, // 岛
+	0x05ba: 0x7977, // 祷
+	0x05bb: 0x5bfc, // 导
+	0x05bc: 0x5230, // 到
+	0x05bd: 0x7a3b, // 稻
+	0x05be: 0x60bc, // 悼
+	0x05bf: 0x9053, // 道
+	0x05c0: 0x76d7, // 盗
+	0x05c1: 0x5fb7, // 德
+	0x05c2: 0x5f97, // 得
+	0x05c3: 0x7684, // 的
+	0x05c4: 0x8e6c, // 蹬
+	0x05c5: 0x706f, // 灯
+	0x05c6: 0x767b, // 登
+	0x05c7: 0x7b49, // 等
+	0x05c8: 0x77aa, // 瞪
+	0x05c9: 0x51f3, // 凳
+	0x05ca: 0x9093, // 邓
+	0x05cb: 0x5824, // 堤
+	0x05cc: 0x4f4e, // 低
+	0x05cd: 0x6ef4, // 滴
+	0x05ce: 0x8fea, // 迪
+	0x05cf: 0x654c, // 敌
+	0x05d0: 0x7b1b, // 笛
+	0x05d1: 0x72c4, // 狄
+	0x05d2: 0x6da4, // 涤
+	0x05d3: 0x7fdf, // 翟
+	0x05d4: 0x5ae1, // 嫡
+	0x05d5: 0x62b5, // 抵
+	0x05d6: 0x5e95, // 底
+	0x05d7: 0x5730, // 地
+	0x05d8: 0x8482, // 蒂
+	0x05d9: 0x7b2c, // 第
+	0x05da: 0x5e1d, // 帝
+	0x05db: 0x5f1f, // 弟
+	0x05dc: 0x9012, // 递
+	0x05dd: 0x7f14, // 缔
+	0x05de: 0x98a0, // 颠
+	0x05df: 0x6382, // 掂
+	0x05e0: 0x6ec7, // 滇
+	0x05e1: 0x7898, // 碘
+	0x05e2: 0x70b9, // 点
+	0x05e3: 0x5178, // 典
+	0x05e4: 0x975b, // 靛
+	0x05e5: 0x57ab, // 垫
+	0x05e6: 0x7535, // 电
+	0x05e7: 0x4f43, // 佃
+	0x05e8: 0x7538, // 甸
+	0x05e9: 0x5e97, // 店
+	0x05ea: 0x60e6, // 惦
+	0x05eb: 0x5960, // 奠
+	0x05ec: 0x6dc0, // 淀
+	0x05ed: 0x6bbf, // 殿
+	0x05ee: 0x7889, // 碉
+	0x05ef: 0x53fc, // 叼
+	0x05f0: 0x96d5, // 雕
+	0x05f1: 0x51cb, // 凋
+	0x05f2: 0x5201, // 刁
+	0x05f3: 0x6389, // 掉
+	0x05f4: 0x540a, // 吊
+	0x05f5: 0x9493, // 钓
+	0x05f6: 0x8c03, // 调
+	0x05f7: 0x8dcc, // 跌
+	0x05f8: 0x7239, // 爹
+	0x05f9: 0x789f, // 碟
+	0x05fa: 0x8776, // 蝶
+	0x05fb: 0x8fed, // 迭
+	0x05fc: 0x8c0d, // 谍
+	0x05fd: 0x53e0, // 叠
+	0x0600: 0x4e01, // 丁
+	0x0601: 0x76ef, // 盯
+	0x0602: 0x53ee, // 叮
+	0x0603: 0x9489, // 钉
+	0x0604: 0x9876, // 顶
+	0x0605: 0x9f0e, // 鼎
+	0x0606: 0x952d, // 锭
+	0x0607: 0x5b9a, // 定
+	0x0608: 0x8ba2, // 订
+	0x0609: 0x4e22, // 丢
+	0x060a: 0x4e1c, // 东
+	0x060b: 0x51ac, // 冬
+	0x060c: 0x8463, // 董
+	0x060d: 0x61c2, // 懂
+	0x060e: 0x52a8, // 动
+	0x060f: 0x680b, // 栋
+	0x0610: 0x4f97, // 侗
+	0x0611: 0x606b, // 恫
+	0x0612: 0x51bb, // 冻
+	0x0613: 0x6d1e, // 洞
+	0x0614: 0x515c, // 兜
+	0x0615: 0x6296, // 抖
+	0x0616: 0x6597, // 斗
+	0x0617: 0x9661, // 陡
+	0x0618: 0x8c46, // 豆
+	0x0619: 0x9017, // 逗
+	0x061a: 0x75d8, // 痘
+	0x061b: 0x90fd, // 都
+	0x061c: 0x7763, // 督
+	0x061d: 0x6bd2, // 毒
+	0x061e: 0x728a, // 犊
+	0x061f: 0x72ec, // 独
+	0x0620: 0x8bfb, // 读
+	0x0621: 0x5835, // 堵
+	0x0622: 0x7779, // 睹
+	0x0623: 0x8d4c, // 赌
+	0x0624: 0x675c, // 杜
+	0x0625: 0x9540, // 镀
+	0x0626: 0x809a, // 肚
+	0x0627: 0x5ea6, // 度
+	0x0628: 0x6e21, // 渡
+	0x0629: 0x5992, // 妒
+	0x062a: 0x7aef, // 端
+	0x062b: 0x77ed, // 短
+	0x062c: 0x953b, // 锻
+	0x062d: 0x6bb5, // 段
+	0x062e: 0x65ad, // 断
+	0x062f: 0x7f0e, // 缎
+	0x0630: 0x5806, // 堆
+	0x0631: 0x5151, // 兑
+	0x0632: 0x961f, // 队
+	0x0633: 0x5bf9, // 对
+	0x0634: 0x58a9, // 墩
+	0x0635: 0x5428, // 吨
+	0x0636: 0x8e72, // 蹲
+	0x0637: 0x6566, // 敦
+	0x0638: 0x987f, // 顿
+	0x0639: 0x56e4, // 囤
+	0x063a: 0x949d, // 钝
+	0x063b: 0x76fe, // 盾
+	0x063c: 0x9041, // 遁
+	0x063d: 0x6387, // 掇
+	0x063e: 0x54c6, // 哆
+	0x063f: 0x591a, // 多
+	0x0640: 0x593a, // 夺
+	0x0641: 0x579b, // 垛
+	0x0642: 0x8eb2, // 躲
+	0x0643: 0x6735, // 朵
+	0x0644: 0x8dfa, // 跺
+	0x0645: 0x8235, // 舵
+	0x0646: 0x5241, // 剁
+	0x0647: 0x60f0, // 惰
+	0x0648: 0x5815, // 堕
+	0x0649: 0x86fe, // 蛾
+	0x064a: 0x5ce8, // 峨
+	0x064b: 0x9e45, // 鹅
+	0x064c: 0x4fc4, // 俄
+	0x064d: 0x989d, // 额
+	0x064e: 0x8bb9, // 讹
+	0x064f: 0x5a25, // 娥
+	0x0650: 0x6076, // 恶
+	0x0651: 0x5384, // 厄
+	0x0652: 0x627c, // 扼
+	0x0653: 0x904f, // 遏
+	0x0654: 0x9102, // 鄂
+	0x0655: 0x997f, // 饿
+	0x0656: 0x6069, // 恩
+	0x0657: 0x800c, // 而
+	0x0658: 0x513f, // 儿
+	0x0659: 0x8033, // 耳
+	0x065a: 0x5c14, // 尔
+	0x065b: 0x9975, // 饵
+	0x065c: 0x6d31, // 洱
+	0x065d: 0x4e8c, // 二
+	0x0660: 0x8d30, // 贰
+	0x0661: 0x53d1, // 发
+	0x0662: 0x7f5a, // 罚
+	0x0663: 0x7b4f, // 筏
+	0x0664: 0x4f10, // 伐
+	0x0665: 0x4e4f, // 乏
+	0x0666: 0x9600, // 阀
+	0x0667: 0x6cd5, // 法
+	0x0668: 0x73d0, // 珐
+	0x0669: 0x85e9, // 藩
+	0x066a: 0x5e06, // 帆
+	0x066b: 0x756a, // 番
+	0x066c: 0x7ffb, // 翻
+	0x066d: 0x6a0a, // 樊
+	0x066e: 0x77fe, // 矾
+	0x066f: 0x9492, // 钒
+	0x0670: 0x7e41, // 繁
+	0x0671: 0x51e1, // 凡
+	0x0672: 0x70e6, // 烦
+	0x0673: 0x53cd, // 反
+	0x0674: 0x8fd4, // 返
+	0x0675: 0x8303, // 范
+	0x0676: 0x8d29, // 贩
+	0x0677: 0x72af, // 犯
+	0x0678: 0x996d, // 饭
+	0x0679: 0x6cdb, // 泛
+	0x067a: 0x574a, // 坊
+	0x067b: 0x82b3, // 芳
+	0x067c: 0x65b9, // 方
+	0x067d: 0x80aa, // 肪
+	0x067e: 0x623f, // 房
+	0x067f: 0x9632, // 防
+	0x0680: 0x59a8, // 妨
+	0x0681: 0x4eff, // 仿
+	0x0682: 0x8bbf, // 访
+	0x0683: 0x7eba, // 纺
+	0x0684: 0x653e, // 放
+	0x0685: 0x83f2, // 菲
+	0x0686: 0x975e, // 非
+	0x0687: 0x5561, // 啡
+	0x0688: 0x98de, // 飞
+	0x0689: 0x80a5, // 肥
+	0x068a: 0x532a, // 匪
+	0x068b: 0x8bfd, // 诽
+	0x068c: 0x5420, // 吠
+	0x068d: 0x80ba, // 肺
+	0x068e: 0x5e9f, // 废
+	0x068f: 0x6cb8, // 沸
+	0x0690: 0x8d39, // 费
+	0x0691: 0x82ac, // 芬
+	0x0692: 0x915a, // 酚
+	0x0693: 0x5429, // 吩
+	0x0694: 0x6c1b, // 氛
+	0x0695: 0x5206, // 分
+	0x0696: 0x7eb7, // 纷
+	0x0697: 0x575f, // 坟
+	0x0698: 0x711a, // 焚
+	0x0699: 0x6c7e, // 汾
+	0x069a: 0x7c89, // 粉
+	0x069b: 0x594b, // 奋
+	0x069c: 0x4efd, // 份
+	0x069d: 0x5fff, // 忿
+	0x069e: 0x6124, // 愤
+	0x069f: 0x7caa, // 粪
+	0x06a0: 0x4e30, // 丰
+	0x06a1: 0x5c01, // 封
+	0x06a2: 0x67ab, // 枫
+	0x06a3: 0x8702, // 蜂
+	0x06a4: 0x5cf0, // 峰
+	0x06a5: 0x950b, // 锋
+	0x06a6: 0x98ce, // 风
+	0x06a7: 0x75af, // 疯
+	0x06a8: 0x70fd, // 烽
+	0x06a9: 0x9022, // 逢
+	0x06aa: 0x51af, // 冯
+	0x06ab: 0x7f1d, // 缝
+	0x06ac: 0x8bbd, // 讽
+	0x06ad: 0x5949, // 奉
+	0x06ae: 0x51e4, // 凤
+	0x06af: 0x4f5b, // 佛
+	0x06b0: 0x5426, // 否
+	0x06b1: 0x592b, // 夫
+	0x06b2: 0x6577, // 敷
+	0x06b3: 0x80a4, // 肤
+	0x06b4: 0x5b75, // 孵
+	0x06b5: 0x6276, // 扶
+	0x06b6: 0x62c2, // 拂
+	0x06b7: 0x8f90, // 辐
+	0x06b8: 0x5e45, // 幅
+	0x06b9: 0x6c1f, // 氟
+	0x06ba: 0x7b26, // 符
+	0x06bb: 0x4f0f, // 伏
+	0x06bc: 0x4fd8, // 俘
+	0x06bd: 0x670d, // 服
+	0x06c0: 0x6d6e, // 浮
+	0x06c1: 0x6daa, // 涪
+	0x06c2: 0x798f, // 福
+	0x06c3: 0x88b1, // 袱
+	0x06c4: 0x5f17, // 弗
+	0x06c5: 0x752b, // 甫
+	0x06c6: 0x629a, // 抚
+	0x06c7: 0x8f85, // 辅
+	0x06c8: 0x4fef, // 俯
+	0x06c9: 0x91dc, // 釜
+	0x06ca: 0x65a7, // 斧
+	0x06cb: 0x812f, // 脯
+	0x06cc: 0x8151, // 腑
+	0x06cd: 0x5e9c, // 府
+	0x06ce: 0x8150, // 腐
+	0x06cf: 0x8d74, // 赴
+	0x06d0: 0x526f, // 副
+	0x06d1: 0x8986, // 覆
+	0x06d2: 0x8d4b, // 赋
+	0x06d3: 0x590d, // 复
+	0x06d4: 0x5085, // 傅
+	0x06d5: 0x4ed8, // 付
+	0x06d6: 0x961c, // 阜
+	0x06d7: 0x7236, // 父
+	0x06d8: 0x8179, // 腹
+	0x06d9: 0x8d1f, // 负
+	0x06da: 0x5bcc, // 富
+	0x06db: 0x8ba3, // 讣
+	0x06dc: 0x9644, // 附
+	0x06dd: 0x5987, // 妇
+	0x06de: 0x7f1a, // 缚
+	0x06df: 0x5490, // 咐
+	0x06e0: 0x5676, // 噶
+	0x06e1: 0x560e, // 嘎
+	0x06e2: 0x8be5, // 该
+	0x06e3: 0x6539, // 改
+	0x06e4: 0x6982, // 概
+	0x06e5: 0x9499, // 钙
+	0x06e6: 0x76d6, // 盖
+	0x06e7: 0x6e89, // 溉
+	0x06e8: 0x5e72, // 干
+	0x06e9: 0x7518, // 甘
+	0x06ea: 0x6746, // 杆
+	0x06eb: 0x67d1, // 柑
+	0x06ec: 0x7aff, // 竿
+	0x06ed: 0x809d, // 肝
+	0x06ee: 0x8d76, // 赶
+	0x06ef: 0x611f, // 感
+	0x06f0: 0x79c6, // 秆
+	0x06f1: 0x6562, // 敢
+	0x06f2: 0x8d63, // 赣
+	0x06f3: 0x5188, // 冈
+	0x06f4: 0x521a, // 刚
+	0x06f5: 0x94a2, // 钢
+	0x06f6: 0x7f38, // 缸
+	0x06f7: 0x809b, // 肛
+	0x06f8: 0x7eb2, // 纲
+	0x06f9: 0x5c97, // 岗
+	0x06fa: 0x6e2f, // 港
+	0x06fb: 0x6760, // 杠
+	0x06fc: 0x7bd9, // 篙
+	0x06fd: 0x768b, // 皋
+	0x06fe: 0x9ad8, // 高
+	0x06ff: 0x818f, // 膏
+	0x0700: 0x7f94, // 羔
+	0x0701: 0x7cd5, // 糕
+	0x0702: 0x641e, // 搞
+	0x0703: 0x9550, // 镐
+	0x0704: 0x7a3f, // 稿
+	0x0705: 0x544a, // 告
+	0x0706: 0x54e5, // 哥
+	0x0707: 0x6b4c, // 歌
+	0x0708: 0x6401, // 搁
+	0x0709: 0x6208, // 戈
+	0x070a: 0x9e3d, // 鸽
+	0x070b: 0x80f3, // 胳
+	0x070c: 0x7599, // 疙
+	0x070d: 0x5272, // 割
+	0x070e: 0x9769, // 革
+	0x070f: 0x845b, // 葛
+	0x0710: 0x683c, // 格
+	0x0711: 0x86e4, // 蛤
+	0x0712: 0x9601, // 阁
+	0x0713: 0x9694, // 隔
+	0x0714: 0x94ec, // 铬
+	0x0715: 0x4e2a, // 个
+	0x0716: 0x5404, // 各
+	0x0717: 0x7ed9, // 给
+	0x0718: 0x6839, // 根
+	0x0719: 0x8ddf, // 跟
+	0x071a: 0x8015, // 耕
+	0x071b: 0x66f4, // 更
+	0x071c: 0x5e9a, // 庚
+	0x071d: 0x7fb9, // 羹
+	0x0720: 0x57c2, // 埂
+	0x0721: 0x803f, // 耿
+	0x0722: 0x6897, // 梗
+	0x0723: 0x5de5, // 工
+	0x0724: 0x653b, // 攻
+	0x0725: 0x529f, // 功
+	0x0726: 0x606d, // 恭
+	0x0727: 0x9f9a, // 龚
+	0x0728: 0x4f9b, // 供
+	0x0729: 0x8eac, // 躬
+	0x072a: 0x516c, // 公
+	0x072b: 0x5bab, // 宫
+	0x072c: 0x5f13, // 弓
+	0x072d: 0x5de9, // 巩
+	0x072e: 0x6c5e, // 汞
+	0x072f: 0x62f1, // 拱
+	0x0730: 0x8d21, // 贡
+	0x0731: 0x5171, // 共
+	0x0732: 0x94a9, // 钩
+	0x0733: 0x52fe, // 勾
+	0x0734: 0x6c9f, // 沟
+	0x0735: 0x82df, // 苟
+	0x0736: 0x72d7, // 狗
+	0x0737: 0x57a2, // 垢
+	0x0738: 0x6784, // 构
+	0x0739: 0x8d2d, // 购
+	0x073a: 0x591f, // 够
+	0x073b: 0x8f9c, // 辜
+	0x073c: 0x83c7, // 菇
+	0x073d: 0x5495, // 咕
+	0x073e: 0x7b8d, // 箍
+	0x073f: 0x4f30, // 估
+	0x0740: 0x6cbd, // 沽
+	0x0741: 0x5b64, // 孤
+	0x0742: 0x59d1, // 姑
+	0x0743: 0x9f13, // 鼓
+	0x0744: 0x53e4, // 古
+	0x0745: 0x86ca, // 蛊
+	0x0746: 0x9aa8, // 骨
+	0x0747: 0x8c37, // 谷
+	0x0748: 0x80a1, // 股
+	0x0749: 0x6545, // 故
+	0x074a: 0x987e, // 顾
+	0x074b: 0x56fa, // 固
+	0x074c: 0x96c7, // 雇
+	0x074d: 0x522e, // 刮
+	0x074e: 0x74dc, // 瓜
+	0x074f: 0x5250, // 剐
+	0x0750: 0x5be1, // 寡
+	0x0751: 0x6302, // 挂
+	0x0752: 0x8902, // 褂
+	0x0753: 0x4e56, // 乖
+	0x0754: 0x62d0, // 拐
+	0x0755: 0x602a, // 怪
+	0x0756: 0x68fa, // 棺
+	0x0757: 0x5173, // 关
+	0x0758: 0x5b98, // 官
+	0x0759: 0x51a0, // 冠
+	0x075a: 0x89c2, // 观
+	0x075b: 0x7ba1, // 管
+	0x075c: 0x9986, // 馆
+	0x075d: 0x7f50, // 罐
+	0x075e: 0x60ef, // 惯
+	0x075f: 0x704c, // 灌
+	0x0760: 0x8d2f, // 贯
+	0x0761: 0x5149, // 光
+	0x0762: 0x5e7f, // 广
+	0x0763: 0x901b, // 逛
+	0x0764: 0x7470, // 瑰
+	0x0765: 0x89c4, // 规
+	0x0766: 0x572d, // 圭
+	0x0767: 0x7845, // 硅
+	0x0768: 0x5f52, // 归
+	0x0769: 0x9f9f, // 龟
+	0x076a: 0x95fa, // 闺
+	0x076b: 0x8f68, // 轨
+	0x076c: 0x9b3c, // 鬼
+	0x076d: 0x8be1, // 诡
+	0x076e: 0x7678, // 癸
+	0x076f: 0x6842, // 桂
+	0x0770: 0x67dc, // 柜
+	0x0771: 0x8dea, // 跪
+	0x0772: 0x8d35, // 贵
+	0x0773: 0x523d, // 刽
+	0x0774: 0x8f8a, // 辊
+	0x0775: 0x6eda, // 滚
+	0x0776: 0x68cd, // 棍
+	0x0777: 0x9505, // 锅
+	0x0778: 0x90ed, // 郭
+	0x0779: 0x56fd, // 国
+	0x077a: 0x679c, // 果
+	0x077b: 0x88f9, // 裹
+	0x077c: 0x8fc7, // 过
+	0x077d: 0x54c8, // 哈
+	0x0780: 0x9ab8, // 骸
+	0x0781: 0x5b69, // 孩
+	0x0782: 0x6d77, // 海
+	0x0783: 0x6c26, // 氦
+	0x0784: 0x4ea5, // 亥
+	0x0785: 0x5bb3, // 害
+	0x0786: 0x9a87, // 骇
+	0x0787: 0x9163, // 酣
+	0x0788: 0x61a8, // 憨
+	0x0789: 0x90af, // 邯
+	0x078a: 0x97e9, // 韩
+	0x078b: 0x542b, // 含
+	0x078c: 0x6db5, // 涵
+	0x078d: 0x5bd2, // 寒
+	0x078e: 0x51fd, // 函
+	0x078f: 0x558a, // 喊
+	0x0790: 0x7f55, // 罕
+	0x0791: 0x7ff0, // 翰
+	0x0792: 0x64bc, // 撼
+	0x0793: 0x634d, // 捍
+	0x0794: 0x65f1, // 旱
+	0x0795: 0x61be, // 憾
+	0x0796: 0x608d, // 悍
+	0x0797: 0x710a, // 焊
+	0x0798: 0x6c57, // 汗
+	0x0799: 0x6c49, // 汉
+	0x079a: 0x592f, // 夯
+	0x079b: 0x676d, // 杭
+	0x079c: 0x822a, // 航
+	0x079d: 0x58d5, // 壕
+	0x079e: 0x568e, // 嚎
+	0x079f: 0x8c6a, // 豪
+	0x07a0: 0x6beb, // 毫
+	0x07a1: 0x90dd, // 郝
+	0x07a2: 0x597d, // 好
+	0x07a3: 0x8017, // 耗
+	0x07a4: 0x53f7, // 号
+	0x07a5: 0x6d69, // 浩
+	0x07a6: 0x5475, // 呵
+	0x07a7: 0x559d, // 喝
+	0x07a8: 0x8377, // 荷
+	0x07a9: 0x83cf, // 菏
+	0x07aa: 0x6838, // 核
+	0x07ab: 0x79be, // 禾
+	0x07ac: 0x548c, // 和
+	0x07ad: 0x4f55, // 何
+	0x07ae: 0x5408, // 合
+	0x07af: 0x76d2, // 盒
+	0x07b0: 0x8c89, // 貉
+	0x07b1: 0x9602, // 阂
+	0x07b2: 0x6cb3, // 河
+	0x07b3: 0x6db8, // 涸
+	0x07b4: 0x8d6b, // 赫
+	0x07b5: 0x8910, // 褐
+	0x07b6: 0x9e64, // 鹤
+	0x07b7: 0x8d3a, // 贺
+	0x07b8: 0x563f, // 嘿
+	0x07b9: 0x9ed1, // 黑
+	0x07ba: 0x75d5, // 痕
+	0x07bb: 0x5f88, // 很
+	0x07bc: 0x72e0, // 狠
+	0x07bd: 0x6068, // 恨
+	0x07be: 0x54fc, // 哼
+	0x07bf: 0x4ea8, // 亨
+	0x07c0: 0x6a2a, // 横
+	0x07c1: 0x8861, // 衡
+	0x07c2: 0x6052, // 恒
+	0x07c3: 0x8f70, // 轰
+	0x07c4: 0x54c4, // 哄
+	0x07c5: 0x70d8, // 烘
+	0x07c6: 0x8679, // 虹
+	0x07c7: 0x9e3f, // 鸿
+	0x07c8: 0x6d2a, // 洪
+	0x07c9: 0x5b8f, // 宏
+	0x07ca: 0x5f18, // 弘
+	0x07cb: 0x7ea2, // 红
+	0x07cc: 0x5589, // 喉
+	0x07cd: 0x4faf, // 侯
+	0x07ce: 0x7334, // 猴
+	0x07cf: 0x543c, // 吼
+	0x07d0: 0x539a, // 厚
+	0x07d1: 0x5019, // 候
+	0x07d2: 0x540e, // 后
+	0x07d3: 0x547c, // 呼
+	0x07d4: 0x4e4e, // 乎
+	0x07d5: 0x5ffd, // 忽
+	0x07d6: 0x745a, // 瑚
+	0x07d7: 0x58f6, // 壶
+	0x07d8: 0x846b, // 葫
+	0x07d9: 0x80e1, // 胡
+	0x07da: 0x8774, // 蝴
+	0x07db: 0x72d0, // 狐
+	0x07dc: 0x7cca, // 糊
+	0x07dd: 0x6e56, // 湖
+	0x07e0: 0x5f27, // 弧
+	0x07e1: 0x864e, // 虎
+	0x07e2: 0x552c, // 唬
+	0x07e3: 0x62a4, // 护
+	0x07e4: 0x4e92, // 互
+	0x07e5: 0x6caa, // 沪
+	0x07e6: 0x6237, // 户
+	0x07e7: 0x82b1, // 花
+	0x07e8: 0x54d7, // 哗
+	0x07e9: 0x534e, // 华
+	0x07ea: 0x733e, // 猾
+	0x07eb: 0x6ed1, // 滑
+	0x07ec: 0x753b, // 画
+	0x07ed: 0x5212, // 划
+	0x07ee: 0x5316, // 化
+	0x07ef: 0x8bdd, // 话
+	0x07f0: 0x69d0, // 槐
+	0x07f1: 0x5f8a, // 徊
+	0x07f2: 0x6000, // 怀
+	0x07f3: 0x6dee, // 淮
+	0x07f4: 0x574f, // 坏
+	0x07f5: 0x6b22, // 欢
+	0x07f6: 0x73af, // 环
+	0x07f7: 0x6853, // 桓
+	0x07f8: 0x8fd8, // 还
+	0x07f9: 0x7f13, // 缓
+	0x07fa: 0x6362, // 换
+	0x07fb: 0x60a3, // 患
+	0x07fc: 0x5524, // 唤
+	0x07fd: 0x75ea, // 痪
+	0x07fe: 0x8c62, // 豢
+	0x07ff: 0x7115, // 焕
+	0x0800: 0x6da3, // 涣
+	0x0801: 0x5ba6, // 宦
+	0x0802: 0x5e7b, // 幻
+	0x0803: 0x8352, // 荒
+	0x0804: 0x614c, // 慌
+	0x0805: 0x9ec4, // 黄
+	0x0806: 0x78fa, // 磺
+	0x0807: 0x8757, // 蝗
+	0x0808: 0x7c27, // 簧
+	0x0809: 0x7687, // 皇
+	0x080a: 0x51f0, // 凰
+	0x080b: 0x60f6, // 惶
+	0x080c: 0x714c, // 煌
+	0x080d: 0x6643, // 晃
+	0x080e: 0x5e4c, // 幌
+	0x080f: 0x604d, // 恍
+	0x0810: 0x8c0e, // 谎
+	0x0811: 0x7070, // 灰
+	0x0812: 0x6325, // 挥
+	0x0813: 0x8f89, // 辉
+	0x0814: 0x5fbd, // 徽
+	0x0815: 0x6062, // 恢
+	0x0816: 0x86d4, // 蛔
+	0x0817: 0x56de, // 回
+	0x0818: 0x6bc1, // 毁
+	0x0819: 0x6094, // 悔
+	0x081a: 0x6167, // 慧
+	0x081b: 0x5349, // 卉
+	0x081c: 0x60e0, // 惠
+	0x081d: 0x6666, // 晦
+	0x081e: 0x8d3f, // 贿
+	0x081f: 0x79fd, // 秽
+	0x0820: 0x4f1a, // 会
+	0x0821: 0x70e9, // 烩
+	0x0822: 0x6c47, // 汇
+	0x0823: 0x8bb3, // 讳
+	0x0824: 0x8bf2, // 诲
+	0x0825: 0x7ed8, // 绘
+	0x0826: 0x8364, // 荤
+	0x0827: 0x660f, // 昏
+	0x0828: 0x5a5a, // 婚
+	0x0829: 0x9b42, // 魂
+	0x082a: 0x6d51, // 浑
+	0x082b: 0x6df7, // 混
+	0x082c: 0x8c41, // 豁
+	0x082d: 0x6d3b, // 活
+	0x082e: 0x4f19, // 伙
+	0x082f: 0x706b, // 火
+	0x0830: 0x83b7, // 获
+	0x0831: 0x6216, // 或
+	0x0832: 0x60d1, // 惑
+	0x0833: 0x970d, // 霍
+	0x0834: 0x8d27, // 货
+	0x0835: 0x7978, // 祸
+	0x0836: 0x51fb, // 击
+	0x0837: 0x573e, // 圾
+	0x0838: 0x57fa, // 基
+	0x0839: 0x673a, // 机
+	0x083a: 0x7578, // 畸
+	0x083b: 0x7a3d, // 稽
+	0x083c: 0x79ef, // 积
+	0x083d: 0x7b95, // 箕
+	0x0840: 0x808c, // 肌
+	0x0841: 0x9965, // 饥
+	0x0842: 0x8ff9, // 迹
+	0x0843: 0x6fc0, // 激
+	0x0844: 0x8ba5, // 讥
+	0x0845: 0x9e21, // 鸡
+	0x0846: 0x59ec, // 姬
+	0x0847: 0x7ee9, // 绩
+	0x0848: 0x7f09, // 缉
+	0x0849: 0x5409, // 吉
+	0x084a: 0x6781, // 极
+	0x084b: 0x68d8, // 棘
+	0x084c: 0x8f91, // 辑
+	0x084d: 0x7c4d, // 籍
+	0x084e: 0x96c6, // 集
+	0x084f: 0x53ca, // 及
+	0x0850: 0x6025, // 急
+	0x0851: 0x75be, // 疾
+	0x0852: 0x6c72, // 汲
+	0x0853: 0x5373, // 即
+	0x0854: 0x5ac9, // 嫉
+	0x0855: 0x7ea7, // 级
+	0x0856: 0x6324, // 挤
+	0x0857: 0x51e0, // 几
+	0x0858: 0x810a, // 脊
+	0x0859: 0x5df1, // 己
+	0x085a: 0x84df, // 蓟
+	0x085b: 0x6280, // 技
+	0x085c: 0x5180, // 冀
+	0x085d: 0x5b63, // 季
+	0x085e: 0x4f0e, // 伎
+	0x085f: 0x796d, // 祭
+	0x0860: 0x5242, // 剂
+	0x0861: 0x60b8, // 悸
+	0x0862: 0x6d4e, // 济
+	0x0863: 0x5bc4, // 寄
+	0x0864: 0x5bc2, // 寂
+	0x0865: 0x8ba1, // 计
+	0x0866: 0x8bb0, // 记
+	0x0867: 0x65e2, // 既
+	0x0868: 0x5fcc, // 忌
+	0x0869: 0x9645, // 际
+	0x086a: 0x5993, // 妓
+	0x086b: 0x7ee7, // 继
+	0x086c: 0x7eaa, // 纪
+	0x086d: 0x5609, // 嘉
+	0x086e: 0x67b7, // 枷
+	0x086f: 0x5939, // 夹
+	0x0870: 0x4f73, // 佳
+	0x0871: 0x5bb6, // 家
+	0x0872: 0x52a0, // 加
+	0x0873: 0x835a, // 荚
+	0x0874: 0x988a, // 颊
+	0x0875: 0x8d3e, // 贾
+	0x0876: 0x7532, // 甲
+	0x0877: 0x94be, // 钾
+	0x0878: 0x5047, // 假
+	0x0879: 0x7a3c, // 稼
+	0x087a: 0x4ef7, // 价
+	0x087b: 0x67b6, // 架
+	0x087c: 0x9a7e, // 驾
+	0x087d: 0x5ac1, // 嫁
+	0x087e: 0x6b7c, // 歼
+	0x087f: 0x76d1, // 监
+	0x0880: 0x575a, // 坚
+	0x0881: 0x5c16, // 尖
+	0x0882: 0x7b3a, // 笺
+	0x0883: 0x95f4, // 间
+	0x0884: 0x714e, // 煎
+	0x0885: 0x517c, // 兼
+	0x0886: 0x80a9, // 肩
+	0x0887: 0x8270, // 艰
+	0x0888: 0x5978, // 奸
+	0x0889: 0x7f04, // 缄
+	0x088a: 0x8327, // 茧
+	0x088b: 0x68c0, // 检
+	0x088c: 0x67ec, // 柬
+	0x088d: 0x78b1, // 碱
+	0x088e: 0x7877, // 硷
+	0x088f: 0x62e3, // 拣
+	0x0890: 0x6361, // 捡
+	0x0891: 0x7b80, // 简
+	0x0892: 0x4fed, // 俭
+	0x0893: 0x526a, // 剪
+	0x0894: 0x51cf, // 减
+	0x0895: 0x8350, // 荐
+	0x0896: 0x69db, // 槛
+	0x0897: 0x9274, // 鉴
+	0x0898: 0x8df5, // 践
+	0x0899: 0x8d31, // 贱
+	0x089a: 0x89c1, // 见
+	0x089b: 0x952e, // 键
+	0x089c: 0x7bad, // 箭
+	0x089d: 0x4ef6, // 件
+	0x08a0: 0x5065, // 健
+	0x08a1: 0x8230, // 舰
+	0x08a2: 0x5251, // 剑
+	0x08a3: 0x996f, // 饯
+	0x08a4: 0x6e10, // 渐
+	0x08a5: 0x6e85, // 溅
+	0x08a6: 0x6da7, // 涧
+	0x08a7: 0x5efa, // 建
+	0x08a8: 0x50f5, // 僵
+	0x08a9: 0x59dc, // 姜
+	0x08aa: 0x5c06, // 将
+	0x08ab: 0x6d46, // 浆
+	0x08ac: 0x6c5f, // 江
+	0x08ad: 0x7586, // 疆
+	0x08ae: 0x848b, // 蒋
+	0x08af: 0x6868, // 桨
+	0x08b0: 0x5956, // 奖
+	0x08b1: 0x8bb2, // 讲
+	0x08b2: 0x5320, // 匠
+	0x08b3: 0x9171, // 酱
+	0x08b4: 0x964d, // 降
+	0x08b5: 0x8549, // 蕉
+	0x08b6: 0x6912, // 椒
+	0x08b7: 0x7901, // 礁
+	0x08b8: 0x7126, // 焦
+	0x08b9: 0x80f6, // 胶
+	0x08ba: 0x4ea4, // 交
+	0x08bb: 0x90ca, // 郊
+	0x08bc: 0x6d47, // 浇
+	0x08bd: 0x9a84, // 骄
+	0x08be: 0x5a07, // 娇
+	0x08bf: 0x56bc, // 嚼
+	0x08c0: 0x6405, // 搅
+	0x08c1: 0x94f0, // 铰
+	0x08c2: 0x77eb, // 矫
+	0x08c3: 0x4fa5, // 侥
+	0x08c4: 0x811a, // 脚
+	0x08c5: 0x72e1, // 狡
+	0x08c6: 0x89d2, // 角
+	0x08c7: 0x997a, // 饺
+	0x08c8: 0x7f34, // 缴
+	0x08c9: 0x7ede, // 绞
+	0x08ca: 0x527f, // 剿
+	0x08cb: 0x6559, // 教
+	0x08cc: 0x9175, // 酵
+	0x08cd: 0x8f7f, // 轿
+	0x08ce: 0x8f83, // 较
+	0x08cf: 0x53eb, // 叫
+	0x08d0: 0x7a96, // 窖
+	0x08d1: 0x63ed, // 揭
+	0x08d2: 0x63a5, // 接
+	0x08d3: 0x7686, // 皆
+	0x08d4: 0x79f8, // 秸
+	0x08d5: 0x8857, // 街
+	0x08d6: 0x9636, // 阶
+	0x08d7: 0x622a, // 截
+	0x08d8: 0x52ab, // 劫
+	0x08d9: 0x8282, // 节
+	0x08da: 0x6854, // 桔
+	0x08db: 0x6770, // 杰
+	0x08dc: 0x6377, // 捷
+	0x08dd: 0x776b, // 睫
+	0x08de: 0x7aed, // 竭
+	0x08df: 0x6d01, // 洁
+	0x08e0: 0x7ed3, // 结
+	0x08e1: 0x89e3, // 解
+	0x08e2: 0x59d0, // 姐
+	0x08e3: 0x6212, // 戒
+	0x08e4: 0x85c9, // 藉
+	0x08e5: 0x82a5, // 芥
+	0x08e6: 0x754c, // 界
+	0x08e7: 0x501f, // 借
+	0x08e8: 0x4ecb, // 介
+	0x08e9: 0x75a5, // 疥
+	0x08ea: 0x8beb, // 诫
+	0x08eb: 0x5c4a, // 届
+	0x08ec: 0x5dfe, // 巾
+	0x08ed: 0x7b4b, // 筋
+	0x08ee: 0x65a4, // 斤
+	0x08ef: 0x91d1, // 金
+	0x08f0: 0x4eca, // 今
+	0x08f1: 0x6d25, // 津
+	0x08f2: 0x895f, // 襟
+	0x08f3: 0x7d27, // 紧
+	0x08f4: 0x9526, // 锦
+	0x08f5: 0x4ec5, // 仅
+	0x08f6: 0x8c28, // 谨
+	0x08f7: 0x8fdb, // 进
+	0x08f8: 0x9773, // 靳
+	0x08f9: 0x664b, // 晋
+	0x08fa: 0x7981, // 禁
+	0x08fb: 0x8fd1, // 近
+	0x08fc: 0x70ec, // 烬
+	0x08fd: 0x6d78, // 浸
+	0x0900: 0x5c3d, // 尽
+	0x0901: 0x52b2, // 劲
+	0x0902: 0x8346, // 荆
+	0x0903: 0x5162, // 兢
+	0x0904: 0x830e, // 茎
+	0x0905: 0x775b, // 睛
+	0x0906: 0x6676, // 晶
+	0x0907: 0x9cb8, // 鲸
+	0x0908: 0x4eac, // 京
+	0x0909: 0x60ca, // 惊
+	0x090a: 0x7cbe, // 精
+	0x090b: 0x7cb3, // 粳
+	0x090c: 0x7ecf, // 经
+	0x090d: 0x4e95, // 井
+	0x090e: 0x8b66, // 警
+	0x090f: 0x666f, // 景
+	0x0910: 0x9888, // 颈
+	0x0911: 0x9759, // 静
+	0x0912: 0x5883, // 境
+	0x0913: 0x656c, // 敬
+	0x0914: 0x955c, // 镜
+	0x0915: 0x5f84, // 径
+	0x0916: 0x75c9, // 痉
+	0x0917: 0x9756, // 靖
+	0x0918: 0x7adf, // 竟
+	0x0919: 0x7ade, // 竞
+	0x091a: 0x51c0, // 净
+	0x091b: 0x70af, // 炯
+	0x091c: 0x7a98, // 窘
+	0x091d: 0x63ea, // 揪
+	0x091e: 0x7a76, // 究
+	0x091f: 0x7ea0, // 纠
+	0x0920: 0x7396, // 玖
+	0x0921: 0x97ed, // 韭
+	0x0922: 0x4e45, // 久
+	0x0923: 0x7078, // 灸
+	0x0924: 0x4e5d, // 九
+	0x0925: 0x9152, // 酒
+	0x0926: 0x53a9, // 厩
+	0x0927: 0x6551, // 救
+	0x0928: 0x65e7, // 旧
+	0x0929: 0x81fc, // 臼
+	0x092a: 0x8205, // 舅
+	0x092b: 0x548e, // 咎
+	0x092c: 0x5c31, // 就
+	0x092d: 0x759a, // 疚
+	0x092e: 0x97a0, // 鞠
+	0x092f: 0x62d8, // 拘
+	0x0930: 0x72d9, // 狙
+	0x0931: 0x75bd, // 疽
+	0x0932: 0x5c45, // 居
+	0x0933: 0x9a79, // 驹
+	0x0934: 0x83ca, // 菊
+	0x0935: 0x5c40, // 局
+	0x0936: 0x5480, // 咀
+	0x0937: 0x77e9, // 矩
+	0x0938: 0x4e3e, // 举
+	0x0939: 0x6cae, // 沮
+	0x093a: 0x805a, // 聚
+	0x093b: 0x62d2, // 拒
+	0x093c: 0x636e, // 据
+	0x093d: 0x5de8, // 巨
+	0x093e: 0x5177, // 具
+	0x093f: 0x8ddd, // 距
+	0x0940: 0x8e1e, // 踞
+	0x0941: 0x952f, // 锯
+	0x0942: 0x4ff1, // 俱
+	0x0943: 0x53e5, // 句
+	0x0944: 0x60e7, // 惧
+	0x0945: 0x70ac, // 炬
+	0x0946: 0x5267, // 剧
+	0x0947: 0x6350, // 捐
+	0x0948: 0x9e43, // 鹃
+	0x0949: 0x5a1f, // 娟
+	0x094a: 0x5026, // 倦
+	0x094b: 0x7737, // 眷
+	0x094c: 0x5377, // 卷
+	0x094d: 0x7ee2, // 绢
+	0x094e: 0x6485, // 撅
+	0x094f: 0x652b, // 攫
+	0x0950: 0x6289, // 抉
+	0x0951: 0x6398, // 掘
+	0x0952: 0x5014, // 倔
+	0x0953: 0x7235, // 爵
+	0x0954: 0x89c9, // 觉
+	0x0955: 0x51b3, // 决
+	0x0956: 0x8bc0, // 诀
+	0x0957: 0x7edd, // 绝
+	0x0958: 0x5747, // 均
+	0x0959: 0x83cc, // 菌
+	0x095a: 0x94a7, // 钧
+	0x095b: 0x519b, // 军
+	0x095c: 0x541b, // 君
+	0x095d: 0x5cfb, // 峻
+	0x0960: 0x4fca, // 俊
+	0x0961: 0x7ae3, // 竣
+	0x0962: 0x6d5a, // 浚
+	0x0963: 0x90e1, // 郡
+	0x0964: 0x9a8f, // 骏
+	0x0965: 0x5580, // 喀
+	0x0966: 0x5496, // 咖
+	0x0967: 0x5361, // 卡
+	0x0968: 0x54af, // 咯
+	0x0969: 0x5f00, // 开
+	0x096a: 0x63e9, // 揩
+	0x096b: 0x6977, // 楷
+	0x096c: 0x51ef, // 凯
+	0x096d: 0x6168, // 慨
+	0x096e: 0x520a, // 刊
+	0x096f: 0x582a, // 堪
+	0x0970: 0x52d8, // 勘
+	0x0971: 0x574e, // 坎
+	0x0972: 0x780d, // 砍
+	0x0973: 0x770b, // 看
+	0x0974: 0x5eb7, // 康
+	0x0975: 0x6177, // 慷
+	0x0976: 0x7ce0, // 糠
+	0x0977: 0x625b, // 扛
+	0x0978: 0x6297, // 抗
+	0x0979: 0x4ea2, // 亢
+	0x097a: 0x7095, // 炕
+	0x097b: 0x8003, // 考
+	0x097c: 0x62f7, // 拷
+	0x097d: 0x70e4, // 烤
+	0x097e: 0x9760, // 靠
+	0x097f: 0x5777, // 坷
+	0x0980: 0x82db, // 苛
+	0x0981: 0x67ef, // 柯
+	0x0982: 0x68f5, // 棵
+	0x0983: 0x78d5, // 磕
+	0x0984: 0x9897, // 颗
+	0x0985: 0x79d1, // 科
+	0x0986: 0x58f3, // 壳
+	0x0987: 0x54b3, // 咳
+	0x0988: 0x53ef, // 可
+	0x0989: 0x6e34, // 渴
+	0x098a: 0x514b, // 克
+	0x098b: 0x523b, // 刻
+	0x098c: 0x5ba2, // 客
+	0x098d: 0x8bfe, // 课
+	0x098e: 0x80af, // 肯
+	0x098f: 0x5543, // 啃
+	0x0990: 0x57a6, // 垦
+	0x0991: 0x6073, // 恳
+	0x0992: 0x5751, // 坑
+	0x0993: 0x542d, // 吭
+	0x0994: 0x7a7a, // 空
+	0x0995: 0x6050, // 恐
+	0x0996: 0x5b54, // 孔
+	0x0997: 0x63a7, // 控
+	0x0998: 0x62a0, // 抠
+	0x0999: 0x53e3, // 口
+	0x099a: 0x6263, // 扣
+	0x099b: 0x5bc7, // 寇
+	0x099c: 0x67af, // 枯
+	0x099d: 0x54ed, // 哭
+	0x099e: 0x7a9f, // 窟
+	0x099f: 0x82e6, // 苦
+	0x09a0: 0x9177, // 酷
+	0x09a1: 0x5e93, // 库
+	0x09a2: 0x88e4, // 裤
+	0x09a3: 0x5938, // 夸
+	0x09a4: 0x57ae, // 垮
+	0x09a5: 0x630e, // 挎
+	0x09a6: 0x8de8, // 跨
+	0x09a7: 0x80ef, // 胯
+	0x09a8: 0x5757, // 块
+	0x09a9: 0x7b77, // 筷
+	0x09aa: 0x4fa9, // 侩
+	0x09ab: 0x5feb, // 快
+	0x09ac: 0x5bbd, // 宽
+	0x09ad: 0x6b3e, // 款
+	0x09ae: 0x5321, // 匡
+	0x09af: 0x7b50, // 筐
+	0x09b0: 0x72c2, // 狂
+	0x09b1: 0x6846, // 框
+	0x09b2: 0x77ff, // 矿
+	0x09b3: 0x7736, // 眶
+	0x09b4: 0x65f7, // 旷
+	0x09b5: 0x51b5, // 况
+	0x09b6: 0x4e8f, // 亏
+	0x09b7: 0x76d4, // 盔
+	0x09b8: 0x5cbf, // 岿
+	0x09b9: 0x7aa5, // 窥
+	0x09ba: 0x8475, // 葵
+	0x09bb: 0x594e, // 奎
+	0x09bc: 0x9b41, // 魁
+	0x09bd: 0x5080, // 傀
+	0x09c0: 0x9988, // 馈
+	0x09c1: 0x6127, // 愧
+	0x09c2: 0x6e83, // 溃
+	0x09c3: 0x5764, // 坤
+	0x09c4: 0x6606, // 昆
+	0x09c5: 0x6346, // 捆
+	0x09c6: 0x56f0, // 困
+	0x09c7: 0x62ec, // 括
+	0x09c8: 0x6269, // 扩
+	0x09c9: 0x5ed3, // 廓
+	0x09ca: 0x9614, // 阔
+	0x09cb: 0x5783, // 垃
+	0x09cc: 0x62c9, // 拉
+	0x09cd: 0x5587, // 喇
+	0x09ce: 0x8721, // 蜡
+	0x09cf: 0x814a, // 腊
+	0x09d0: 0x8fa3, // 辣
+	0x09d1: 0x5566, // 啦
+	0x09d2: 0x83b1, // 莱
+	0x09d3: 0x6765, // 来
+	0x09d4: 0x8d56, // 赖
+	0x09d5: 0x84dd, // 蓝
+	0x09d6: 0x5a6a, // 婪
+	0x09d7: 0x680f, // 栏
+	0x09d8: 0x62e6, // 拦
+	0x09d9: 0x7bee, // 篮
+	0x09da: 0x9611, // 阑
+	0x09db: 0x5170, // 兰
+	0x09dc: 0x6f9c, // 澜
+	0x09dd: 0x8c30, // 谰
+	0x09de: 0x63fd, // 揽
+	0x09df: 0x89c8, // 览
+	0x09e0: 0x61d2, // 懒
+	0x09e1: 0x7f06, // 缆
+	0x09e2: 0x70c2, // 烂
+	0x09e3: 0x6ee5, // 滥
+	0x09e4: 0x7405, // 琅
+	0x09e5: 0x6994, // 榔
+	0x09e6: 0x72fc, // 狼
+	0x09e7: 0x5eca, // 廊
+	0x09e8: 0x90ce, // 郎
+	0x09e9: 0x6717, // 朗
+	0x09ea: 0x6d6a, // 浪
+	0x09eb: 0x635e, // 捞
+	0x09ec: 0x52b3, // 劳
+	0x09ed: 0x7262, // 牢
+	0x09ee: 0x8001, // 老
+	0x09ef: 0x4f6c, // 佬
+	0x09f0: 0x59e5, // 姥
+	0x09f1: 0x916a, // 酪
+	0x09f2: 0x70d9, // 烙
+	0x09f3: 0x6d9d, // 涝
+	0x09f4: 0x52d2, // 勒
+	0x09f5: 0x4e50, // 乐
+	0x09f6: 0x96f7, // 雷
+	0x09f7: 0x956d, // 镭
+	0x09f8: 0x857e, // 蕾
+	0x09f9: 0x78ca, // 磊
+	0x09fa: 0x7d2f, // 累
+	0x09fb: 0x5121, // 儡
+	0x09fc: 0x5792, // 垒
+	0x09fd: 0x64c2, // 擂
+	0x09fe: 0x808b, // 肋
+	0x09ff: 0x7c7b, // 类
+	0x0a00: 0x6cea, // 泪
+	0x0a01: 0x68f1, // 棱
+	0x0a02: 0x695e, // 楞
+	0x0a03: 0x51b7, // 冷
+	0x0a04: 0x5398, // 厘
+	0x0a05: 0x68a8, // 梨
+	0x0a06: 0x7281, // 犁
+	0x0a07: 0x9ece, // 黎
+	0x0a08: 0x7bf1, // 篱
+	0x0a09: 0x72f8, // 狸
+	0x0a0a: 0x79bb, // 离
+	0x0a0b: 0x6f13, // 漓
+	0x0a0c: 0x7406, // 理
+	0x0a0d: 0x674e, // 李
+	0x0a0e: 0x91cc, // 里
+	0x0a0f: 0x9ca4, // 鲤
+	0x0a10: 0x793c, // 礼
+	0x0a11: 0x8389, // 莉
+	0x0a12: 0x8354, // 荔
+	0x0a13: 0x540f, // 吏
+	0x0a14: 0x6817, // 栗
+	0x0a15: 0x4e3d, // 丽
+	0x0a16: 0x5389, // 厉
+	0x0a17: 0x52b1, // 励
+	0x0a18: 0x783e, // 砾
+	0x0a19: 0x5386, // 历
+	0x0a1a: 0x5229, // 利
+	0x0a1b: 0x5088, // 傈
+	0x0a1c: 0x4f8b, // 例
+	0x0a1d: 0x4fd0, // 俐
+	0x0a20: 0x75e2, // 痢
+	0x0a21: 0x7acb, // 立
+	0x0a22: 0x7c92, // 粒
+	0x0a23: 0x6ca5, // 沥
+	0x0a24: 0x96b6, // 隶
+	0x0a25: 0x529b, // 力
+	0x0a26: 0x7483, // 璃
+	0x0a27: 0x54e9, // 哩
+	0x0a28: 0x4fe9, // 俩
+	0x0a29: 0x8054, // 联
+	0x0a2a: 0x83b2, // 莲
+	0x0a2b: 0x8fde, // 连
+	0x0a2c: 0x9570, // 镰
+	0x0a2d: 0x5ec9, // 廉
+	0x0a2e: 0x601c, // 怜
+	0x0a2f: 0x6d9f, // 涟
+	0x0a30: 0x5e18, // 帘
+	0x0a31: 0x655b, // 敛
+	0x0a32: 0x8138, // 脸
+	0x0a33: 0x94fe, // 链
+	0x0a34: 0x604b, // 恋
+	0x0a35: 0x70bc, // 炼
+	0x0a36: 0x7ec3, // 练
+	0x0a37: 0x7cae, // 粮
+	0x0a38: 0x51c9, // 凉
+	0x0a39: 0x6881, // 梁
+	0x0a3a: 0x7cb1, // 粱
+	0x0a3b: 0x826f, // 良
+	0x0a3c: 0x4e24, // 两
+	0x0a3d: 0x8f86, // 辆
+	0x0a3e: 0x91cf, // 量
+	0x0a3f: 0x667e, // 晾
+	0x0a40: 0x4eae, // 亮
+	0x0a41: 0x8c05, // 谅
+	0x0a42: 0x64a9, // 撩
+	0x0a43: 0x804a, // 聊
+	0x0a44: 0x50da, // 僚
+	0x0a45: 0x7597, // 疗
+	0x0a46: 0x71ce, // 燎
+	0x0a47: 0x5be5, // 寥
+	0x0a48: 0x8fbd, // 辽
+	0x0a49: 0x6f66, // 潦
+	0x0a4a: 0x4e86, // 了
+	0x0a4b: 0x6482, // 撂
+	0x0a4c: 0x9563, // 镣
+	0x0a4d: 0x5ed6, // 廖
+	0x0a4e: 0x6599, // 料
+	0x0a4f: 0x5217, // 列
+	0x0a50: 0x88c2, // 裂
+	0x0a51: 0x70c8, // 烈
+	0x0a52: 0x52a3, // 劣
+	0x0a53: 0x730e, // 猎
+	0x0a54: 0x7433, // 琳
+	0x0a55: 0x6797, // 林
+	0x0a56: 0x78f7, // 磷
+	0x0a57: 0x9716, // 霖
+	0x0a58: 0x4e34, // 临
+	0x0a59: 0x90bb, // 邻
+	0x0a5a: 0x9cde, // 鳞
+	0x0a5b: 0x6dcb, // 淋
+	0x0a5c: 0x51db, // 凛
+	0x0a5d: 0x8d41, // 赁
+	0x0a5e: 0x541d, // 吝
+	0x0a5f: 0x62ce, // 拎
+	0x0a60: 0x73b2, // 玲
+	0x0a61: 0x83f1, // 菱
+	0x0a62: 0x96f6, // 零
+	0x0a63: 0x9f84, // 龄
+	0x0a64: 0x94c3, // 铃
+	0x0a65: 0x4f36, // 伶
+	0x0a66: 0x7f9a, // 羚
+	0x0a67: 0x51cc, // 凌
+	0x0a68: 0x7075, // 灵
+	0x0a69: 0x9675, // 陵
+	0x0a6a: 0x5cad, // 岭
+	0x0a6b: 0x9886, // 领
+	0x0a6c: 0x53e6, // 另
+	0x0a6d: 0x4ee4, // 令
+	0x0a6e: 0x6e9c, // 溜
+	0x0a6f: 0x7409, // 琉
+	0x0a70: 0x69b4, // 榴
+	0x0a71: 0x786b, // 硫
+	0x0a72: 0x998f, // 馏
+	0x0a73: 0x7559, // 留
+	0x0a74: 0x5218, // 刘
+	0x0a75: 0x7624, // 瘤
+	0x0a76: 0x6d41, // 流
+	0x0a77: 0x67f3, // 柳
+	0x0a78: 0x516d, // 六
+	0x0a79: 0x9f99, // 龙
+	0x0a7a: 0x804b, // 聋
+	0x0a7b: 0x5499, // 咙
+	0x0a7c: 0x7b3c, // 笼
+	0x0a7d: 0x7abf, // 窿
+	0x0a80: 0x9686, // 隆
+	0x0a81: 0x5784, // 垄
+	0x0a82: 0x62e2, // 拢
+	0x0a83: 0x9647, // 陇
+	0x0a84: 0x697c, // 楼
+	0x0a85: 0x5a04, // 娄
+	0x0a86: 0x6402, // 搂
+	0x0a87: 0x7bd3, // 篓
+	0x0a88: 0x6f0f, // 漏
+	0x0a89: 0x964b, // 陋
+	0x0a8a: 0x82a6, // 芦
+	0x0a8b: 0x5362, // 卢
+	0x0a8c: 0x9885, // 颅
+	0x0a8d: 0x5e90, // 庐
+	0x0a8e: 0x7089, // 炉
+	0x0a8f: 0x63b3, // 掳
+	0x0a90: 0x5364, // 卤
+	0x0a91: 0x864f, // 虏
+	0x0a92: 0x9c81, // 鲁
+	0x0a93: 0x9e93, // 麓
+	0x0a94: 0x788c, // 碌
+	0x0a95: 0x9732, // 露
+	0x0a96: 0x8def, // 路
+	0x0a97: 0x8d42, // 赂
+	0x0a98: 0x9e7f, // 鹿
+	0x0a99: 0x6f5e, // 潞
+	0x0a9a: 0x7984, // 禄
+	0x0a9b: 0x5f55, // 录
+	0x0a9c: 0x9646, // 陆
+	0x0a9d: 0x622e, // 戮
+	0x0a9e: 0x9a74, // 驴
+	0x0a9f: 0x5415, // 吕
+	0x0aa0: 0x94dd, // 铝
+	0x0aa1: 0x4fa3, // 侣
+	0x0aa2: 0x65c5, // 旅
+	0x0aa3: 0x5c65, // 履
+	0x0aa4: 0x5c61, // 屡
+	0x0aa5: 0x7f15, // 缕
+	0x0aa6: 0x8651, // 虑
+	0x0aa7: 0x6c2f, // 氯
+	0x0aa8: 0x5f8b, // 律
+	0x0aa9: 0x7387, // 率
+	0x0aaa: 0x6ee4, // 滤
+	0x0aab: 0x7eff, // 绿
+	0x0aac: 0x5ce6, // 峦
+	0x0aad: 0x631b, // 挛
+	0x0aae: 0x5b6a, // 孪
+	0x0aaf: 0x6ee6, // 滦
+	0x0ab0: 0x5375, // 卵
+	0x0ab1: 0x4e71, // 乱
+	0x0ab2: 0x63a0, // 掠
+	0x0ab3: 0x7565, // 略
+	0x0ab4: 0x62a1, // 抡
+	0x0ab5: 0x8f6e, // 轮
+	0x0ab6: 0x4f26, // 伦
+	0x0ab7: 0x4ed1, // 仑
+	0x0ab8: 0x6ca6, // 沦
+	0x0ab9: 0x7eb6, // 纶
+	0x0aba: 0x8bba, // 论
+	0x0abb: 0x841d, // 萝
+	0x0abc: 0x87ba, // 螺
+	0x0abd: 0x7f57, // 罗
+	0x0abe: 0x903b, // 逻
+	0x0abf: 0x9523, // 锣
+	0x0ac0: 0x7ba9, // 箩
+	0x0ac1: 0x9aa1, // 骡
+	0x0ac2: 0x88f8, // 裸
+	0x0ac3: 0x843d, // 落
+	0x0ac4: 0x6d1b, // 洛
+	0x0ac5: 0x9a86, // 骆
+	0x0ac6: 0x7edc, // 络
+	0x0ac7: 0x5988, // 妈
+	0x0ac8: 0x9ebb, // 麻
+	0x0ac9: 0x739b, // 玛
+	0x0aca: 0x7801, // 码
+	0x0acb: 0x8682, // 蚂
+	0x0acc: 0x9a6c, // 马
+	0x0acd: 0x9a82, // 骂
+	0x0ace: 0x561b, // 嘛
+	0x0acf: 0x5417, // 吗
+	0x0ad0: 0x57cb, // 埋
+	0x0ad1: 0x4e70, // 买
+	0x0ad2: 0x9ea6, // 麦
+	0x0ad3: 0x5356, // 卖
+	0x0ad4: 0x8fc8, // 迈
+	0x0ad5: 0x8109, // 脉
+	0x0ad6: 0x7792, // 瞒
+	0x0ad7: 0x9992, // 馒
+	0x0ad8: 0x86ee, // 蛮
+	0x0ad9: 0x6ee1, // 满
+	0x0ada: 0x8513, // 蔓
+	0x0adb: 0x66fc, // 曼
+	0x0adc: 0x6162, // 慢
+	0x0add: 0x6f2b, // 漫
+	0x0ae0: 0x8c29, // 谩
+	0x0ae1: 0x8292, // 芒
+	0x0ae2: 0x832b, // 茫
+	0x0ae3: 0x76f2, // 盲
+	0x0ae4: 0x6c13, // 氓
+	0x0ae5: 0x5fd9, // 忙
+	0x0ae6: 0x83bd, // 莽
+	0x0ae7: 0x732b, // 猫
+	0x0ae8: 0x8305, // 茅
+	0x0ae9: 0x951a, // 锚
+	0x0aea: 0x6bdb, // 毛
+	0x0aeb: 0x77db, // 矛
+	0x0aec: 0x94c6, // 铆
+	0x0aed: 0x536f, // 卯
+	0x0aee: 0x8302, // 茂
+	0x0aef: 0x5192, // 冒
+	0x0af0: 0x5e3d, // 帽
+	0x0af1: 0x8c8c, // 貌
+	0x0af2: 0x8d38, // 贸
+	0x0af3: 0x4e48, // 么
+	0x0af4: 0x73ab, // 玫
+	0x0af5: 0x679a, // 枚
+	0x0af6: 0x6885, // 梅
+	0x0af7: 0x9176, // 酶
+	0x0af8: 0x9709, // 霉
+	0x0af9: 0x7164, // 煤
+	0x0afa: 0x6ca1, // 没
+	0x0afb: 0x7709, // 眉
+	0x0afc: 0x5a92, // 媒
+	0x0afd: 0x9541, // 镁
+	0x0afe: 0x6bcf, // 每
+	0x0aff: 0x7f8e, // 美
+	0x0b00: 0x6627, // 昧
+	0x0b01: 0x5bd0, // 寐
+	0x0b02: 0x59b9, // 妹
+	0x0b03: 0x5a9a, // 媚
+	0x0b04: 0x95e8, // 门
+	0x0b05: 0x95f7, // 闷
+	0x0b06: 0x4eec, // 们
+	0x0b07: 0x840c, // 萌
+	0x0b08: 0x8499, // 蒙
+	0x0b09: 0x6aac, // 檬
+	0x0b0a: 0x76df, // 盟
+	0x0b0b: 0x9530, // 锰
+	0x0b0c: 0x731b, // 猛
+	0x0b0d: 0x68a6, // 梦
+	0x0b0e: 0x5b5f, // 孟
+	0x0b0f: 0x772f, // 眯
+	0x0b10: 0x919a, // 醚
+	0x0b11: 0x9761, // 靡
+	0x0b12: 0x7cdc, // 糜
+	0x0b13: 0x8ff7, // 迷
+	0x0b14: 0x8c1c, // 谜
+	0x0b15: 0x5f25, // 弥
+	0x0b16: 0x7c73, // 米
+	0x0b17: 0x79d8, // 秘
+	0x0b18: 0x89c5, // 觅
+	0x0b19: 0x6ccc, // 泌
+	0x0b1a: 0x871c, // 蜜
+	0x0b1b: 0x5bc6, // 密
+	0x0b1c: 0x5e42, // 幂
+	0x0b1d: 0x68c9, // 棉
+	0x0b1e: 0x7720, // 眠
+	0x0b1f: 0x7ef5, // 绵
+	0x0b20: 0x5195, // 冕
+	0x0b21: 0x514d, // 免
+	0x0b22: 0x52c9, // 勉
+	0x0b23: 0x5a29, // 娩
+	0x0b24: 0x7f05, // 缅
+	0x0b25: 0x9762, // 面
+	0x0b26: 0x82d7, // 苗
+	0x0b27: 0x63cf, // 描
+	0x0b28: 0x7784, // 瞄
+	0x0b29: 0x85d0, // 藐
+	0x0b2a: 0x79d2, // 秒
+	0x0b2b: 0x6e3a, // 渺
+	0x0b2c: 0x5e99, // 庙
+	0x0b2d: 0x5999, // 妙
+	0x0b2e: 0x8511, // 蔑
+	0x0b2f: 0x706d, // 灭
+	0x0b30: 0x6c11, // 民
+	0x0b31: 0x62bf, // 抿
+	0x0b32: 0x76bf, // 皿
+	0x0b33: 0x654f, // 敏
+	0x0b34: 0x60af, // 悯
+	0x0b35: 0x95fd, // 闽
+	0x0b36: 0x660e, // 明
+	0x0b37: 0x879f, // 螟
+	0x0b38: 0x9e23, // 鸣
+	0x0b39: 0x94ed, // 铭
+	0x0b3a: 0x540d, // 名
+	0x0b3b: 0x547d, // 命
+	0x0b3c: 0x8c2c, // 谬
+	0x0b3d: 0x6478, // 摸
+	0x0b40: 0x6479, // 摹
+	0x0b41: 0x8611, // 蘑
+	0x0b42: 0x6a21, // 模
+	0x0b43: 0x819c, // 膜
+	0x0b44: 0x78e8, // 磨
+	0x0b45: 0x6469, // 摩
+	0x0b46: 0x9b54, // 魔
+	0x0b47: 0x62b9, // 抹
+	0x0b48: 0x672b, // 末
+	0x0b49: 0x83ab, // 莫
+	0x0b4a: 0x58a8, // 墨
+	0x0b4b: 0x9ed8, // 默
+	0x0b4c: 0x6cab, // 沫
+	0x0b4d: 0x6f20, // 漠
+	0x0b4e: 0x5bde, // 寞
+	0x0b4f: 0x964c, // 陌
+	0x0b50: 0x8c0b, // 谋
+	0x0b51: 0x725f, // 牟
+	0x0b52: 0x67d0, // 某
+	0x0b53: 0x62c7, // 拇
+	0x0b54: 0x7261, // 牡
+	0x0b55: 0x4ea9, // 亩
+	0x0b56: 0x59c6, // 姆
+	0x0b57: 0x6bcd, // 母
+	0x0b58: 0x5893, // 墓
+	0x0b59: 0x66ae, // 暮
+	0x0b5a: 0x5e55, // 幕
+	0x0b5b: 0x52df, // 募
+	0x0b5c: 0x6155, // 慕
+	0x0b5d: 0x6728, // 木
+	0x0b5e: 0x76ee, // 目
+	0x0b5f: 0x7766, // 睦
+	0x0b60: 0x7267, // 牧
+	0x0b61: 0x7a46, // 穆
+	0x0b62: 0x62ff, // 拿
+	0x0b63: 0x54ea, // 哪
+	0x0b64: 0x5450, // 呐
+	0x0b65: 0x94a0, // 钠
+	0x0b66: 0x90a3, // 那
+	0x0b67: 0x5a1c, // 娜
+	0x0b68: 0x7eb3, // 纳
+	0x0b69: 0x6c16, // 氖
+	0x0b6a: 0x4e43, // 乃
+	0x0b6b: 0x5976, // 奶
+	0x0b6c: 0x8010, // 耐
+	0x0b6d: 0x5948, // 奈
+	0x0b6e: 0x5357, // 南
+	0x0b6f: 0x7537, // 男
+	0x0b70: 0x96be, // 难
+	0x0b71: 0x56ca, // 囊
+	0x0b72: 0x6320, // 挠
+	0x0b73: 0x8111, // 脑
+	0x0b74: 0x607c, // 恼
+	0x0b75: 0x95f9, // 闹
+	0x0b76: 0x6dd6, // 淖
+	0x0b77: 0x5462, // 呢
+	0x0b78: 0x9981, // 馁
+	0x0b79: 0x5185, // 内
+	0x0b7a: 0x5ae9, // 嫩
+	0x0b7b: 0x80fd, // 能
+	0x0b7c: 0x59ae, // 妮
+	0x0b7d: 0x9713, // 霓
+	0x0b7e: 0x502a, // 倪
+	0x0b7f: 0x6ce5, // 泥
+	0x0b80: 0x5c3c, // 尼
+	0x0b81: 0x62df, // 拟
+	0x0b82: 0x4f60, // 你
+	0x0b83: 0x533f, // 匿
+	0x0b84: 0x817b, // 腻
+	0x0b85: 0x9006, // 逆
+	0x0b86: 0x6eba, // 溺
+	0x0b87: 0x852b, // 蔫
+	0x0b88: 0x62c8, // 拈
+	0x0b89: 0x5e74, // 年
+	0x0b8a: 0x78be, // 碾
+	0x0b8b: 0x64b5, // 撵
+	0x0b8c: 0x637b, // 捻
+	0x0b8d: 0x5ff5, // 念
+	0x0b8e: 0x5a18, // 娘
+	0x0b8f: 0x917f, // 酿
+	0x0b90: 0x9e1f, // 鸟
+	0x0b91: 0x5c3f, // 尿
+	0x0b92: 0x634f, // 捏
+	0x0b93: 0x8042, // 聂
+	0x0b94: 0x5b7d, // 孽
+	0x0b95: 0x556e, // 啮
+	0x0b96: 0x954a, // 镊
+	0x0b97: 0x954d, // 镍
+	0x0b98: 0x6d85, // 涅
+	0x0b99: 0x60a8, // 您
+	0x0b9a: 0x67e0, // 柠
+	0x0b9b: 0x72de, // 狞
+	0x0b9c: 0x51dd, // 凝
+	0x0b9d: 0x5b81, // 宁
+	0x0ba0: 0x62e7, // 拧
+	0x0ba1: 0x6cde, // 泞
+	0x0ba2: 0x725b, // 牛
+	0x0ba3: 0x626d, // 扭
+	0x0ba4: 0x94ae, // 钮
+	0x0ba5: 0x7ebd, // 纽
+	0x0ba6: 0x8113, // 脓
+	0x0ba7: 0x6d53, // 浓
+	0x0ba8: 0x519c, // 农
+	0x0ba9: 0x5f04, // 弄
+	0x0baa: 0x5974, // 奴
+	0x0bab: 0x52aa, // 努
+	0x0bac: 0x6012, // 怒
+	0x0bad: 0x5973, // 女
+	0x0bae: 0x6696, // 暖
+	0x0baf: 0x8650, // 虐
+	0x0bb0: 0x759f, // 疟
+	0x0bb1: 0x632a, // 挪
+	0x0bb2: 0x61e6, // 懦
+	0x0bb3: 0x7cef, // 糯
+	0x0bb4: 0x8bfa, // 诺
+	0x0bb5: 0x54e6, // 哦
+	0x0bb6: 0x6b27, // 欧
+	0x0bb7: 0x9e25, // 鸥
+	0x0bb8: 0x6bb4, // 殴
+	0x0bb9: 0x85d5, // 藕
+	0x0bba: 0x5455, // 呕
+	0x0bbb: 0x5076, // 偶
+	0x0bbc: 0x6ca4, // 沤
+	0x0bbd: 0x556a, // 啪
+	0x0bbe: 0x8db4, // 趴
+	0x0bbf: 0x722c, // 爬
+	0x0bc0: 0x5e15, // 帕
+	0x0bc1: 0x6015, // 怕
+	0x0bc2: 0x7436, // 琶
+	0x0bc3: 0x62cd, // 拍
+	0x0bc4: 0x6392, // 排
+	0x0bc5: 0x724c, // 牌
+	0x0bc6: 0x5f98, // 徘
+	0x0bc7: 0x6e43, // 湃
+	0x0bc8: 0x6d3e, // 派
+	0x0bc9: 0x6500, // 攀
+	0x0bca: 0x6f58, // 潘
+	0x0bcb: 0x76d8, // 盘
+	0x0bcc: 0x78d0, // 磐
+	0x0bcd: 0x76fc, // 盼
+	0x0bce: 0x7554, // 畔
+	0x0bcf: 0x5224, // 判
+	0x0bd0: 0x53db, // 叛
+	0x0bd1: 0x4e53, // 乓
+	0x0bd2: 0x5e9e, // 庞
+	0x0bd3: 0x65c1, // 旁
+	0x0bd4: 0x802a, // 耪
+	0x0bd5: 0x80d6, // 胖
+	0x0bd6: 0x629b, // 抛
+	0x0bd7: 0x5486, // 咆
+	0x0bd8: 0x5228, // 刨
+	0x0bd9: 0x70ae, // 炮
+	0x0bda: 0x888d, // 袍
+	0x0bdb: 0x8dd1, // 跑
+	0x0bdc: 0x6ce1, // 泡
+	0x0bdd: 0x5478, // 呸
+	0x0bde: 0x80da, // 胚
+	0x0bdf: 0x57f9, // 培
+	0x0be0: 0x88f4, // 裴
+	0x0be1: 0x8d54, // 赔
+	0x0be2: 0x966a, // 陪
+	0x0be3: 0x914d, // 配
+	0x0be4: 0x4f69, // 佩
+	0x0be5: 0x6c9b, // 沛
+	0x0be6: 0x55b7, // 喷
+	0x0be7: 0x76c6, // 盆
+	0x0be8: 0x7830, // 砰
+	0x0be9: 0x62a8, // 抨
+	0x0bea: 0x70f9, // 烹
+	0x0beb: 0x6f8e, // 澎
+	0x0bec: 0x5f6d, // 彭
+	0x0bed: 0x84ec, // 蓬
+	0x0bee: 0x68da, // 棚
+	0x0bef: 0x787c, // 硼
+	0x0bf0: 0x7bf7, // 篷
+	0x0bf1: 0x81a8, // 膨
+	0x0bf2: 0x670b, // 朋
+	0x0bf3: 0x9e4f, // 鹏
+	0x0bf4: 0x6367, // 捧
+	0x0bf5: 0x78b0, // 碰
+	0x0bf6: 0x576f, // 坯
+	0x0bf7: 0x7812, // 砒
+	0x0bf8: 0x9739, // 霹
+	0x0bf9: 0x6279, // 批
+	0x0bfa: 0x62ab, // 披
+	0x0bfb: 0x5288, // 劈
+	0x0bfc: 0x7435, // 琵
+	0x0bfd: 0x6bd7, // 毗
+	0x0c00: 0x5564, // 啤
+	0x0c01: 0x813e, // 脾
+	0x0c02: 0x75b2, // 疲
+	0x0c03: 0x76ae, // 皮
+	0x0c04: 0x5339, // 匹
+	0x0c05: 0x75de, // 痞
+	0x0c06: 0x50fb, // 僻
+	0x0c07: 0x5c41, // 屁
+	0x0c08: 0x8b6c, // 譬
+	0x0c09: 0x7bc7, // 篇
+	0x0c0a: 0x504f, // 偏
+	0x0c0b: 0x7247, // 片
+	0x0c0c: 0x9a97, // 骗
+	0x0c0d: 0x98d8, // 飘
+	0x0c0e: 0x6f02, // 漂
+	0x0c0f: 0x74e2, // 瓢
+	0x0c10: 0x7968, // 票
+	0x0c11: 0x6487, // 撇
+	0x0c12: 0x77a5, // 瞥
+	0x0c13: 0x62fc, // 拼
+	0x0c14: 0x9891, // 频
+	0x0c15: 0x8d2b, // 贫
+	0x0c16: 0x54c1, // 品
+	0x0c17: 0x8058, // 聘
+	0x0c18: 0x4e52, // 乒
+	0x0c19: 0x576a, // 坪
+	0x0c1a: 0x82f9, // 苹
+	0x0c1b: 0x840d, // 萍
+	0x0c1c: 0x5e73, // 平
+	0x0c1d: 0x51ed, // 凭
+	0x0c1e: 0x74f6, // 瓶
+	0x0c1f: 0x8bc4, // 评
+	0x0c20: 0x5c4f, // 屏
+	0x0c21: 0x5761, // 坡
+	0x0c22: 0x6cfc, // 泼
+	0x0c23: 0x9887, // 颇
+	0x0c24: 0x5a46, // 婆
+	0x0c25: 0x7834, // 破
+	0x0c26: 0x9b44, // 魄
+	0x0c27: 0x8feb, // 迫
+	0x0c28: 0x7c95, // 粕
+	0x0c29: 0x5256, // 剖
+	0x0c2a: 0x6251, // 扑
+	0x0c2b: 0x94fa, // 铺
+	0x0c2c: 0x4ec6, // 仆
+	0x0c2d: 0x8386, // 莆
+	0x0c2e: 0x8461, // 葡
+	0x0c2f: 0x83e9, // 菩
+	0x0c30: 0x84b2, // 蒲
+	0x0c31: 0x57d4, // 埔
+	0x0c32: 0x6734, // 朴
+	0x0c33: 0x5703, // 圃
+	0x0c34: 0x666e, // 普
+	0x0c35: 0x6d66, // 浦
+	0x0c36: 0x8c31, // 谱
+	0x0c37: 0x66dd, // 曝
+	0x0c38: 0x7011, // 瀑
+	0x0c39: 0x671f, // 期
+	0x0c3a: 0x6b3a, // 欺
+	0x0c3b: 0x6816, // 栖
+	0x0c3c: 0x621a, // 戚
+	0x0c3d: 0x59bb, // 妻
+	0x0c3e: 0x4e03, // 七
+	0x0c3f: 0x51c4, // 凄
+	0x0c40: 0x6f06, // 漆
+	0x0c41: 0x67d2, // 柒
+	0x0c42: 0x6c8f, // 沏
+	0x0c43: 0x5176, // 其
+	0x0c44: 0x68cb, // 棋
+	0x0c45: 0x5947, // 奇
+	0x0c46: 0x6b67, // 歧
+	0x0c47: 0x7566, // 畦
+	0x0c48: 0x5d0e, // 崎
+	0x0c49: 0x8110, // 脐
+	0x0c4a: 0x9f50, // 齐
+	0x0c4b: 0x65d7, // 旗
+	0x0c4c: 0x7948, // 祈
+	0x0c4d: 0x7941, // 祁
+	0x0c4e: 0x9a91, // 骑
+	0x0c4f: 0x8d77, // 起
+	0x0c50: 0x5c82, // 岂
+	0x0c51: 0x4e5e, // 乞
+	0x0c52: 0x4f01, // 企
+	0x0c53: 0x542f, // 启
+	0x0c54: 0x5951, // 契
+	0x0c55: 0x780c, // 砌
+	0x0c56: 0x5668, // 器
+	0x0c57: 0x6c14, // 气
+	0x0c58: 0x8fc4, // 迄
+	0x0c59: 0x5f03, // 弃
+	0x0c5a: 0x6c7d, // 汽
+	0x0c5b: 0x6ce3, // 泣
+	0x0c5c: 0x8bab, // 讫
+	0x0c5d: 0x6390, // 掐
+	0x0c60: 0x6070, // 恰
+	0x0c61: 0x6d3d, // 洽
+	0x0c62: 0x7275, // 牵
+	0x0c63: 0x6266, // 扦
+	0x0c64: 0x948e, // 钎
+	0x0c65: 0x94c5, // 铅
+	0x0c66: 0x5343, // 千
+	0x0c67: 0x8fc1, // 迁
+	0x0c68: 0x7b7e, // 签
+	0x0c69: 0x4edf, // 仟
+	0x0c6a: 0x8c26, // 谦
+	0x0c6b: 0x4e7e, // 乾
+	0x0c6c: 0x9ed4, // 黔
+	0x0c6d: 0x94b1, // 钱
+	0x0c6e: 0x94b3, // 钳
+	0x0c6f: 0x524d, // 前
+	0x0c70: 0x6f5c, // 潜
+	0x0c71: 0x9063, // 遣
+	0x0c72: 0x6d45, // 浅
+	0x0c73: 0x8c34, // 谴
+	0x0c74: 0x5811, // 堑
+	0x0c75: 0x5d4c, // 嵌
+	0x0c76: 0x6b20, // 欠
+	0x0c77: 0x6b49, // 歉
+	0x0c78: 0x67aa, // 枪
+	0x0c79: 0x545b, // 呛
+	0x0c7a: 0x8154, // 腔
+	0x0c7b: 0x7f8c, // 羌
+	0x0c7c: 0x5899, // 墙
+	0x0c7d: 0x8537, // 蔷
+	0x0c7e: 0x5f3a, // 强
+	0x0c7f: 0x62a2, // 抢
+	0x0c80: 0x6a47, // 橇
+	0x0c81: 0x9539, // 锹
+	0x0c82: 0x6572, // 敲
+	0x0c83: 0x6084, // 悄
+	0x0c84: 0x6865, // 桥
+	0x0c85: 0x77a7, // 瞧
+	0x0c86: 0x4e54, // 乔
+	0x0c87: 0x4fa8, // 侨
+	0x0c88: 0x5de7, // 巧
+	0x0c89: 0x9798, // 鞘
+	0x0c8a: 0x64ac, // 撬
+	0x0c8b: 0x7fd8, // 翘
+	0x0c8c: 0x5ced, // 峭
+	0x0c8d: 0x4fcf, // 俏
+	0x0c8e: 0x7a8d, // 窍
+	0x0c8f: 0x5207, // 切
+	0x0c90: 0x8304, // 茄
+	0x0c91: 0x4e14, // 且
+	0x0c92: 0x602f, // 怯
+	0x0c93: 0x7a83, // 窃
+	0x0c94: 0x94a6, // 钦
+	0x0c95: 0x4fb5, // 侵
+	0x0c96: 0x4eb2, // 亲
+	0x0c97: 0x79e6, // 秦
+	0x0c98: 0x7434, // 琴
+	0x0c99: 0x52e4, // 勤
+	0x0c9a: 0x82b9, // 芹
+	0x0c9b: 0x64d2, // 擒
+	0x0c9c: 0x79bd, // 禽
+	0x0c9d: 0x5bdd, // 寝
+	0x0c9e: 0x6c81, // 沁
+	0x0c9f: 0x9752, // 青
+	0x0ca0: 0x8f7b, // 轻
+	0x0ca1: 0x6c22, // 氢
+	0x0ca2: 0x503e, // 倾
+	0x0ca3: 0x537f, // 卿
+	0x0ca4: 0x6e05, // 清
+	0x0ca5: 0x64ce, // 擎
+	0x0ca6: 0x6674, // 晴
+	0x0ca7: 0x6c30, // 氰
+	0x0ca8: 0x60c5, // 情
+	0x0ca9: 0x9877, // 顷
+	0x0caa: 0x8bf7, // 请
+	0x0cab: 0x5e86, // 庆
+	0x0cac: 0x743c, // 琼
+	0x0cad: 0x7a77, // 穷
+	0x0cae: 0x79cb, // 秋
+	0x0caf: 0x4e18, // 丘
+	0x0cb0: 0x90b1, // 邱
+	0x0cb1: 0x7403, // 球
+	0x0cb2: 0x6c42, // 求
+	0x0cb3: 0x56da, // 囚
+	0x0cb4: 0x914b, // 酋
+	0x0cb5: 0x6cc5, // 泅
+	0x0cb6: 0x8d8b, // 趋
+	0x0cb7: 0x533a, // 区
+	0x0cb8: 0x86c6, // 蛆
+	0x0cb9: 0x66f2, // 曲
+	0x0cba: 0x8eaf, // 躯
+	0x0cbb: 0x5c48, // 屈
+	0x0cbc: 0x9a71, // 驱
+	0x0cbd: 0x6e20, // 渠
+	0x0cc0: 0x53d6, // 取
+	0x0cc1: 0x5a36, // 娶
+	0x0cc2: 0x9f8b, // 龋
+	0x0cc3: 0x8da3, // 趣
+	0x0cc4: 0x53bb, // 去
+	0x0cc5: 0x5708, // 圈
+	0x0cc6: 0x98a7, // 颧
+	0x0cc7: 0x6743, // 权
+	0x0cc8: 0x919b, // 醛
+	0x0cc9: 0x6cc9, // 泉
+	0x0cca: 0x5168, // 全
+	0x0ccb: 0x75ca, // 痊
+	0x0ccc: 0x62f3, // 拳
+	0x0ccd: 0x72ac, // 犬
+	0x0cce: 0x5238, // 券
+	0x0ccf: 0x529d, // 劝
+	0x0cd0: 0x7f3a, // 缺
+	0x0cd1: 0x7094, // 炔
+	0x0cd2: 0x7638, // 瘸
+	0x0cd3: 0x5374, // 却
+	0x0cd4: 0x9e4a, // 鹊
+	0x0cd5: 0x69b7, // 榷
+	0x0cd6: 0x786e, // 确
+	0x0cd7: 0x96c0, // 雀
+	0x0cd8: 0x88d9, // 裙
+	0x0cd9: 0x7fa4, // 群
+	0x0cda: 0x7136, // 然
+	0x0cdb: 0x71c3, // 燃
+	0x0cdc: 0x5189, // 冉
+	0x0cdd: 0x67d3, // 染
+	0x0cde: 0x74e4, // 瓤
+	0x0cdf: 0x58e4, // 壤
+	0x0ce0: 0x6518, // 攘
+	0x0ce1: 0x56b7, // 嚷
+	0x0ce2: 0x8ba9, // 让
+	0x0ce3: 0x9976, // 饶
+	0x0ce4: 0x6270, // 扰
+	0x0ce5: 0x7ed5, // 绕
+	0x0ce6: 0x60f9, // 惹
+	0x0ce7: 0x70ed, // 热
+	0x0ce8: 0x58ec, // 壬
+	0x0ce9: 0x4ec1, // 仁
+	0x0cea: 0x4eba, // 人
+	0x0ceb: 0x5fcd, // 忍
+	0x0cec: 0x97e7, // 韧
+	0x0ced: 0x4efb, // 任
+	0x0cee: 0x8ba4, // 认
+	0x0cef: 0x5203, // 刃
+	0x0cf0: 0x598a, // 妊
+	0x0cf1: 0x7eab, // 纫
+	0x0cf2: 0x6254, // 扔
+	0x0cf3: 0x4ecd, // 仍
+	0x0cf4: 0x65e5, // 日
+	0x0cf5: 0x620e, // 戎
+	0x0cf6: 0x8338, // 茸
+	0x0cf7: 0x84c9, // 蓉
+	0x0cf8: 0x8363, // 荣
+	0x0cf9: 0x878d, // 融
+	0x0cfa: 0x7194, // 熔
+	0x0cfb: 0x6eb6, // 溶
+	0x0cfc: 0x5bb9, // 容
+	0x0cfd: 0x7ed2, // 绒
+	0x0cfe: 0x5197, // 冗
+	0x0cff: 0x63c9, // 揉
+	0x0d00: 0x67d4, // 柔
+	0x0d01: 0x8089, // 肉
+	0x0d02: 0x8339, // 茹
+	0x0d03: 0x8815, // 蠕
+	0x0d04: 0x5112, // 儒
+	0x0d05: 0x5b7a, // 孺
+	0x0d06: 0x5982, // 如
+	0x0d07: 0x8fb1, // 辱
+	0x0d08: 0x4e73, // 乳
+	0x0d09: 0x6c5d, // 汝
+	0x0d0a: 0x5165, // 入
+	0x0d0b: 0x8925, // 褥
+	0x0d0c: 0x8f6f, // 软
+	0x0d0d: 0x962e, // 阮
+	0x0d0e: 0x854a, // 蕊
+	0x0d0f: 0x745e, // 瑞
+	0x0d10: 0x9510, // 锐
+	0x0d11: 0x95f0, // 闰
+	0x0d12: 0x6da6, // 润
+	0x0d13: 0x82e5, // 若
+	0x0d14: 0x5f31, // 弱
+	0x0d15: 0x6492, // 撒
+	0x0d16: 0x6d12, // 洒
+	0x0d17: 0x8428, // 萨
+	0x0d18: 0x816e, // 腮
+	0x0d19: 0x9cc3, // 鳃
+	0x0d1a: 0x585e, // 塞
+	0x0d1b: 0x8d5b, // 赛
+	0x0d1c: 0x4e09, // 三
+	0x0d1d: 0x53c1, // 叁
+	0x0d20: 0x4f1e, // 伞
+	0x0d21: 0x6563, // 散
+	0x0d22: 0x6851, // 桑
+	0x0d23: 0x55d3, // 嗓
+	0x0d24: 0x4e27, // 丧
+	0x0d25: 0x6414, // 搔
+	0x0d26: 0x9a9a, // 骚
+	0x0d27: 0x626b, // 扫
+	0x0d28: 0x5ac2, // 嫂
+	0x0d29: 0x745f, // 瑟
+	0x0d2a: 0x8272, // 色
+	0x0d2b: 0x6da9, // 涩
+	0x0d2c: 0x68ee, // 森
+	0x0d2d: 0x50e7, // 僧
+	0x0d2e: 0x838e, // 莎
+	0x0d2f: 0x7802, // 砂
+	0x0d30: 0x6740, // 杀
+	0x0d31: 0x5239, // 刹
+	0x0d32: 0x6c99, // 沙
+	0x0d33: 0x7eb1, // 纱
+	0x0d34: 0x50bb, // 傻
+	0x0d35: 0x5565, // 啥
+	0x0d36: 0x715e, // 煞
+	0x0d37: 0x7b5b, // 筛
+	0x0d38: 0x6652, // 晒
+	0x0d39: 0x73ca, // 珊
+	0x0d3a: 0x82eb, // 苫
+	0x0d3b: 0x6749, // 杉
+	0x0d3c: 0x5c71, // 山
+	0x0d3d: 0x5220, // 删
+	0x0d3e: 0x717d, // 煽
+	0x0d3f: 0x886b, // 衫
+	0x0d40: 0x95ea, // 闪
+	0x0d41: 0x9655, // 陕
+	0x0d42: 0x64c5, // 擅
+	0x0d43: 0x8d61, // 赡
+	0x0d44: 0x81b3, // 膳
+	0x0d45: 0x5584, // 善
+	0x0d46: 0x6c55, // 汕
+	0x0d47: 0x6247, // 扇
+	0x0d48: 0x7f2e, // 缮
+	0x0d49: 0x5892, // 墒
+	0x0d4a: 0x4f24, // 伤
+	0x0d4b: 0x5546, // 商
+	0x0d4c: 0x8d4f, // 赏
+	0x0d4d: 0x664c, // 晌
+	0x0d4e: 0x4e0a, // 上
+	0x0d4f: 0x5c1a, // 尚
+	0x0d50: 0x88f3, // 裳
+	0x0d51: 0x68a2, // 梢
+	0x0d52: 0x634e, // 捎
+	0x0d53: 0x7a0d, // 稍
+	0x0d54: 0x70e7, // 烧
+	0x0d55: 0x828d, // 芍
+	0x0d56: 0x52fa, // 勺
+	0x0d57: 0x97f6, // 韶
+	0x0d58: 0x5c11, // 少
+	0x0d59: 0x54e8, // 哨
+	0x0d5a: 0x90b5, // 邵
+	0x0d5b: 0x7ecd, // 绍
+	0x0d5c: 0x5962, // 奢
+	0x0d5d: 0x8d4a, // 赊
+	0x0d5e: 0x86c7, // 蛇
+	0x0d5f: 0x820c, // 舌
+	0x0d60: 0x820d, // 舍
+	0x0d61: 0x8d66, // 赦
+	0x0d62: 0x6444, // 摄
+	0x0d63: 0x5c04, // 射
+	0x0d64: 0x6151, // 慑
+	0x0d65: 0x6d89, // 涉
+	0x0d66: 0x793e, // 社
+	0x0d67: 0x8bbe, // 设
+	0x0d68: 0x7837, // 砷
+	0x0d69: 0x7533, // 申
+	0x0d6a: 0x547b, // 呻
+	0x0d6b: 0x4f38, // 伸
+	0x0d6c: 0x8eab, // 身
+	0x0d6d: 0x6df1, // 深
+	0x0d6e: 0x5a20, // 娠
+	0x0d6f: 0x7ec5, // 绅
+	0x0d70: 0x795e, // 神
+	0x0d71: 0x6c88, // 沈
+	0x0d72: 0x5ba1, // 审
+	0x0d73: 0x5a76, // 婶
+	0x0d74: 0x751a, // 甚
+	0x0d75: 0x80be, // 肾
+	0x0d76: 0x614e, // 慎
+	0x0d77: 0x6e17, // 渗
+	0x0d78: 0x58f0, // 声
+	0x0d79: 0x751f, // 生
+	0x0d7a: 0x7525, // 甥
+	0x0d7b: 0x7272, // 牲
+	0x0d7c: 0x5347, // 升
+	0x0d7d: 0x7ef3, // 绳
+	0x0d80: 0x7701, // 省
+	0x0d81: 0x76db, // 盛
+	0x0d82: 0x5269, // 剩
+	0x0d83: 0x80dc, // 胜
+	0x0d84: 0x5723, // 圣
+	0x0d85: 0x5e08, // 师
+	0x0d86: 0x5931, // 失
+	0x0d87: 0x72ee, // 狮
+	0x0d88: 0x65bd, // 施
+	0x0d89: 0x6e7f, // 湿
+	0x0d8a: 0x8bd7, // 诗
+	0x0d8b: 0x5c38, // 尸
+	0x0d8c: 0x8671, // 虱
+	0x0d8d: 0x5341, // 十
+	0x0d8e: 0x77f3, // 石
+	0x0d8f: 0x62fe, // 拾
+	0x0d90: 0x65f6, // 时
+	0x0d91: 0x4ec0, // 什
+	0x0d92: 0x98df, // 食
+	0x0d93: 0x8680, // 蚀
+	0x0d94: 0x5b9e, // 实
+	0x0d95: 0x8bc6, // 识
+	0x0d96: 0x53f2, // 史
+	0x0d97: 0x77e2, // 矢
+	0x0d98: 0x4f7f, // 使
+	0x0d99: 0x5c4e, // 屎
+	0x0d9a: 0x9a76, // 驶
+	0x0d9b: 0x59cb, // 始
+	0x0d9c: 0x5f0f, // 式
+	0x0d9d: 0x793a, // 示
+	0x0d9e: 0x58eb, // 士
+	0x0d9f: 0x4e16, // 世
+	0x0da0: 0x67ff, // 柿
+	0x0da1: 0x4e8b, // 事
+	0x0da2: 0x62ed, // 拭
+	0x0da3: 0x8a93, // 誓
+	0x0da4: 0x901d, // 逝
+	0x0da5: 0x52bf, // 势
+	0x0da6: 0x662f, // 是
+	0x0da7: 0x55dc, // 嗜
+	0x0da8: 0x566c, // 噬
+	0x0da9: 0x9002, // 适
+	0x0daa: 0x4ed5, // 仕
+	0x0dab: 0x4f8d, // 侍
+	0x0dac: 0x91ca, // 释
+	0x0dad: 0x9970, // 饰
+	0x0dae: 0x6c0f, // 氏
+	0x0daf: 0x5e02, // 市
+	0x0db0: 0x6043, // 恃
+	0x0db1: 0x5ba4, // 室
+	0x0db2: 0x89c6, // 视
+	0x0db3: 0x8bd5, // 试
+	0x0db4: 0x6536, // 收
+	0x0db5: 0x624b, // 手
+	0x0db6: 0x9996, // 首
+	0x0db7: 0x5b88, // 守
+	0x0db8: 0x5bff, // 寿
+	0x0db9: 0x6388, // 授
+	0x0dba: 0x552e, // 售
+	0x0dbb: 0x53d7, // 受
+	0x0dbc: 0x7626, // 瘦
+	0x0dbd: 0x517d, // 兽
+	0x0dbe: 0x852c, // 蔬
+	0x0dbf: 0x67a2, // 枢
+	0x0dc0: 0x68b3, // 梳
+	0x0dc1: 0x6b8a, // 殊
+	0x0dc2: 0x6292, // 抒
+	0x0dc3: 0x8f93, // 输
+	0x0dc4: 0x53d4, // 叔
+	0x0dc5: 0x8212, // 舒
+	0x0dc6: 0x6dd1, // 淑
+	0x0dc7: 0x758f, // 疏
+	0x0dc8: 0x4e66, // 书
+	0x0dc9: 0x8d4e, // 赎
+	0x0dca: 0x5b70, // 孰
+	0x0dcb: 0x719f, // 熟
+	0x0dcc: 0x85af, // 薯
+	0x0dcd: 0x6691, // 暑
+	0x0dce: 0x66d9, // 曙
+	0x0dcf: 0x7f72, // 署
+	0x0dd0: 0x8700, // 蜀
+	0x0dd1: 0x9ecd, // 黍
+	0x0dd2: 0x9f20, // 鼠
+	0x0dd3: 0x5c5e, // 属
+	0x0dd4: 0x672f, // 术
+	0x0dd5: 0x8ff0, // 述
+	0x0dd6: 0x6811, // 树
+	0x0dd7: 0x675f, // 束
+	0x0dd8: 0x620d, // 戍
+	0x0dd9: 0x7ad6, // 竖
+	0x0dda: 0x5885, // 墅
+	0x0ddb: 0x5eb6, // 庶
+	0x0ddc: 0x6570, // 数
+	0x0ddd: 0x6f31, // 漱
+	0x0de0: 0x6055, // 恕
+	0x0de1: 0x5237, // 刷
+	0x0de2: 0x800d, // 耍
+	0x0de3: 0x6454, // 摔
+	0x0de4: 0x8870, // 衰
+	0x0de5: 0x7529, // 甩
+	0x0de6: 0x5e05, // 帅
+	0x0de7: 0x6813, // 栓
+	0x0de8: 0x62f4, // 拴
+	0x0de9: 0x971c, // 霜
+	0x0dea: 0x53cc, // 双
+	0x0deb: 0x723d, // 爽
+	0x0dec: 0x8c01, // 谁
+	0x0ded: 0x6c34, // 水
+	0x0dee: 0x7761, // 睡
+	0x0def: 0x7a0e, // 税
+	0x0df0: 0x542e, // 吮
+	0x0df1: 0x77ac, // 瞬
+	0x0df2: 0x987a, // 顺
+	0x0df3: 0x821c, // 舜
+	0x0df4: 0x8bf4, // 说
+	0x0df5: 0x7855, // 硕
+	0x0df6: 0x6714, // 朔
+	0x0df7: 0x70c1, // 烁
+	0x0df8: 0x65af, // 斯
+	0x0df9: 0x6495, // 撕
+	0x0dfa: 0x5636, // 嘶
+	0x0dfb: 0x601d, // 思
+	0x0dfc: 0x79c1, // 私
+	0x0dfd: 0x53f8, // 司
+	0x0dfe: 0x4e1d, // 丝
+	0x0dff: 0x6b7b, // 死
+	0x0e00: 0x8086, // 肆
+	0x0e01: 0x5bfa, // 寺
+	0x0e02: 0x55e3, // 嗣
+	0x0e03: 0x56db, // 四
+	0x0e04: 0x4f3a, // 伺
+	0x0e05: 0x4f3c, // 似
+	0x0e06: 0x9972, // 饲
+	0x0e07: 0x5df3, // 巳
+	0x0e08: 0x677e, // 松
+	0x0e09: 0x8038, // 耸
+	0x0e0a: 0x6002, // 怂
+	0x0e0b: 0x9882, // 颂
+	0x0e0c: 0x9001, // 送
+	0x0e0d: 0x5b8b, // 宋
+	0x0e0e: 0x8bbc, // 讼
+	0x0e0f: 0x8bf5, // 诵
+	0x0e10: 0x641c, // 搜
+	0x0e11: 0x8258, // 艘
+	0x0e12: 0x64de, // 擞
+	0x0e13: 0x55fd, // 嗽
+	0x0e14: 0x82cf, // 苏
+	0x0e15: 0x9165, // 酥
+	0x0e16: 0x4fd7, // 俗
+	0x0e17: 0x7d20, // 素
+	0x0e18: 0x901f, // 速
+	0x0e19: 0x7c9f, // 粟
+	0x0e1a: 0x50f3, // 僳
+	0x0e1b: 0x5851, // 塑
+	0x0e1c: 0x6eaf, // 溯
+	0x0e1d: 0x5bbf, // 宿
+	0x0e1e: 0x8bc9, // 诉
+	0x0e1f: 0x8083, // 肃
+	0x0e20: 0x9178, // 酸
+	0x0e21: 0x849c, // 蒜
+	0x0e22: 0x7b97, // 算
+	0x0e23: 0x867d, // 虽
+	0x0e24: 0x968b, // 隋
+	0x0e25: 0x968f, // 随
+	0x0e26: 0x7ee5, // 绥
+	0x0e27: 0x9ad3, // 髓
+	0x0e28: 0x788e, // 碎
+	0x0e29: 0x5c81, // 岁
+	0x0e2a: 0x7a57, // 穗
+	0x0e2b: 0x9042, // 遂
+	0x0e2c: 0x96a7, // 隧
+	0x0e2d: 0x795f, // 祟
+	0x0e2e: 0x5b59, // 孙
+	0x0e2f: 0x635f, // 损
+	0x0e30: 0x7b0b, // 笋
+	0x0e31: 0x84d1, // 蓑
+	0x0e32: 0x68ad, // 梭
+	0x0e33: 0x5506, // 唆
+	0x0e34: 0x7f29, // 缩
+	0x0e35: 0x7410, // 琐
+	0x0e36: 0x7d22, // 索
+	0x0e37: 0x9501, // 锁
+	0x0e38: 0x6240, // 所
+	0x0e39: 0x584c, // 塌
+	0x0e3a: 0x4ed6, // 他
+	0x0e3b: 0x5b83, // 它
+	0x0e3c: 0x5979, // 她
+	0x0e3d: 0x5854, // 塔
+	0x0e40: 0x736d, // 獭
+	0x0e41: 0x631e, // 挞
+	0x0e42: 0x8e4b, // 蹋
+	0x0e43: 0x8e0f, // 踏
+	0x0e44: 0x80ce, // 胎
+	0x0e45: 0x82d4, // 苔
+	0x0e46: 0x62ac, // 抬
+	0x0e47: 0x53f0, // 台
+	0x0e48: 0x6cf0, // 泰
+	0x0e49: 0x915e, // 酞
+	0x0e4a: 0x592a, // 太
+	0x0e4b: 0x6001, // 态
+	0x0e4c: 0x6c70, // 汰
+	0x0e4d: 0x574d, // 坍
+	0x0e4e: 0x644a, // 摊
+	0x0e4f: 0x8d2a, // 贪
+	0x0e50: 0x762b, // 瘫
+	0x0e51: 0x6ee9, // 滩
+	0x0e52: 0x575b, // 坛
+	0x0e53: 0x6a80, // 檀
+	0x0e54: 0x75f0, // 痰
+	0x0e55: 0x6f6d, // 潭
+	0x0e56: 0x8c2d, // 谭
+	0x0e57: 0x8c08, // 谈
+	0x0e58: 0x5766, // 坦
+	0x0e59: 0x6bef, // 毯
+	0x0e5a: 0x8892, // 袒
+	0x0e5b: 0x78b3, // 碳
+	0x0e5c: 0x63a2, // 探
+	0x0e5d: 0x53f9, // 叹
+	0x0e5e: 0x70ad, // 炭
+	0x0e5f: 0x6c64, // 汤
+	0x0e60: 0x5858, // 塘
+	0x0e61: 0x642a, // 搪
+	0x0e62: 0x5802, // 堂
+	0x0e63: 0x68e0, // 棠
+	0x0e64: 0x819b, // 膛
+	0x0e65: 0x5510, // 唐
+	0x0e66: 0x7cd6, // 糖
+	0x0e67: 0x5018, // 倘
+	0x0e68: 0x8eba, // 躺
+	0x0e69: 0x6dcc, // 淌
+	0x0e6a: 0x8d9f, // 趟
+	0x0e6b: 0x70eb, // 烫
+	0x0e6c: 0x638f, // 掏
+	0x0e6d: 0x6d9b, // 涛
+	0x0e6e: 0x6ed4, // 滔
+	0x0e6f: 0x7ee6, // 绦
+	0x0e70: 0x8404, // 萄
+	0x0e71: 0x6843, // 桃
+	0x0e72: 0x9003, // 逃
+	0x0e73: 0x6dd8, // 淘
+	0x0e74: 0x9676, // 陶
+	0x0e75: 0x8ba8, // 讨
+	0x0e76: 0x5957, // 套
+	0x0e77: 0x7279, // 特
+	0x0e78: 0x85e4, // 藤
+	0x0e79: 0x817e, // 腾
+	0x0e7a: 0x75bc, // 疼
+	0x0e7b: 0x8a8a, // 誊
+	0x0e7c: 0x68af, // 梯
+	0x0e7d: 0x5254, // 剔
+	0x0e7e: 0x8e22, // 踢
+	0x0e7f: 0x9511, // 锑
+	0x0e80: 0x63d0, // 提
+	0x0e81: 0x9898, // 题
+	0x0e82: 0x8e44, // 蹄
+	0x0e83: 0x557c, // 啼
+	0x0e84: 0x4f53, // 体
+	0x0e85: 0x66ff, // 替
+	0x0e86: 0x568f, // 嚏
+	0x0e87: 0x60d5, // 惕
+	0x0e88: 0x6d95, // 涕
+	0x0e89: 0x5243, // 剃
+	0x0e8a: 0x5c49, // 屉
+	0x0e8b: 0x5929, // 天
+	0x0e8c: 0x6dfb, // 添
+	0x0e8d: 0x586b, // 填
+	0x0e8e: 0x7530, // 田
+	0x0e8f: 0x751c, // 甜
+	0x0e90: 0x606c, // 恬
+	0x0e91: 0x8214, // 舔
+	0x0e92: 0x8146, // 腆
+	0x0e93: 0x6311, // 挑
+	0x0e94: 0x6761, // 条
+	0x0e95: 0x8fe2, // 迢
+	0x0e96: 0x773a, // 眺
+	0x0e97: 0x8df3, // 跳
+	0x0e98: 0x8d34, // 贴
+	0x0e99: 0x94c1, // 铁
+	0x0e9a: 0x5e16, // 帖
+	0x0e9b: 0x5385, // 厅
+	0x0e9c: 0x542c, // 听
+	0x0e9d: 0x70c3, // 烃
+	0x0ea0: 0x6c40, // 汀
+	0x0ea1: 0x5ef7, // 廷
+	0x0ea2: 0x505c, // 停
+	0x0ea3: 0x4ead, // 亭
+	0x0ea4: 0x5ead, // 庭
+	0x0ea5: 0x633a, // 挺
+	0x0ea6: 0x8247, // 艇
+	0x0ea7: 0x901a, // 通
+	0x0ea8: 0x6850, // 桐
+	0x0ea9: 0x916e, // 酮
+	0x0eaa: 0x77b3, // 瞳
+	0x0eab: 0x540c, // 同
+	0x0eac: 0x94dc, // 铜
+	0x0ead: 0x5f64, // 彤
+	0x0eae: 0x7ae5, // 童
+	0x0eaf: 0x6876, // 桶
+	0x0eb0: 0x6345, // 捅
+	0x0eb1: 0x7b52, // 筒
+	0x0eb2: 0x7edf, // 统
+	0x0eb3: 0x75db, // 痛
+	0x0eb4: 0x5077, // 偷
+	0x0eb5: 0x6295, // 投
+	0x0eb6: 0x5934, // 头
+	0x0eb7: 0x900f, // 透
+	0x0eb8: 0x51f8, // 凸
+	0x0eb9: 0x79c3, // 秃
+	0x0eba: 0x7a81, // 突
+	0x0ebb: 0x56fe, // 图
+	0x0ebc: 0x5f92, // 徒
+	0x0ebd: 0x9014, // 途
+	0x0ebe: 0x6d82, // 涂
+	0x0ebf: 0x5c60, // 屠
+	0x0ec0: 0x571f, // 土
+	0x0ec1: 0x5410, // 吐
+	0x0ec2: 0x5154, // 兔
+	0x0ec3: 0x6e4d, // 湍
+	0x0ec4: 0x56e2, // 团
+	0x0ec5: 0x63a8, // 推
+	0x0ec6: 0x9893, // 颓
+	0x0ec7: 0x817f, // 腿
+	0x0ec8: 0x8715, // 蜕
+	0x0ec9: 0x892a, // 褪
+	0x0eca: 0x9000, // 退
+	0x0ecb: 0x541e, // 吞
+	0x0ecc: 0x5c6f, // 屯
+	0x0ecd: 0x81c0, // 臀
+	0x0ece: 0x62d6, // 拖
+	0x0ecf: 0x6258, // 托
+	0x0ed0: 0x8131, // 脱
+	0x0ed1: 0x9e35, // 鸵
+	0x0ed2: 0x9640, // 陀
+	0x0ed3: 0x9a6e, // 驮
+	0x0ed4: 0x9a7c, // 驼
+	0x0ed5: 0x692d, // 椭
+	0x0ed6: 0x59a5, // 妥
+	0x0ed7: 0x62d3, // 拓
+	0x0ed8: 0x553e, // 唾
+	0x0ed9: 0x6316, // 挖
+	0x0eda: 0x54c7, // 哇
+	0x0edb: 0x86d9, // 蛙
+	0x0edc: 0x6d3c, // 洼
+	0x0edd: 0x5a03, // 娃
+	0x0ede: 0x74e6, // 瓦
+	0x0edf: 0x889c, // 袜
+	0x0ee0: 0x6b6a, // 歪
+	0x0ee1: 0x5916, // 外
+	0x0ee2: 0x8c4c, // 豌
+	0x0ee3: 0x5f2f, // 弯
+	0x0ee4: 0x6e7e, // 湾
+	0x0ee5: 0x73a9, // 玩
+	0x0ee6: 0x987d, // 顽
+	0x0ee7: 0x4e38, // 丸
+	0x0ee8: 0x70f7, // 烷
+	0x0ee9: 0x5b8c, // 完
+	0x0eea: 0x7897, // 碗
+	0x0eeb: 0x633d, // 挽
+	0x0eec: 0x665a, // 晚
+	0x0eed: 0x7696, // 皖
+	0x0eee: 0x60cb, // 惋
+	0x0eef: 0x5b9b, // 宛
+	0x0ef0: 0x5a49, // 婉
+	0x0ef1: 0x4e07, // 万
+	0x0ef2: 0x8155, // 腕
+	0x0ef3: 0x6c6a, // 汪
+	0x0ef4: 0x738b, // 王
+	0x0ef5: 0x4ea1, // 亡
+	0x0ef6: 0x6789, // 枉
+	0x0ef7: 0x7f51, // 网
+	0x0ef8: 0x5f80, // 往
+	0x0ef9: 0x65fa, // 旺
+	0x0efa: 0x671b, // 望
+	0x0efb: 0x5fd8, // 忘
+	0x0efc: 0x5984, // 妄
+	0x0efd: 0x5a01, // 威
+	0x0f00: 0x5dcd, // 巍
+	0x0f01: 0x5fae, // 微
+	0x0f02: 0x5371, // 危
+	0x0f03: 0x97e6, // 韦
+	0x0f04: 0x8fdd, // 违
+	0x0f05: 0x6845, // 桅
+	0x0f06: 0x56f4, // 围
+	0x0f07: 0x552f, // 唯
+	0x0f08: 0x60df, // 惟
+	0x0f09: 0x4e3a, // 为
+	0x0f0a: 0x6f4d, // 潍
+	0x0f0b: 0x7ef4, // 维
+	0x0f0c: 0x82c7, // 苇
+	0x0f0d: 0x840e, // 萎
+	0x0f0e: 0x59d4, // 委
+	0x0f0f: 0x4f1f, // 伟
+	0x0f10: 0x4f2a, // 伪
+	0x0f11: 0x5c3e, // 尾
+	0x0f12: 0x7eac, // 纬
+	0x0f13: 0x672a, // 未
+	0x0f14: 0x851a, // 蔚
+	0x0f15: 0x5473, // 味
+	0x0f16: 0x754f, // 畏
+	0x0f17: 0x80c3, // 胃
+	0x0f18: 0x5582, // 喂
+	0x0f19: 0x9b4f, // 魏
+	0x0f1a: 0x4f4d, // 位
+	0x0f1b: 0x6e2d, // 渭
+	0x0f1c: 0x8c13, // 谓
+	0x0f1d: 0x5c09, // 尉
+	0x0f1e: 0x6170, // 慰
+	0x0f1f: 0x536b, // 卫
+	0x0f20: 0x761f, // 瘟
+	0x0f21: 0x6e29, // 温
+	0x0f22: 0x868a, // 蚊
+	0x0f23: 0x6587, // 文
+	0x0f24: 0x95fb, // 闻
+	0x0f25: 0x7eb9, // 纹
+	0x0f26: 0x543b, // 吻
+	0x0f27: 0x7a33, // 稳
+	0x0f28: 0x7d0a, // 紊
+	0x0f29: 0x95ee, // 问
+	0x0f2a: 0x55e1, // 嗡
+	0x0f2b: 0x7fc1, // 翁
+	0x0f2c: 0x74ee, // 瓮
+	0x0f2d: 0x631d, // 挝
+	0x0f2e: 0x8717, // 蜗
+	0x0f2f: 0x6da1, // 涡
+	0x0f30: 0x7a9d, // 窝
+	0x0f31: 0x6211, // 我
+	0x0f32: 0x65a1, // 斡
+	0x0f33: 0x5367, // 卧
+	0x0f34: 0x63e1, // 握
+	0x0f35: 0x6c83, // 沃
+	0x0f36: 0x5deb, // 巫
+	0x0f37: 0x545c, // 呜
+	0x0f38: 0x94a8, // 钨
+	0x0f39: 0x4e4c, // 乌
+	0x0f3a: 0x6c61, // 污
+	0x0f3b: 0x8bec, // 诬
+	0x0f3c: 0x5c4b, // 屋
+	0x0f3d: 0x65e0, // 无
+	0x0f3e: 0x829c, // 芜
+	0x0f3f: 0x68a7, // 梧
+	0x0f40: 0x543e, // 吾
+	0x0f41: 0x5434, // 吴
+	0x0f42: 0x6bcb, // 毋
+	0x0f43: 0x6b66, // 武
+	0x0f44: 0x4e94, // 五
+	0x0f45: 0x6342, // 捂
+	0x0f46: 0x5348, // 午
+	0x0f47: 0x821e, // 舞
+	0x0f48: 0x4f0d, // 伍
+	0x0f49: 0x4fae, // 侮
+	0x0f4a: 0x575e, // 坞
+	0x0f4b: 0x620a, // 戊
+	0x0f4c: 0x96fe, // 雾
+	0x0f4d: 0x6664, // 晤
+	0x0f4e: 0x7269, // 物
+	0x0f4f: 0x52ff, // 勿
+	0x0f50: 0x52a1, // 务
+	0x0f51: 0x609f, // 悟
+	0x0f52: 0x8bef, // 误
+	0x0f53: 0x6614, // 昔
+	0x0f54: 0x7199, // 熙
+	0x0f55: 0x6790, // 析
+	0x0f56: 0x897f, // 西
+	0x0f57: 0x7852, // 硒
+	0x0f58: 0x77fd, // 矽
+	0x0f59: 0x6670, // 晰
+	0x0f5a: 0x563b, // 嘻
+	0x0f5b: 0x5438, // 吸
+	0x0f5c: 0x9521, // 锡
+	0x0f5d: 0x727a, // 牺
+	0x0f60: 0x7a00, // 稀
+	0x0f61: 0x606f, // 息
+	0x0f62: 0x5e0c, // 希
+	0x0f63: 0x6089, // 悉
+	0x0f64: 0x819d, // 膝
+	0x0f65: 0x5915, // 夕
+	0x0f66: 0x60dc, // 惜
+	0x0f67: 0x7184, // 熄
+	0x0f68: 0x70ef, // 烯
+	0x0f69: 0x6eaa, // 溪
+	0x0f6a: 0x6c50, // 汐
+	0x0f6b: 0x7280, // 犀
+	0x0f6c: 0x6a84, // 檄
+	0x0f6d: 0x88ad, // 袭
+	0x0f6e: 0x5e2d, // 席
+	0x0f6f: 0x4e60, // 习
+	0x0f70: 0x5ab3, // 媳
+	0x0f71: 0x559c, // 喜
+	0x0f72: 0x94e3, // 铣
+	0x0f73: 0x6d17, // 洗
+	0x0f74: 0x7cfb, // 系
+	0x0f75: 0x9699, // 隙
+	0x0f76: 0x620f, // 戏
+	0x0f77: 0x7ec6, // 细
+	0x0f78: 0x778e, // 瞎
+	0x0f79: 0x867e, // 虾
+	0x0f7a: 0x5323, // 匣
+	0x0f7b: 0x971e, // 霞
+	0x0f7c: 0x8f96, // 辖
+	0x0f7d: 0x6687, // 暇
+	0x0f7e: 0x5ce1, // 峡
+	0x0f7f: 0x4fa0, // 侠
+	0x0f80: 0x72ed, // 狭
+	0x0f81: 0x4e0b, // 下
+	0x0f82: 0x53a6, // 厦
+	0x0f83: 0x590f, // 夏
+	0x0f84: 0x5413, // 吓
+	0x0f85: 0x6380, // 掀
+	0x0f86: 0x9528, // 锨
+	0x0f87: 0x5148, // 先
+	0x0f88: 0x4ed9, // 仙
+	0x0f89: 0x9c9c, // 鲜
+	0x0f8a: 0x7ea4, // 纤
+	0x0f8b: 0x54b8, // 咸
+	0x0f8c: 0x8d24, // 贤
+	0x0f8d: 0x8854, // 衔
+	0x0f8e: 0x8237, // 舷
+	0x0f8f: 0x95f2, // 闲
+	0x0f90: 0x6d8e, // 涎
+	0x0f91: 0x5f26, // 弦
+	0x0f92: 0x5acc, // 嫌
+	0x0f93: 0x663e, // 显
+	0x0f94: 0x9669, // 险
+	0x0f95: 0x73b0, // 现
+	0x0f96: 0x732e, // 献
+	0x0f97: 0x53bf, // 县
+	0x0f98: 0x817a, // 腺
+	0x0f99: 0x9985, // 馅
+	0x0f9a: 0x7fa1, // 羡
+	0x0f9b: 0x5baa, // 宪
+	0x0f9c: 0x9677, // 陷
+	0x0f9d: 0x9650, // 限
+	0x0f9e: 0x7ebf, // 线
+	0x0f9f: 0x76f8, // 相
+	0x0fa0: 0x53a2, // 厢
+	0x0fa1: 0x9576, // 镶
+	0x0fa2: 0x9999, // 香
+	0x0fa3: 0x7bb1, // 箱
+	0x0fa4: 0x8944, // 襄
+	0x0fa5: 0x6e58, // 湘
+	0x0fa6: 0x4e61, // 乡
+	0x0fa7: 0x7fd4, // 翔
+	0x0fa8: 0x7965, // 祥
+	0x0fa9: 0x8be6, // 详
+	0x0faa: 0x60f3, // 想
+	0x0fab: 0x54cd, // 响
+	0x0fac: 0x4eab, // 享
+	0x0fad: 0x9879, // 项
+	0x0fae: 0x5df7, // 巷
+	0x0faf: 0x6a61, // 橡
+	0x0fb0: 0x50cf, // 像
+	0x0fb1: 0x5411, // 向
+	0x0fb2: 0x8c61, // 象
+	0x0fb3: 0x8427, // 萧
+	0x0fb4: 0x785d, // 硝
+	0x0fb5: 0x9704, // 霄
+	0x0fb6: 0x524a, // 削
+	0x0fb7: 0x54ee, // 哮
+	0x0fb8: 0x56a3, // 嚣
+	0x0fb9: 0x9500, // 销
+	0x0fba: 0x6d88, // 消
+	0x0fbb: 0x5bb5, // 宵
+	0x0fbc: 0x6dc6, // 淆
+	0x0fbd: 0x6653, // 晓
+	0x0fc0: 0x5c0f, // 小
+	0x0fc1: 0x5b5d, // 孝
+	0x0fc2: 0x6821, // 校
+	0x0fc3: 0x8096, // 肖
+	0x0fc4: 0x5578, // 啸
+	0x0fc5: 0x7b11, // 笑
+	0x0fc6: 0x6548, // 效
+	0x0fc7: 0x6954, // 楔
+	0x0fc8: 0x4e9b, // 些
+	0x0fc9: 0x6b47, // 歇
+	0x0fca: 0x874e, // 蝎
+	0x0fcb: 0x978b, // 鞋
+	0x0fcc: 0x534f, // 协
+	0x0fcd: 0x631f, // 挟
+	0x0fce: 0x643a, // 携
+	0x0fcf: 0x90aa, // 邪
+	0x0fd0: 0x659c, // 斜
+	0x0fd1: 0x80c1, // 胁
+	0x0fd2: 0x8c10, // 谐
+	0x0fd3: 0x5199, // 写
+	0x0fd4: 0x68b0, // 械
+	0x0fd5: 0x5378, // 卸
+	0x0fd6: 0x87f9, // 蟹
+	0x0fd7: 0x61c8, // 懈
+	0x0fd8: 0x6cc4, // 泄
+	0x0fd9: 0x6cfb, // 泻
+	0x0fda: 0x8c22, // 谢
+	0x0fdb: 0x5c51, // 屑
+	0x0fdc: 0x85aa, // 薪
+	0x0fdd: 0x82af, // 芯
+	0x0fde: 0x950c, // 锌
+	0x0fdf: 0x6b23, // 欣
+	0x0fe0: 0x8f9b, // 辛
+	0x0fe1: 0x65b0, // 新
+	0x0fe2: 0x5ffb, // 忻
+	0x0fe3: 0x5fc3, // 心
+	0x0fe4: 0x4fe1, // 信
+	0x0fe5: 0x8845, // 衅
+	0x0fe6: 0x661f, // 星
+	0x0fe7: 0x8165, // 腥
+	0x0fe8: 0x7329, // 猩
+	0x0fe9: 0x60fa, // 惺
+	0x0fea: 0x5174, // 兴
+	0x0feb: 0x5211, // 刑
+	0x0fec: 0x578b, // 型
+	0x0fed: 0x5f62, // 形
+	0x0fee: 0x90a2, // 邢
+	0x0fef: 0x884c, // 行
+	0x0ff0: 0x9192, // 醒
+	0x0ff1: 0x5e78, // 幸
+	0x0ff2: 0x674f, // 杏
+	0x0ff3: 0x6027, // 性
+	0x0ff4: 0x59d3, // 姓
+	0x0ff5: 0x5144, // 兄
+	0x0ff6: 0x51f6, // 凶
+	0x0ff7: 0x80f8, // 胸
+	0x0ff8: 0x5308, // 匈
+	0x0ff9: 0x6c79, // 汹
+	0x0ffa: 0x96c4, // 雄
+	0x0ffb: 0x718a, // 熊
+	0x0ffc: 0x4f11, // 休
+	0x0ffd: 0x4fee, // 修
+	0x0ffe: 0x7f9e, // 羞
+	0x0fff: 0x673d, // 朽
+	0x1000: 0x55c5, // 嗅
+	0x1001: 0x9508, // 锈
+	0x1002: 0x79c0, // 秀
+	0x1003: 0x8896, // 袖
+	0x1004: 0x7ee3, // 绣
+	0x1005: 0x589f, // 墟
+	0x1006: 0x620c, // 戌
+	0x1007: 0x9700, // 需
+	0x1008: 0x865a, // 虚
+	0x1009: 0x5618, // 嘘
+	0x100a: 0x987b, // 须
+	0x100b: 0x5f90, // 徐
+	0x100c: 0x8bb8, // 许
+	0x100d: 0x84c4, // 蓄
+	0x100e: 0x9157, // 酗
+	0x100f: 0x53d9, // 叙
+	0x1010: 0x65ed, // 旭
+	0x1011: 0x5e8f, // 序
+	0x1012: 0x755c, // 畜
+	0x1013: 0x6064, // 恤
+	0x1014: 0x7d6e, // 絮
+	0x1015: 0x5a7f, // 婿
+	0x1016: 0x7eea, // 绪
+	0x1017: 0x7eed, // 续
+	0x1018: 0x8f69, // 轩
+	0x1019: 0x55a7, // 喧
+	0x101a: 0x5ba3, // 宣
+	0x101b: 0x60ac, // 悬
+	0x101c: 0x65cb, // 旋
+	0x101d: 0x7384, // 玄
+	0x1020: 0x9009, // 选
+	0x1021: 0x7663, // 癣
+	0x1022: 0x7729, // 眩
+	0x1023: 0x7eda, // 绚
+	0x1024: 0x9774, // 靴
+	0x1025: 0x859b, // 薛
+	0x1026: 0x5b66, // 学
+	0x1027: 0x7a74, // 穴
+	0x1028: 0x96ea, // 雪
+	0x1029: 0x8840, // 血
+	0x102a: 0x52cb, // 勋
+	0x102b: 0x718f, // 熏
+	0x102c: 0x5faa, // 循
+	0x102d: 0x65ec, // 旬
+	0x102e: 0x8be2, // 询
+	0x102f: 0x5bfb, // 寻
+	0x1030: 0x9a6f, // 驯
+	0x1031: 0x5de1, // 巡
+	0x1032: 0x6b89, // 殉
+	0x1033: 0x6c5b, // 汛
+	0x1034: 0x8bad, // 训
+	0x1035: 0x8baf, // 讯
+	0x1036: 0x900a, // 逊
+	0x1037: 0x8fc5, // 迅
+	0x1038: 0x538b, // 压
+	0x1039: 0x62bc, // 押
+	0x103a: 0x9e26, // 鸦
+	0x103b: 0x9e2d, // 鸭
+	0x103c: 0x5440, // 呀
+	0x103d: 0x4e2b, // 丫
+	0x103e: 0x82bd, // 芽
+	0x103f: 0x7259, // 牙
+	0x1040: 0x869c, // 蚜
+	0x1041: 0x5d16, // 崖
+	0x1042: 0x8859, // 衙
+	0x1043: 0x6daf, // 涯
+	0x1044: 0x96c5, // 雅
+	0x1045: 0x54d1, // 哑
+	0x1046: 0x4e9a, // 亚
+	0x1047: 0x8bb6, // 讶
+	0x1048: 0x7109, // 焉
+	0x1049: 0x54bd, // 咽
+	0x104a: 0x9609, // 阉
+	0x104b: 0x70df, // 烟
+	0x104c: 0x6df9, // 淹
+	0x104d: 0x76d0, // 盐
+	0x104e: 0x4e25, // 严
+	0x104f: 0x7814, // 研
+	0x1050: 0x8712, // 蜒
+	0x1051: 0x5ca9, // 岩
+	0x1052: 0x5ef6, // 延
+	0x1053: 0x8a00, // 言
+	0x1054: 0x989c, // 颜
+	0x1055: 0x960e, // 阎
+	0x1056: 0x708e, // 炎
+	0x1057: 0x6cbf, // 沿
+	0x1058: 0x5944, // 奄
+	0x1059: 0x63a9, // 掩
+	0x105a: 0x773c, // 眼
+	0x105b: 0x884d, // 衍
+	0x105c: 0x6f14, // 演
+	0x105d: 0x8273, // 艳
+	0x105e: 0x5830, // 堰
+	0x105f: 0x71d5, // 燕
+	0x1060: 0x538c, // 厌
+	0x1061: 0x781a, // 砚
+	0x1062: 0x96c1, // 雁
+	0x1063: 0x5501, // 唁
+	0x1064: 0x5f66, // 彦
+	0x1065: 0x7130, // 焰
+	0x1066: 0x5bb4, // 宴
+	0x1067: 0x8c1a, // 谚
+	0x1068: 0x9a8c, // 验
+	0x1069: 0x6b83, // 殃
+	0x106a: 0x592e, // 央
+	0x106b: 0x9e2f, // 鸯
+	0x106c: 0x79e7, // 秧
+	0x106d: 0x6768, // 杨
+	0x106e: 0x626c, // 扬
+	0x106f: 0x4f6f, // 佯
+	0x1070: 0x75a1, // 疡
+	0x1071: 0x7f8a, // 羊
+	0x1072: 0x6d0b, // 洋
+	0x1073: 0x9633, // 阳
+	0x1074: 0x6c27, // 氧
+	0x1075: 0x4ef0, // 仰
+	0x1076: 0x75d2, // 痒
+	0x1077: 0x517b, // 养
+	0x1078: 0x6837, // 样
+	0x1079: 0x6f3e, // 漾
+	0x107a: 0x9080, // 邀
+	0x107b: 0x8170, // 腰
+	0x107c: 0x5996, // 妖
+	0x107d: 0x7476, // 瑶
+	0x1080: 0x6447, // 摇
+	0x1081: 0x5c27, // 尧
+	0x1082: 0x9065, // 遥
+	0x1083: 0x7a91, // 窑
+	0x1084: 0x8c23, // 谣
+	0x1085: 0x59da, // 姚
+	0x1086: 0x54ac, // 咬
+	0x1087: 0x8200, // 舀
+	0x1088: 0x836f, // 药
+	0x1089: 0x8981, // 要
+	0x108a: 0x8000, // 耀
+	0x108b: 0x6930, // 椰
+	0x108c: 0x564e, // 噎
+	0x108d: 0x8036, // 耶
+	0x108e: 0x7237, // 爷
+	0x108f: 0x91ce, // 野
+	0x1090: 0x51b6, // 冶
+	0x1091: 0x4e5f, // 也
+	0x1092: 0x9875, // 页
+	0x1093: 0x6396, // 掖
+	0x1094: 0x4e1a, // 业
+	0x1095: 0x53f6, // 叶
+	0x1096: 0x66f3, // 曳
+	0x1097: 0x814b, // 腋
+	0x1098: 0x591c, // 夜
+	0x1099: 0x6db2, // 液
+	0x109a: 0x4e00, // 一
+	0x109b: 0x58f9, // 壹
+	0x109c: 0x533b, // 医
+	0x109d: 0x63d6, // 揖
+	0x109e: 0x94f1, // 铱
+	0x109f: 0x4f9d, // 依
+	0x10a0: 0x4f0a, // 伊
+	0x10a1: 0x8863, // 衣
+	0x10a2: 0x9890, // 颐
+	0x10a3: 0x5937, // 夷
+	0x10a4: 0x9057, // 遗
+	0x10a5: 0x79fb, // 移
+	0x10a6: 0x4eea, // 仪
+	0x10a7: 0x80f0, // 胰
+	0x10a8: 0x7591, // 疑
+	0x10a9: 0x6c82, // 沂
+	0x10aa: 0x5b9c, // 宜
+	0x10ab: 0x59e8, // 姨
+	0x10ac: 0x5f5d, // 彝
+	0x10ad: 0x6905, // 椅
+	0x10ae: 0x8681, // 蚁
+	0x10af: 0x501a, // 倚
+	0x10b0: 0x5df2, // 已
+	0x10b1: 0x4e59, // 乙
+	0x10b2: 0x77e3, // 矣
+	0x10b3: 0x4ee5, // 以
+	0x10b4: 0x827a, // 艺
+	0x10b5: 0x6291, // 抑
+	0x10b6: 0x6613, // 易
+	0x10b7: 0x9091, // 邑
+	0x10b8: 0x5c79, // 屹
+	0x10b9: 0x4ebf, // 亿
+	0x10ba: 0x5f79, // 役
+	0x10bb: 0x81c6, // 臆
+	0x10bc: 0x9038, // 逸
+	0x10bd: 0x8084, // 肄
+	0x10be: 0x75ab, // 疫
+	0x10bf: 0x4ea6, // 亦
+	0x10c0: 0x88d4, // 裔
+	0x10c1: 0x610f, // 意
+	0x10c2: 0x6bc5, // 毅
+	0x10c3: 0x5fc6, // 忆
+	0x10c4: 0x4e49, // 义
+	0x10c5: 0x76ca, // 益
+	0x10c6: 0x6ea2, // 溢
+	0x10c7: 0x8be3, // 诣
+	0x10c8: 0x8bae, // 议
+	0x10c9: 0x8c0a, // 谊
+	0x10ca: 0x8bd1, // 译
+	0x10cb: 0x5f02, // 异
+	0x10cc: 0x7ffc, // 翼
+	0x10cd: 0x7fcc, // 翌
+	0x10ce: 0x7ece, // 绎
+	0x10cf: 0x8335, // 茵
+	0x10d0: 0x836b, // 荫
+	0x10d1: 0x56e0, // 因
+	0x10d2: 0x6bb7, // 殷
+	0x10d3: 0x97f3, // 音
+	0x10d4: 0x9634, // 阴
+	0x10d5: 0x59fb, // 姻
+	0x10d6: 0x541f, // 吟
+	0x10d7: 0x94f6, // 银
+	0x10d8: 0x6deb, // 淫
+	0x10d9: 0x5bc5, // 寅
+	0x10da: 0x996e, // 饮
+	0x10db: 0x5c39, // 尹
+	0x10dc: 0x5f15, // 引
+	0x10dd: 0x9690, // 隐
+	0x10e0: 0x5370, // 印
+	0x10e1: 0x82f1, // 英
+	0x10e2: 0x6a31, // 樱
+	0x10e3: 0x5a74, // 婴
+	0x10e4: 0x9e70, // 鹰
+	0x10e5: 0x5e94, // 应
+	0x10e6: 0x7f28, // 缨
+	0x10e7: 0x83b9, // 莹
+	0x10e8: 0x8424, // 萤
+	0x10e9: 0x8425, // 营
+	0x10ea: 0x8367, // 荧
+	0x10eb: 0x8747, // 蝇
+	0x10ec: 0x8fce, // 迎
+	0x10ed: 0x8d62, // 赢
+	0x10ee: 0x76c8, // 盈
+	0x10ef: 0x5f71, // 影
+	0x10f0: 0x9896, // 颖
+	0x10f1: 0x786c, // 硬
+	0x10f2: 0x6620, // 映
+	0x10f3: 0x54df, // 哟
+	0x10f4: 0x62e5, // 拥
+	0x10f5: 0x4f63, // 佣
+	0x10f6: 0x81c3, // 臃
+	0x10f7: 0x75c8, // 痈
+	0x10f8: 0x5eb8, // 庸
+	0x10f9: 0x96cd, // 雍
+	0x10fa: 0x8e0a, // 踊
+	0x10fb: 0x86f9, // 蛹
+	0x10fc: 0x548f, // 咏
+	0x10fd: 0x6cf3, // 泳
+	0x10fe: 0x6d8c, // 涌
+	0x10ff: 0x6c38, // 永
+	0x1100: 0x607f, // 恿
+	0x1101: 0x52c7, // 勇
+	0x1102: 0x7528, // 用
+	0x1103: 0x5e7d, // 幽
+	0x1104: 0x4f18, // 优
+	0x1105: 0x60a0, // 悠
+	0x1106: 0x5fe7, // 忧
+	0x1107: 0x5c24, // 尤
+	0x1108: 0x7531, // 由
+	0x1109: 0x90ae, // 邮
+	0x110a: 0x94c0, // 铀
+	0x110b: 0x72b9, // 犹
+	0x110c: 0x6cb9, // 油
+	0x110d: 0x6e38, // 游
+	0x110e: 0x9149, // 酉
+	0x110f: 0x6709, // 有
+	0x1110: 0x53cb, // 友
+	0x1111: 0x53f3, // 右
+	0x1112: 0x4f51, // 佑
+	0x1113: 0x91c9, // 釉
+	0x1114: 0x8bf1, // 诱
+	0x1115: 0x53c8, // 又
+	0x1116: 0x5e7c, // 幼
+	0x1117: 0x8fc2, // 迂
+	0x1118: 0x6de4, // 淤
+	0x1119: 0x4e8e, // 于
+	0x111a: 0x76c2, // 盂
+	0x111b: 0x6986, // 榆
+	0x111c: 0x865e, // 虞
+	0x111d: 0x611a, // 愚
+	0x111e: 0x8206, // 舆
+	0x111f: 0x4f59, // 余
+	0x1120: 0x4fde, // 俞
+	0x1121: 0x903e, // 逾
+	0x1122: 0x9c7c, // 鱼
+	0x1123: 0x6109, // 愉
+	0x1124: 0x6e1d, // 渝
+	0x1125: 0x6e14, // 渔
+	0x1126: 0x9685, // 隅
+	0x1127: 0x4e88, // 予
+	0x1128: 0x5a31, // 娱
+	0x1129: 0x96e8, // 雨
+	0x112a: 0x4e0e, // 与
+	0x112b: 0x5c7f, // 屿
+	0x112c: 0x79b9, // 禹
+	0x112d: 0x5b87, // 宇
+	0x112e: 0x8bed, // 语
+	0x112f: 0x7fbd, // 羽
+	0x1130: 0x7389, // 玉
+	0x1131: 0x57df, // 域
+	0x1132: 0x828b, // 芋
+	0x1133: 0x90c1, // 郁
+	0x1134: 0x5401, // 吁
+	0x1135: 0x9047, // 遇
+	0x1136: 0x55bb, // 喻
+	0x1137: 0x5cea, // 峪
+	0x1138: 0x5fa1, // 御
+	0x1139: 0x6108, // 愈
+	0x113a: 0x6b32, // 欲
+	0x113b: 0x72f1, // 狱
+	0x113c: 0x80b2, // 育
+	0x113d: 0x8a89, // 誉
+	0x1140: 0x6d74, // 浴
+	0x1141: 0x5bd3, // 寓
+	0x1142: 0x88d5, // 裕
+	0x1143: 0x9884, // 预
+	0x1144: 0x8c6b, // 豫
+	0x1145: 0x9a6d, // 驭
+	0x1146: 0x9e33, // 鸳
+	0x1147: 0x6e0a, // 渊
+	0x1148: 0x51a4, // 冤
+	0x1149: 0x5143, // 元
+	0x114a: 0x57a3, // 垣
+	0x114b: 0x8881, // 袁
+	0x114c: 0x539f, // 原
+	0x114d: 0x63f4, // 援
+	0x114e: 0x8f95, // 辕
+	0x114f: 0x56ed, // 园
+	0x1150: 0x5458, // 员
+	0x1151: 0x5706, // 圆
+	0x1152: 0x733f, // 猿
+	0x1153: 0x6e90, // 源
+	0x1154: 0x7f18, // 缘
+	0x1155: 0x8fdc, // 远
+	0x1156: 0x82d1, // 苑
+	0x1157: 0x613f, // 愿
+	0x1158: 0x6028, // 怨
+	0x1159: 0x9662, // 院
+	0x115a: 0x66f0, // 曰
+	0x115b: 0x7ea6, // 约
+	0x115c: 0x8d8a, // 越
+	0x115d: 0x8dc3, // 跃
+	0x115e: 0x94a5, // 钥
+	0x115f: 0x5cb3, // 岳
+	0x1160: 0x7ca4, // 粤
+	0x1161: 0x6708, // 月
+	0x1162: 0x60a6, // 悦
+	0x1163: 0x9605, // 阅
+	0x1164: 0x8018, // 耘
+	0x1165: 0x4e91, // 云
+	0x1166: 0x90e7, // 郧
+	0x1167: 0x5300, // 匀
+	0x1168: 0x9668, // 陨
+	0x1169: 0x5141, // 允
+	0x116a: 0x8fd0, // 运
+	0x116b: 0x8574, // 蕴
+	0x116c: 0x915d, // 酝
+	0x116d: 0x6655, // 晕
+	0x116e: 0x97f5, // 韵
+	0x116f: 0x5b55, // 孕
+	0x1170: 0x531d, // 匝
+	0x1171: 0x7838, // 砸
+	0x1172: 0x6742, // 杂
+	0x1173: 0x683d, // 栽
+	0x1174: 0x54c9, // 哉
+	0x1175: 0x707e, // 灾
+	0x1176: 0x5bb0, // 宰
+	0x1177: 0x8f7d, // 载
+	0x1178: 0x518d, // 再
+	0x1179: 0x5728, // 在
+	0x117a: 0x54b1, // 咱
+	0x117b: 0x6512, // 攒
+	0x117c: 0x6682, // 暂
+	0x117d: 0x8d5e, // 赞
+	0x117e: 0x8d43, // 赃
+	0x117f: 0x810f, // 脏
+	0x1180: 0x846c, // 葬
+	0x1181: 0x906d, // 遭
+	0x1182: 0x7cdf, // 糟
+	0x1183: 0x51ff, // 凿
+	0x1184: 0x85fb, // 藻
+	0x1185: 0x67a3, // 枣
+	0x1186: 0x65e9, // 早
+	0x1187: 0x6fa1, // 澡
+	0x1188: 0x86a4, // 蚤
+	0x1189: 0x8e81, // 躁
+	0x118a: 0x566a, // 噪
+	0x118b: 0x9020, // 造
+	0x118c: 0x7682, // 皂
+	0x118d: 0x7076, // 灶
+	0x118e: 0x71e5, // 燥
+	0x118f: 0x8d23, // 责
+	0x1190: 0x62e9, // 择
+	0x1191: 0x5219, // 则
+	0x1192: 0x6cfd, // 泽
+	0x1193: 0x8d3c, // 贼
+	0x1194: 0x600e, // 怎
+	0x1195: 0x589e, // 增
+	0x1196: 0x618e, // 憎
+	0x1197: 0x66fe, // 曾
+	0x1198: 0x8d60, // 赠
+	0x1199: 0x624e, // 扎
+	0x119a: 0x55b3, // 喳
+	0x119b: 0x6e23, // 渣
+	0x119c: 0x672d, // 札
+	0x119d: 0x8f67, // 轧
+	0x11a0: 0x94e1, // 铡
+	0x11a1: 0x95f8, // 闸
+	0x11a2: 0x7728, // 眨
+	0x11a3: 0x6805, // 栅
+	0x11a4: 0x69a8, // 榨
+	0x11a5: 0x548b, // 咋
+	0x11a6: 0x4e4d, // 乍
+	0x11a7: 0x70b8, // 炸
+	0x11a8: 0x8bc8, // 诈
+	0x11a9: 0x6458, // 摘
+	0x11aa: 0x658b, // 斋
+	0x11ab: 0x5b85, // 宅
+	0x11ac: 0x7a84, // 窄
+	0x11ad: 0x503a, // 债
+	0x11ae: 0x5be8, // 寨
+	0x11af: 0x77bb, // 瞻
+	0x11b0: 0x6be1, // 毡
+	0x11b1: 0x8a79, // 詹
+	0x11b2: 0x7c98, // 粘
+	0x11b3: 0x6cbe, // 沾
+	0x11b4: 0x76cf, // 盏
+	0x11b5: 0x65a9, // 斩
+	0x11b6: 0x8f97, // 辗
+	0x11b7: 0x5d2d, // 崭
+	0x11b8: 0x5c55, // 展
+	0x11b9: 0x8638, // 蘸
+	0x11ba: 0x6808, // 栈
+	0x11bb: 0x5360, // 占
+	0x11bc: 0x6218, // 战
+	0x11bd: 0x7ad9, // 站
+	0x11be: 0x6e5b, // 湛
+	0x11bf: 0x7efd, // 绽
+	0x11c0: 0x6a1f, // 樟
+	0x11c1: 0x7ae0, // 章
+	0x11c2: 0x5f70, // 彰
+	0x11c3: 0x6f33, // 漳
+	0x11c4: 0x5f20, // 张
+	0x11c5: 0x638c, // 掌
+	0x11c6: 0x6da8, // 涨
+	0x11c7: 0x6756, // 杖
+	0x11c8: 0x4e08, // 丈
+	0x11c9: 0x5e10, // 帐
+	0x11ca: 0x8d26, // 账
+	0x11cb: 0x4ed7, // 仗
+	0x11cc: 0x80c0, // 胀
+	0x11cd: 0x7634, // 瘴
+	0x11ce: 0x969c, // 障
+	0x11cf: 0x62db, // 招
+	0x11d0: 0x662d, // 昭
+	0x11d1: 0x627e, // 找
+	0x11d2: 0x6cbc, // 沼
+	0x11d3: 0x8d75, // 赵
+	0x11d4: 0x7167, // 照
+	0x11d5: 0x7f69, // 罩
+	0x11d6: 0x5146, // 兆
+	0x11d7: 0x8087, // 肇
+	0x11d8: 0x53ec, // 召
+	0x11d9: 0x906e, // 遮
+	0x11da: 0x6298, // 折
+	0x11db: 0x54f2, // 哲
+	0x11dc: 0x86f0, // 蛰
+	0x11dd: 0x8f99, // 辙
+	0x11de: 0x8005, // 者
+	0x11df: 0x9517, // 锗
+	0x11e0: 0x8517, // 蔗
+	0x11e1: 0x8fd9, // 这
+	0x11e2: 0x6d59, // 浙
+	0x11e3: 0x73cd, // 珍
+	0x11e4: 0x659f, // 斟
+	0x11e5: 0x771f, // 真
+	0x11e6: 0x7504, // 甄
+	0x11e7: 0x7827, // 砧
+	0x11e8: 0x81fb, // 臻
+	0x11e9: 0x8d1e, // 贞
+	0x11ea: 0x9488, // 针
+	0x11eb: 0x4fa6, // 侦
+	0x11ec: 0x6795, // 枕
+	0x11ed: 0x75b9, // 疹
+	0x11ee: 0x8bca, // 诊
+	0x11ef: 0x9707, // 震
+	0x11f0: 0x632f, // 振
+	0x11f1: 0x9547, // 镇
+	0x11f2: 0x9635, // 阵
+	0x11f3: 0x84b8, // 蒸
+	0x11f4: 0x6323, // 挣
+	0x11f5: 0x7741, // 睁
+	0x11f6: 0x5f81, // 征
+	0x11f7: 0x72f0, // 狰
+	0x11f8: 0x4e89, // 争
+	0x11f9: 0x6014, // 怔
+	0x11fa: 0x6574, // 整
+	0x11fb: 0x62ef, // 拯
+	0x11fc: 0x6b63, // 正
+	0x11fd: 0x653f, // 政
+	0x1200: 0x5e27, // 帧
+	0x1201: 0x75c7, // 症
+	0x1202: 0x90d1, // 郑
+	0x1203: 0x8bc1, // 证
+	0x1204: 0x829d, // 芝
+	0x1205: 0x679d, // 枝
+	0x1206: 0x652f, // 支
+	0x1207: 0x5431, // 吱
+	0x1208: 0x8718, // 蜘
+	0x1209: 0x77e5, // 知
+	0x120a: 0x80a2, // 肢
+	0x120b: 0x8102, // 脂
+	0x120c: 0x6c41, // 汁
+	0x120d: 0x4e4b, // 之
+	0x120e: 0x7ec7, // 织
+	0x120f: 0x804c, // 职
+	0x1210: 0x76f4, // 直
+	0x1211: 0x690d, // 植
+	0x1212: 0x6b96, // 殖
+	0x1213: 0x6267, // 执
+	0x1214: 0x503c, // 值
+	0x1215: 0x4f84, // 侄
+	0x1216: 0x5740, // 址
+	0x1217: 0x6307, // 指
+	0x1218: 0x6b62, // 止
+	0x1219: 0x8dbe, // 趾
+	0x121a: 0x53ea, // 只
+	0x121b: 0x65e8, // 旨
+	0x121c: 0x7eb8, // 纸
+	0x121d: 0x5fd7, // 志
+	0x121e: 0x631a, // 挚
+	0x121f: 0x63b7, // 掷
+	0x1220: 0x81f3, // 至
+	0x1221: 0x81f4, // 致
+	0x1222: 0x7f6e, // 置
+	0x1223: 0x5e1c, // 帜
+	0x1224: 0x5cd9, // 峙
+	0x1225: 0x5236, // 制
+	0x1226: 0x667a, // 智
+	0x1227: 0x79e9, // 秩
+	0x1228: 0x7a1a, // 稚
+	0x1229: 0x8d28, // 质
+	0x122a: 0x7099, // 炙
+	0x122b: 0x75d4, // 痔
+	0x122c: 0x6ede, // 滞
+	0x122d: 0x6cbb, // 治
+	0x122e: 0x7a92, // 窒
+	0x122f: 0x4e2d, // 中
+	0x1230: 0x76c5, // 盅
+	0x1231: 0x5fe0, // 忠
+	0x1232: 0x949f, // 钟
+	0x1233: 0x8877, // 衷
+	0x1234: 0x7ec8, // 终
+	0x1235: 0x79cd, // 种
+	0x1236: 0x80bf, // 肿
+	0x1237: 0x91cd, // 重
+	0x1238: 0x4ef2, // 仲
+	0x1239: 0x4f17, // 众
+	0x123a: 0x821f, // 舟
+	0x123b: 0x5468, // 周
+	0x123c: 0x5dde, // 州
+	0x123d: 0x6d32, // 洲
+	0x123e: 0x8bcc, // 诌
+	0x123f: 0x7ca5, // 粥
+	0x1240: 0x8f74, // 轴
+	0x1241: 0x8098, // 肘
+	0x1242: 0x5e1a, // 帚
+	0x1243: 0x5492, // 咒
+	0x1244: 0x76b1, // 皱
+	0x1245: 0x5b99, // 宙
+	0x1246: 0x663c, // 昼
+	0x1247: 0x9aa4, // 骤
+	0x1248: 0x73e0, // 珠
+	0x1249: 0x682a, // 株
+	0x124a: 0x86db, // 蛛
+	0x124b: 0x6731, // 朱
+	0x124c: 0x732a, // 猪
+	0x124d: 0x8bf8, // 诸
+	0x124e: 0x8bdb, // 诛
+	0x124f: 0x9010, // 逐
+	0x1250: 0x7af9, // 竹
+	0x1251: 0x70db, // 烛
+	0x1252: 0x716e, // 煮
+	0x1253: 0x62c4, // 拄
+	0x1254: 0x77a9, // 瞩
+	0x1255: 0x5631, // 嘱
+	0x1256: 0x4e3b, // 主
+	0x1257: 0x8457, // 著
+	0x1258: 0x67f1, // 柱
+	0x1259: 0x52a9, // 助
+	0x125a: 0x86c0, // 蛀
+	0x125b: 0x8d2e, // 贮
+	0x125c: 0x94f8, // 铸
+	0x125d: 0x7b51, // 筑
+	0x1260: 0x4f4f, // 住
+	0x1261: 0x6ce8, // 注
+	0x1262: 0x795d, // 祝
+	0x1263: 0x9a7b, // 驻
+	0x1264: 0x6293, // 抓
+	0x1265: 0x722a, // 爪
+	0x1266: 0x62fd, // 拽
+	0x1267: 0x4e13, // 专
+	0x1268: 0x7816, // 砖
+	0x1269: 0x8f6c, // 转
+	0x126a: 0x64b0, // 撰
+	0x126b: 0x8d5a, // 赚
+	0x126c: 0x7bc6, // 篆
+	0x126d: 0x6869, // 桩
+	0x126e: 0x5e84, // 庄
+	0x126f: 0x88c5, // 装
+	0x1270: 0x5986, // 妆
+	0x1271: 0x649e, // 撞
+	0x1272: 0x58ee, // 壮
+	0x1273: 0x72b6, // 状
+	0x1274: 0x690e, // 椎
+	0x1275: 0x9525, // 锥
+	0x1276: 0x8ffd, // 追
+	0x1277: 0x8d58, // 赘
+	0x1278: 0x5760, // 坠
+	0x1279: 0x7f00, // 缀
+	0x127a: 0x8c06, // 谆
+	0x127b: 0x51c6, // 准
+	0x127c: 0x6349, // 捉
+	0x127d: 0x62d9, // 拙
+	0x127e: 0x5353, // 卓
+	0x127f: 0x684c, // 桌
+	0x1280: 0x7422, // 琢
+	0x1281: 0x8301, // 茁
+	0x1282: 0x914c, // 酌
+	0x1283: 0x5544, // 啄
+	0x1284: 0x7740, // 着
+	0x1285: 0x707c, // 灼
+	0x1286: 0x6d4a, // 浊
+	0x1287: 0x5179, // 兹
+	0x1288: 0x54a8, // 咨
+	0x1289: 0x8d44, // 资
+	0x128a: 0x59ff, // 姿
+	0x128b: 0x6ecb, // 滋
+	0x128c: 0x6dc4, // 淄
+	0x128d: 0x5b5c, // 孜
+	0x128e: 0x7d2b, // 紫
+	0x128f: 0x4ed4, // 仔
+	0x1290: 0x7c7d, // 籽
+	0x1291: 0x6ed3, // 滓
+	0x1292: 0x5b50, // 子
+	0x1293: 0x81ea, // 自
+	0x1294: 0x6e0d, // 渍
+	0x1295: 0x5b57, // 字
+	0x1296: 0x9b03, // 鬃
+	0x1297: 0x68d5, // 棕
+	0x1298: 0x8e2a, // 踪
+	0x1299: 0x5b97, // 宗
+	0x129a: 0x7efc, // 综
+	0x129b: 0x603b, // 总
+	0x129c: 0x7eb5, // 纵
+	0x129d: 0x90b9, // 邹
+	0x129e: 0x8d70, // 走
+	0x129f: 0x594f, // 奏
+	0x12a0: 0x63cd, // 揍
+	0x12a1: 0x79df, // 租
+	0x12a2: 0x8db3, // 足
+	0x12a3: 0x5352, // 卒
+	0x12a4: 0x65cf, // 族
+	0x12a5: 0x7956, // 祖
+	0x12a6: 0x8bc5, // 诅
+	0x12a7: 0x963b, // 阻
+	0x12a8: 0x7ec4, // 组
+	0x12a9: 0x94bb, // 钻
+	0x12aa: 0x7e82, // 纂
+	0x12ab: 0x5634, // 嘴
+	0x12ac: 0x9189, // 醉
+	0x12ad: 0x6700, // 最
+	0x12ae: 0x7f6a, // 罪
+	0x12af: 0x5c0a, // 尊
+	0x12b0: 0x9075, // 遵
+	0x12b1: 0x6628, // 昨
+	0x12b2: 0x5de6, // 左
+	0x12b3: 0x4f50, // 佐
+	0x12b4: 0x67de, // 柞
+	0x12b5: 0x505a, // 做
+	0x12b6: 0x4f5c, // 作
+	0x12b7: 0x5750, // 坐
+	0x12b8: 0x5ea7, // 座
+	0x12c0: 0x4e8d, // 亍
+	0x12c1: 0x4e0c, // 丌
+	0x12c2: 0x5140, // 兀
+	0x12c3: 0x4e10, // 丐
+	0x12c4: 0x5eff, // 廿
+	0x12c5: 0x5345, // 卅
+	0x12c6: 0x4e15, // 丕
+	0x12c7: 0x4e98, // 亘
+	0x12c8: 0x4e1e, // 丞
+	0x12c9: 0x9b32, // 鬲
+	0x12ca: 0x5b6c, // 孬
+	0x12cb: 0x5669, // 噩
+	0x12cc: 0x4e28, // 丨
+	0x12cd: 0x79ba, // 禺
+	0x12ce: 0x4e3f, // 丿
+	0x12cf: 0x5315, // 匕
+	0x12d0: 0x4e47, // 乇
+	0x12d1: 0x592d, // 夭
+	0x12d2: 0x723b, // 爻
+	0x12d3: 0x536e, // 卮
+	0x12d4: 0x6c10, // 氐
+	0x12d5: 0x56df, // 囟
+	0x12d6: 0x80e4, // 胤
+	0x12d7: 0x9997, // 馗
+	0x12d8: 0x6bd3, // 毓
+	0x12d9: 0x777e, // 睾
+	0x12da: 0x9f17, // 鼗
+	0x12db: 0x4e36, // 丶
+	0x12dc: 0x4e9f, // 亟
+	0x12dd: 0x9f10, // 鼐
+	0x12de: 0x4e5c, // 乜
+	0x12df: 0x4e69, // 乩
+	0x12e0: 0x4e93, // 亓
+	0x12e1: 0x8288, // 芈
+	0x12e2: 0x5b5b, // 孛
+	0x12e3: 0x556c, // 啬
+	0x12e4: 0x560f, // 嘏
+	0x12e5: 0x4ec4, // 仄
+	0x12e6: 0x538d, // 厍
+	0x12e7: 0x539d, // 厝
+	0x12e8: 0x53a3, // 厣
+	0x12e9: 0x53a5, // 厥
+	0x12ea: 0x53ae, // 厮
+	0x12eb: 0x9765, // 靥
+	0x12ec: 0x8d5d, // 赝
+	0x12ed: 0x531a, // 匚
+	0x12ee: 0x53f5, // 叵
+	0x12ef: 0x5326, // 匦
+	0x12f0: 0x532e, // 匮
+	0x12f1: 0x533e, // 匾
+	0x12f2: 0x8d5c, // 赜
+	0x12f3: 0x5366, // 卦
+	0x12f4: 0x5363, // 卣
+	0x12f5: 0x5202, // 刂
+	0x12f6: 0x5208, // 刈
+	0x12f7: 0x520e, // 刎
+	0x12f8: 0x522d, // 刭
+	0x12f9: 0x5233, // 刳
+	0x12fa: 0x523f, // 刿
+	0x12fb: 0x5240, // 剀
+	0x12fc: 0x524c, // 剌
+	0x12fd: 0x525e, // 剞
+	0x12fe: 0x5261, // 剡
+	0x12ff: 0x525c, // 剜
+	0x1300: 0x84af, // 蒯
+	0x1301: 0x527d, // 剽
+	0x1302: 0x5282, // 劂
+	0x1303: 0x5281, // 劁
+	0x1304: 0x5290, // 劐
+	0x1305: 0x5293, // 劓
+	0x1306: 0x5182, // 冂
+	0x1307: 0x7f54, // 罔
+	0x1308: 0x4ebb, // 亻
+	0x1309: 0x4ec3, // 仃
+	0x130a: 0x4ec9, // 仉
+	0x130b: 0x4ec2, // 仂
+	0x130c: 0x4ee8, // 仨
+	0x130d: 0x4ee1, // 仡
+	0x130e: 0x4eeb, // 仫
+	0x130f: 0x4ede, // 仞
+	0x1310: 0x4f1b, // 伛
+	0x1311: 0x4ef3, // 仳
+	0x1312: 0x4f22, // 伢
+	0x1313: 0x4f64, // 佤
+	0x1314: 0x4ef5, // 仵
+	0x1315: 0x4f25, // 伥
+	0x1316: 0x4f27, // 伧
+	0x1317: 0x4f09, // 伉
+	0x1318: 0x4f2b, // 伫
+	0x1319: 0x4f5e, // 佞
+	0x131a: 0x4f67, // 佧
+	0x131b: 0x6538, // 攸
+	0x131c: 0x4f5a, // 佚
+	0x131d: 0x4f5d, // 佝
+	0x1320: 0x4f5f, // 佟
+	0x1321: 0x4f57, // 佗
+	0x1322: 0x4f32, // 伲
+	0x1323: 0x4f3d, // 伽
+	0x1324: 0x4f76, // 佶
+	0x1325: 0x4f74, // 佴
+	0x1326: 0x4f91, // 侑
+	0x1327: 0x4f89, // 侉
+	0x1328: 0x4f83, // 侃
+	0x1329: 0x4f8f, // 侏
+	0x132a: 0x4f7e, // 佾
+	0x132b: 0x4f7b, // 佻
+	0x132c: 0x4faa, // 侪
+	0x132d: 0x4f7c, // 佼
+	0x132e: 0x4fac, // 侬
+	0x132f: 0x4f94, // 侔
+	0x1330: 0x4fe6, // 俦
+	0x1331: 0x4fe8, // 俨
+	0x1332: 0x4fea, // 俪
+	0x1333: 0x4fc5, // 俅
+	0x1334: 0x4fda, // 俚
+	0x1335: 0x4fe3, // 俣
+	0x1336: 0x4fdc, // 俜
+	0x1337: 0x4fd1, // 俑
+	0x1338: 0x4fdf, // 俟
+	0x1339: 0x4ff8, // 俸
+	0x133a: 0x5029, // 倩
+	0x133b: 0x504c, // 偌
+	0x133c: 0x4ff3, // 俳
+	0x133d: 0x502c, // 倬
+	0x133e: 0x500f, // 倏
+	0x133f: 0x502e, // 倮
+	0x1340: 0x502d, // 倭
+	0x1341: 0x4ffe, // 俾
+	0x1342: 0x501c, // 倜
+	0x1343: 0x500c, // 倌
+	0x1344: 0x5025, // 倥
+	0x1345: 0x5028, // 倨
+	0x1346: 0x507e, // 偾
+	0x1347: 0x5043, // 偃
+	0x1348: 0x5055, // 偕
+	0x1349: 0x5048, // 偈
+	0x134a: 0x504e, // 偎
+	0x134b: 0x506c, // 偬
+	0x134c: 0x507b, // 偻
+	0x134d: 0x50a5, // 傥
+	0x134e: 0x50a7, // 傧
+	0x134f: 0x50a9, // 傩
+	0x1350: 0x50ba, // 傺
+	0x1351: 0x50d6, // 僖
+	0x1352: 0x5106, // 儆
+	0x1353: 0x50ed, // 僭
+	0x1354: 0x50ec, // 僬
+	0x1355: 0x50e6, // 僦
+	0x1356: 0x50ee, // 僮
+	0x1357: 0x5107, // 儇
+	0x1358: 0x510b, // 儋
+	0x1359: 0x4edd, // 仝
+	0x135a: 0x6c3d, // 氽
+	0x135b: 0x4f58, // 佘
+	0x135c: 0x4f65, // 佥
+	0x135d: 0x4fce, // 俎
+	0x135e: 0x9fa0, // 龠
+	0x135f: 0x6c46, // 汆
+	0x1360: 0x7c74, // 籴
+	0x1361: 0x516e, // 兮
+	0x1362: 0x5dfd, // 巽
+	0x1363: 0x9ec9, // 黉
+	0x1364: 0x9998, // 馘
+	0x1365: 0x5181, // 冁
+	0x1366: 0x5914, // 夔
+	0x1367: 0x52f9, // 勹
+	0x1368: 0x530d, // 匍
+	0x1369: 0x8a07, // 訇
+	0x136a: 0x5310, // 匐
+	0x136b: 0x51eb, // 凫
+	0x136c: 0x5919, // 夙
+	0x136d: 0x5155, // 兕
+	0x136e: 0x4ea0, // 亠
+	0x136f: 0x5156, // 兖
+	0x1370: 0x4eb3, // 亳
+	0x1371: 0x886e, // 衮
+	0x1372: 0x88a4, // 袤
+	0x1373: 0x4eb5, // 亵
+	0x1374: 0x8114, // 脔
+	0x1375: 0x88d2, // 裒
+	0x1376: 0x7980, // 禀
+	0x1377: 0x5b34, // 嬴
+	0x1378: 0x8803, // 蠃
+	0x1379: 0x7fb8, // 羸
+	0x137a: 0x51ab, // 冫
+	0x137b: 0x51b1, // 冱
+	0x137c: 0x51bd, // 冽
+	0x137d: 0x51bc, // 冼
+	0x1380: 0x51c7, // 凇
+	0x1381: 0x5196, // 冖
+	0x1382: 0x51a2, // 冢
+	0x1383: 0x51a5, // 冥
+	0x1384: 0x8ba0, // 讠
+	0x1385: 0x8ba6, // 讦
+	0x1386: 0x8ba7, // 讧
+	0x1387: 0x8baa, // 讪
+	0x1388: 0x8bb4, // 讴
+	0x1389: 0x8bb5, // 讵
+	0x138a: 0x8bb7, // 讷
+	0x138b: 0x8bc2, // 诂
+	0x138c: 0x8bc3, // 诃
+	0x138d: 0x8bcb, // 诋
+	0x138e: 0x8bcf, // 诏
+	0x138f: 0x8bce, // 诎
+	0x1390: 0x8bd2, // 诒
+	0x1391: 0x8bd3, // 诓
+	0x1392: 0x8bd4, // 诔
+	0x1393: 0x8bd6, // 诖
+	0x1394: 0x8bd8, // 诘
+	0x1395: 0x8bd9, // 诙
+	0x1396: 0x8bdc, // 诜
+	0x1397: 0x8bdf, // 诟
+	0x1398: 0x8be0, // 诠
+	0x1399: 0x8be4, // 诤
+	0x139a: 0x8be8, // 诨
+	0x139b: 0x8be9, // 诩
+	0x139c: 0x8bee, // 诮
+	0x139d: 0x8bf0, // 诰
+	0x139e: 0x8bf3, // 诳
+	0x139f: 0x8bf6, // 诶
+	0x13a0: 0x8bf9, // 诹
+	0x13a1: 0x8bfc, // 诼
+	0x13a2: 0x8bff, // 诿
+	0x13a3: 0x8c00, // 谀
+	0x13a4: 0x8c02, // 谂
+	0x13a5: 0x8c04, // 谄
+	0x13a6: 0x8c07, // 谇
+	0x13a7: 0x8c0c, // 谌
+	0x13a8: 0x8c0f, // 谏
+	0x13a9: 0x8c11, // 谑
+	0x13aa: 0x8c12, // 谒
+	0x13ab: 0x8c14, // 谔
+	0x13ac: 0x8c15, // 谕
+	0x13ad: 0x8c16, // 谖
+	0x13ae: 0x8c19, // 谙
+	0x13af: 0x8c1b, // 谛
+	0x13b0: 0x8c18, // 谘
+	0x13b1: 0x8c1d, // 谝
+	0x13b2: 0x8c1f, // 谟
+	0x13b3: 0x8c20, // 谠
+	0x13b4: 0x8c21, // 谡
+	0x13b5: 0x8c25, // 谥
+	0x13b6: 0x8c27, // 谧
+	0x13b7: 0x8c2a, // 谪
+	0x13b8: 0x8c2b, // 谫
+	0x13b9: 0x8c2e, // 谮
+	0x13ba: 0x8c2f, // 谯
+	0x13bb: 0x8c32, // 谲
+	0x13bc: 0x8c33, // 谳
+	0x13bd: 0x8c35, // 谵
+	0x13be: 0x8c36, // 谶
+	0x13bf: 0x5369, // 卩
+	0x13c0: 0x537a, // 卺
+	0x13c1: 0x961d, // 阝
+	0x13c2: 0x9622, // 阢
+	0x13c3: 0x9621, // 阡
+	0x13c4: 0x9631, // 阱
+	0x13c5: 0x962a, // 阪
+	0x13c6: 0x963d, // 阽
+	0x13c7: 0x963c, // 阼
+	0x13c8: 0x9642, // 陂
+	0x13c9: 0x9649, // 陉
+	0x13ca: 0x9654, // 陔
+	0x13cb: 0x965f, // 陟
+	0x13cc: 0x9667, // 陧
+	0x13cd: 0x966c, // 陬
+	0x13ce: 0x9672, // 陲
+	0x13cf: 0x9674, // 陴
+	0x13d0: 0x9688, // 隈
+	0x13d1: 0x968d, // 隍
+	0x13d2: 0x9697, // 隗
+	0x13d3: 0x96b0, // 隰
+	0x13d4: 0x9097, // 邗
+	0x13d5: 0x909b, // 邛
+	0x13d6: 0x909d, // 邝
+	0x13d7: 0x9099, // 邙
+	0x13d8: 0x90ac, // 邬
+	0x13d9: 0x90a1, // 邡
+	0x13da: 0x90b4, // 邴
+	0x13db: 0x90b3, // 邳
+	0x13dc: 0x90b6, // 邶
+	0x13dd: 0x90ba, // 邺
+	0x13e0: 0x90b8, // 邸
+	0x13e1: 0x90b0, // 邰
+	0x13e2: 0x90cf, // 郏
+	0x13e3: 0x90c5, // 郅
+	0x13e4: 0x90be, // 邾
+	0x13e5: 0x90d0, // 郐
+	0x13e6: 0x90c4, // 郄
+	0x13e7: 0x90c7, // 郇
+	0x13e8: 0x90d3, // 郓
+	0x13e9: 0x90e6, // 郦
+	0x13ea: 0x90e2, // 郢
+	0x13eb: 0x90dc, // 郜
+	0x13ec: 0x90d7, // 郗
+	0x13ed: 0x90db, // 郛
+	0x13ee: 0x90eb, // 郫
+	0x13ef: 0x90ef, // 郯
+	0x13f0: 0x90fe, // 郾
+	0x13f1: 0x9104, // 鄄
+	0x13f2: 0x9122, // 鄢
+	0x13f3: 0x911e, // 鄞
+	0x13f4: 0x9123, // 鄣
+	0x13f5: 0x9131, // 鄱
+	0x13f6: 0x912f, // 鄯
+	0x13f7: 0x9139, // 鄹
+	0x13f8: 0x9143, // 酃
+	0x13f9: 0x9146, // 酆
+	0x13fa: 0x520d, // 刍
+	0x13fb: 0x5942, // 奂
+	0x13fc: 0x52a2, // 劢
+	0x13fd: 0x52ac, // 劬
+	0x13fe: 0x52ad, // 劭
+	0x13ff: 0x52be, // 劾
+	0x1400: 0x54ff, // 哿
+	0x1401: 0x52d0, // 勐
+	0x1402: 0x52d6, // 勖
+	0x1403: 0x52f0, // 勰
+	0x1404: 0x53df, // 叟
+	0x1405: 0x71ee, // 燮
+	0x1406: 0x77cd, // 矍
+	0x1407: 0x5ef4, // 廴
+	0x1408: 0x51f5, // 凵
+	0x1409: 0x51fc, // 凼
+	0x140a: 0x9b2f, // 鬯
+	0x140b: 0x53b6, // 厶
+	0x140c: 0x5f01, // 弁
+	0x140d: 0x755a, // 畚
+	0x140e: 0x5def, // 巯
+	0x140f: 0x574c, // 坌
+	0x1410: 0x57a9, // 垩
+	0x1411: 0x57a1, // 垡
+	0x1412: 0x587e, // 塾
+	0x1413: 0x58bc, // 墼
+	0x1414: 0x58c5, // 壅
+	0x1415: 0x58d1, // 壑
+	0x1416: 0x5729, // 圩
+	0x1417: 0x572c, // 圬
+	0x1418: 0x572a, // 圪
+	0x1419: 0x5733, // 圳
+	0x141a: 0x5739, // 圹
+	0x141b: 0x572e, // 圮
+	0x141c: 0x572f, // 圯
+	0x141d: 0x575c, // 坜
+	0x141e: 0x573b, // 圻
+	0x141f: 0x5742, // 坂
+	0x1420: 0x5769, // 坩
+	0x1421: 0x5785, // 垅
+	0x1422: 0x576b, // 坫
+	0x1423: 0x5786, // 垆
+	0x1424: 0x577c, // 坼
+	0x1425: 0x577b, // 坻
+	0x1426: 0x5768, // 坨
+	0x1427: 0x576d, // 坭
+	0x1428: 0x5776, // 坶
+	0x1429: 0x5773, // 坳
+	0x142a: 0x57ad, // 垭
+	0x142b: 0x57a4, // 垤
+	0x142c: 0x578c, // 垌
+	0x142d: 0x57b2, // 垲
+	0x142e: 0x57cf, // 埏
+	0x142f: 0x57a7, // 垧
+	0x1430: 0x57b4, // 垴
+	0x1431: 0x5793, // 垓
+	0x1432: 0x57a0, // 垠
+	0x1433: 0x57d5, // 埕
+	0x1434: 0x57d8, // 埘
+	0x1435: 0x57da, // 埚
+	0x1436: 0x57d9, // 埙
+	0x1437: 0x57d2, // 埒
+	0x1438: 0x57b8, // 垸
+	0x1439: 0x57f4, // 埴
+	0x143a: 0x57ef, // 埯
+	0x143b: 0x57f8, // 埸
+	0x143c: 0x57e4, // 埤
+	0x143d: 0x57dd, // 埝
+	0x1440: 0x580b, // 堋
+	0x1441: 0x580d, // 堍
+	0x1442: 0x57fd, // 埽
+	0x1443: 0x57ed, // 埭
+	0x1444: 0x5800, // 堀
+	0x1445: 0x581e, // 堞
+	0x1446: 0x5819, // 堙
+	0x1447: 0x5844, // 塄
+	0x1448: 0x5820, // 堠
+	0x1449: 0x5865, // 塥
+	0x144a: 0x586c, // 塬
+	0x144b: 0x5881, // 墁
+	0x144c: 0x5889, // 墉
+	0x144d: 0x589a, // 墚
+	0x144e: 0x5880, // 墀
+	0x144f: 0x99a8, // 馨
+	0x1450: 0x9f19, // 鼙
+	0x1451: 0x61ff, // 懿
+	0x1452: 0x8279, // 艹
+	0x1453: 0x827d, // 艽
+	0x1454: 0x827f, // 艿
+	0x1455: 0x828f, // 芏
+	0x1456: 0x828a, // 芊
+	0x1457: 0x82a8, // 芨
+	0x1458: 0x8284, // 芄
+	0x1459: 0x828e, // 芎
+	0x145a: 0x8291, // 芑
+	0x145b: 0x8297, // 芗
+	0x145c: 0x8299, // 芙
+	0x145d: 0x82ab, // 芫
+	0x145e: 0x82b8, // 芸
+	0x145f: 0x82be, // 芾
+	0x1460: 0x82b0, // 芰
+	0x1461: 0x82c8, // 苈
+	0x1462: 0x82ca, // 苊
+	0x1463: 0x82e3, // 苣
+	0x1464: 0x8298, // 芘
+	0x1465: 0x82b7, // 芷
+	0x1466: 0x82ae, // 芮
+	0x1467: 0x82cb, // 苋
+	0x1468: 0x82cc, // 苌
+	0x1469: 0x82c1, // 苁
+	0x146a: 0x82a9, // 芩
+	0x146b: 0x82b4, // 芴
+	0x146c: 0x82a1, // 芡
+	0x146d: 0x82aa, // 芪
+	0x146e: 0x829f, // 芟
+	0x146f: 0x82c4, // 苄
+	0x1470: 0x82ce, // 苎
+	0x1471: 0x82a4, // 芤
+	0x1472: 0x82e1, // 苡
+	0x1473: 0x8309, // 茉
+	0x1474: 0x82f7, // 苷
+	0x1475: 0x82e4, // 苤
+	0x1476: 0x830f, // 茏
+	0x1477: 0x8307, // 茇
+	0x1478: 0x82dc, // 苜
+	0x1479: 0x82f4, // 苴
+	0x147a: 0x82d2, // 苒
+	0x147b: 0x82d8, // 苘
+	0x147c: 0x830c, // 茌
+	0x147d: 0x82fb, // 苻
+	0x147e: 0x82d3, // 苓
+	0x147f: 0x8311, // 茑
+	0x1480: 0x831a, // 茚
+	0x1481: 0x8306, // 茆
+	0x1482: 0x8314, // 茔
+	0x1483: 0x8315, // 茕
+	0x1484: 0x82e0, // 苠
+	0x1485: 0x82d5, // 苕
+	0x1486: 0x831c, // 茜
+	0x1487: 0x8351, // 荑
+	0x1488: 0x835b, // 荛
+	0x1489: 0x835c, // 荜
+	0x148a: 0x8308, // 茈
+	0x148b: 0x8392, // 莒
+	0x148c: 0x833c, // 茼
+	0x148d: 0x8334, // 茴
+	0x148e: 0x8331, // 茱
+	0x148f: 0x839b, // 莛
+	0x1490: 0x835e, // 荞
+	0x1491: 0x832f, // 茯
+	0x1492: 0x834f, // 荏
+	0x1493: 0x8347, // 荇
+	0x1494: 0x8343, // 荃
+	0x1495: 0x835f, // 荟
+	0x1496: 0x8340, // 荀
+	0x1497: 0x8317, // 茗
+	0x1498: 0x8360, // 荠
+	0x1499: 0x832d, // 茭
+	0x149a: 0x833a, // 茺
+	0x149b: 0x8333, // 茳
+	0x149c: 0x8366, // 荦
+	0x149d: 0x8365, // 荥
+	0x14a0: 0x8368, // 荨
+	0x14a1: 0x831b, // 茛
+	0x14a2: 0x8369, // 荩
+	0x14a3: 0x836c, // 荬
+	0x14a4: 0x836a, // 荪
+	0x14a5: 0x836d, // 荭
+	0x14a6: 0x836e, // 荮
+	0x14a7: 0x83b0, // 莰
+	0x14a8: 0x8378, // 荸
+	0x14a9: 0x83b3, // 莳
+	0x14aa: 0x83b4, // 莴
+	0x14ab: 0x83a0, // 莠
+	0x14ac: 0x83aa, // 莪
+	0x14ad: 0x8393, // 莓
+	0x14ae: 0x839c, // 莜
+	0x14af: 0x8385, // 莅
+	0x14b0: 0x837c, // 荼
+	0x14b1: 0x83b6, // 莶
+	0x14b2: 0x83a9, // 莩
+	0x14b3: 0x837d, // 荽
+	0x14b4: 0x83b8, // 莸
+	0x14b5: 0x837b, // 荻
+	0x14b6: 0x8398, // 莘
+	0x14b7: 0x839e, // 莞
+	0x14b8: 0x83a8, // 莨
+	0x14b9: 0x83ba, // 莺
+	0x14ba: 0x83bc, // 莼
+	0x14bb: 0x83c1, // 菁
+	0x14bc: 0x8401, // 萁
+	0x14bd: 0x83e5, // 菥
+	0x14be: 0x83d8, // 菘
+	0x14bf: 0x5807, // 堇
+	0x14c0: 0x8418, // 萘
+	0x14c1: 0x840b, // 萋
+	0x14c2: 0x83dd, // 菝
+	0x14c3: 0x83fd, // 菽
+	0x14c4: 0x83d6, // 菖
+	0x14c5: 0x841c, // 萜
+	0x14c6: 0x8438, // 萸
+	0x14c7: 0x8411, // 萑
+	0x14c8: 0x8406, // 萆
+	0x14c9: 0x83d4, // 菔
+	0x14ca: 0x83df, // 菟
+	0x14cb: 0x840f, // 萏
+	0x14cc: 0x8403, // 萃
+	0x14cd: 0x83f8, // 菸
+	0x14ce: 0x83f9, // 菹
+	0x14cf: 0x83ea, // 菪
+	0x14d0: 0x83c5, // 菅
+	0x14d1: 0x83c0, // 菀
+	0x14d2: 0x8426, // 萦
+	0x14d3: 0x83f0, // 菰
+	0x14d4: 0x83e1, // 菡
+	0x14d5: 0x845c, // 葜
+	0x14d6: 0x8451, // 葑
+	0x14d7: 0x845a, // 葚
+	0x14d8: 0x8459, // 葙
+	0x14d9: 0x8473, // 葳
+	0x14da: 0x8487, // 蒇
+	0x14db: 0x8488, // 蒈
+	0x14dc: 0x847a, // 葺
+	0x14dd: 0x8489, // 蒉
+	0x14de: 0x8478, // 葸
+	0x14df: 0x843c, // 萼
+	0x14e0: 0x8446, // 葆
+	0x14e1: 0x8469, // 葩
+	0x14e2: 0x8476, // 葶
+	0x14e3: 0x848c, // 蒌
+	0x14e4: 0x848e, // 蒎
+	0x14e5: 0x8431, // 萱
+	0x14e6: 0x846d, // 葭
+	0x14e7: 0x84c1, // 蓁
+	0x14e8: 0x84cd, // 蓍
+	0x14e9: 0x84d0, // 蓐
+	0x14ea: 0x84e6, // 蓦
+	0x14eb: 0x84bd, // 蒽
+	0x14ec: 0x84d3, // 蓓
+	0x14ed: 0x84ca, // 蓊
+	0x14ee: 0x84bf, // 蒿
+	0x14ef: 0x84ba, // 蒺
+	0x14f0: 0x84e0, // 蓠
+	0x14f1: 0x84a1, // 蒡
+	0x14f2: 0x84b9, // 蒹
+	0x14f3: 0x84b4, // 蒴
+	0x14f4: 0x8497, // 蒗
+	0x14f5: 0x84e5, // 蓥
+	0x14f6: 0x84e3, // 蓣
+	0x14f7: 0x850c, // 蔌
+	0x14f8: 0x750d, // 甍
+	0x14f9: 0x8538, // 蔸
+	0x14fa: 0x84f0, // 蓰
+	0x14fb: 0x8539, // 蔹
+	0x14fc: 0x851f, // 蔟
+	0x14fd: 0x853a, // 蔺
+	0x1500: 0x8556, // 蕖
+	0x1501: 0x853b, // 蔻
+	0x1502: 0x84ff, // 蓿
+	0x1503: 0x84fc, // 蓼
+	0x1504: 0x8559, // 蕙
+	0x1505: 0x8548, // 蕈
+	0x1506: 0x8568, // 蕨
+	0x1507: 0x8564, // 蕤
+	0x1508: 0x855e, // 蕞
+	0x1509: 0x857a, // 蕺
+	0x150a: 0x77a2, // 瞢
+	0x150b: 0x8543, // 蕃
+	0x150c: 0x8572, // 蕲
+	0x150d: 0x857b, // 蕻
+	0x150e: 0x85a4, // 薤
+	0x150f: 0x85a8, // 薨
+	0x1510: 0x8587, // 薇
+	0x1511: 0x858f, // 薏
+	0x1512: 0x8579, // 蕹
+	0x1513: 0x85ae, // 薮
+	0x1514: 0x859c, // 薜
+	0x1515: 0x8585, // 薅
+	0x1516: 0x85b9, // 薹
+	0x1517: 0x85b7, // 薷
+	0x1518: 0x85b0, // 薰
+	0x1519: 0x85d3, // 藓
+	0x151a: 0x85c1, // 藁
+	0x151b: 0x85dc, // 藜
+	0x151c: 0x85ff, // 藿
+	0x151d: 0x8627, // 蘧
+	0x151e: 0x8605, // 蘅
+	0x151f: 0x8629, // 蘩
+	0x1520: 0x8616, // 蘖
+	0x1521: 0x863c, // 蘼
+	0x1522: 0x5efe, // 廾
+	0x1523: 0x5f08, // 弈
+	0x1524: 0x593c, // 夼
+	0x1525: 0x5941, // 奁
+	0x1526: 0x8037, // 耷
+	0x1527: 0x5955, // 奕
+	0x1528: 0x595a, // 奚
+	0x1529: 0x5958, // 奘
+	0x152a: 0x530f, // 匏
+	0x152b: 0x5c22, // 尢
+	0x152c: 0x5c25, // 尥
+	0x152d: 0x5c2c, // 尬
+	0x152e: 0x5c34, // 尴
+	0x152f: 0x624c, // 扌
+	0x1530: 0x626a, // 扪
+	0x1531: 0x629f, // 抟
+	0x1532: 0x62bb, // 抻
+	0x1533: 0x62ca, // 拊
+	0x1534: 0x62da, // 拚
+	0x1535: 0x62d7, // 拗
+	0x1536: 0x62ee, // 拮
+	0x1537: 0x6322, // 挢
+	0x1538: 0x62f6, // 拶
+	0x1539: 0x6339, // 挹
+	0x153a: 0x634b, // 捋
+	0x153b: 0x6343, // 捃
+	0x153c: 0x63ad, // 掭
+	0x153d: 0x63f6, // 揶
+	0x153e: 0x6371, // 捱
+	0x153f: 0x637a, // 捺
+	0x1540: 0x638e, // 掎
+	0x1541: 0x63b4, // 掴
+	0x1542: 0x636d, // 捭
+	0x1543: 0x63ac, // 掬
+	0x1544: 0x638a, // 掊
+	0x1545: 0x6369, // 捩
+	0x1546: 0x63ae, // 掮
+	0x1547: 0x63bc, // 掼
+	0x1548: 0x63f2, // 揲
+	0x1549: 0x63f8, // 揸
+	0x154a: 0x63e0, // 揠
+	0x154b: 0x63ff, // 揿
+	0x154c: 0x63c4, // 揄
+	0x154d: 0x63de, // 揞
+	0x154e: 0x63ce, // 揎
+	0x154f: 0x6452, // 摒
+	0x1550: 0x63c6, // 揆
+	0x1551: 0x63be, // 掾
+	0x1552: 0x6445, // 摅
+	0x1553: 0x6441, // 摁
+	0x1554: 0x640b, // 搋
+	0x1555: 0x641b, // 搛
+	0x1556: 0x6420, // 搠
+	0x1557: 0x640c, // 搌
+	0x1558: 0x6426, // 搦
+	0x1559: 0x6421, // 搡
+	0x155a: 0x645e, // 摞
+	0x155b: 0x6484, // 撄
+	0x155c: 0x646d, // 摭
+	0x155d: 0x6496, // 撖
+	0x1560: 0x647a, // 摺
+	0x1561: 0x64b7, // 撷
+	0x1562: 0x64b8, // 撸
+	0x1563: 0x6499, // 撙
+	0x1564: 0x64ba, // 撺
+	0x1565: 0x64c0, // 擀
+	0x1566: 0x64d0, // 擐
+	0x1567: 0x64d7, // 擗
+	0x1568: 0x64e4, // 擤
+	0x1569: 0x64e2, // 擢
+	0x156a: 0x6509, // 攉
+	0x156b: 0x6525, // 攥
+	0x156c: 0x652e, // 攮
+	0x156d: 0x5f0b, // 弋
+	0x156e: 0x5fd2, // 忒
+	0x156f: 0x7519, // 甙
+	0x1570: 0x5f11, // 弑
+	0x1571: 0x535f, // 卟
+	0x1572: 0x53f1, // 叱
+	0x1573: 0x53fd, // 叽
+	0x1574: 0x53e9, // 叩
+	0x1575: 0x53e8, // 叨
+	0x1576: 0x53fb, // 叻
+	0x1577: 0x5412, // 吒
+	0x1578: 0x5416, // 吖
+	0x1579: 0x5406, // 吆
+	0x157a: 0x544b, // 呋
+	0x157b: 0x5452, // 呒
+	0x157c: 0x5453, // 呓
+	0x157d: 0x5454, // 呔
+	0x157e: 0x5456, // 呖
+	0x157f: 0x5443, // 呃
+	0x1580: 0x5421, // 吡
+	0x1581: 0x5457, // 呗
+	0x1582: 0x5459, // 呙
+	0x1583: 0x5423, // 吣
+	0x1584: 0x5432, // 吲
+	0x1585: 0x5482, // 咂
+	0x1586: 0x5494, // 咔
+	0x1587: 0x5477, // 呷
+	0x1588: 0x5471, // 呱
+	0x1589: 0x5464, // 呤
+	0x158a: 0x549a, // 咚
+	0x158b: 0x549b, // 咛
+	0x158c: 0x5484, // 咄
+	0x158d: 0x5476, // 呶
+	0x158e: 0x5466, // 呦
+	0x158f: 0x549d, // 咝
+	0x1590: 0x54d0, // 哐
+	0x1591: 0x54ad, // 咭
+	0x1592: 0x54c2, // 哂
+	0x1593: 0x54b4, // 咴
+	0x1594: 0x54d2, // 哒
+	0x1595: 0x54a7, // 咧
+	0x1596: 0x54a6, // 咦
+	0x1597: 0x54d3, // 哓
+	0x1598: 0x54d4, // 哔
+	0x1599: 0x5472, // 呲
+	0x159a: 0x54a3, // 咣
+	0x159b: 0x54d5, // 哕
+	0x159c: 0x54bb, // 咻
+	0x159d: 0x54bf, // 咿
+	0x159e: 0x54cc, // 哌
+	0x159f: 0x54d9, // 哙
+	0x15a0: 0x54da, // 哚
+	0x15a1: 0x54dc, // 哜
+	0x15a2: 0x54a9, // 咩
+	0x15a3: 0x54aa, // 咪
+	0x15a4: 0x54a4, // 咤
+	0x15a5: 0x54dd, // 哝
+	0x15a6: 0x54cf, // 哏
+	0x15a7: 0x54de, // 哞
+	0x15a8: 0x551b, // 唛
+	0x15a9: 0x54e7, // 哧
+	0x15aa: 0x5520, // 唠
+	0x15ab: 0x54fd, // 哽
+	0x15ac: 0x5514, // 唔
+	0x15ad: 0x54f3, // 哳
+	0x15ae: 0x5522, // 唢
+	0x15af: 0x5523, // 唣
+	0x15b0: 0x550f, // 唏
+	0x15b1: 0x5511, // 唑
+	0x15b2: 0x5527, // 唧
+	0x15b3: 0x552a, // 唪
+	0x15b4: 0x5567, // 啧
+	0x15b5: 0x558f, // 喏
+	0x15b6: 0x55b5, // 喵
+	0x15b7: 0x5549, // 啉
+	0x15b8: 0x556d, // 啭
+	0x15b9: 0x5541, // 啁
+	0x15ba: 0x5555, // 啕
+	0x15bb: 0x553f, // 唿
+	0x15bc: 0x5550, // 啐
+	0x15bd: 0x553c, // 唼
+	0x15c0: 0x5537, // 唷
+	0x15c1: 0x5556, // 啖
+	0x15c2: 0x5575, // 啵
+	0x15c3: 0x5576, // 啶
+	0x15c4: 0x5577, // 啷
+	0x15c5: 0x5533, // 唳
+	0x15c6: 0x5530, // 唰
+	0x15c7: 0x555c, // 啜
+	0x15c8: 0x558b, // 喋
+	0x15c9: 0x55d2, // 嗒
+	0x15ca: 0x5583, // 喃
+	0x15cb: 0x55b1, // 喱
+	0x15cc: 0x55b9, // 喹
+	0x15cd: 0x5588, // 喈
+	0x15ce: 0x5581, // 喁
+	0x15cf: 0x559f, // 喟
+	0x15d0: 0x557e, // 啾
+	0x15d1: 0x55d6, // 嗖
+	0x15d2: 0x5591, // 喑
+	0x15d3: 0x557b, // 啻
+	0x15d4: 0x55df, // 嗟
+	0x15d5: 0x55bd, // 喽
+	0x15d6: 0x55be, // 喾
+	0x15d7: 0x5594, // 喔
+	0x15d8: 0x5599, // 喙
+	0x15d9: 0x55ea, // 嗪
+	0x15da: 0x55f7, // 嗷
+	0x15db: 0x55c9, // 嗉
+	0x15dc: 0x561f, // 嘟
+	0x15dd: 0x55d1, // 嗑
+	0x15de: 0x55eb, // 嗫
+	0x15df: 0x55ec, // 嗬
+	0x15e0: 0x55d4, // 嗔
+	0x15e1: 0x55e6, // 嗦
+	0x15e2: 0x55dd, // 嗝
+	0x15e3: 0x55c4, // 嗄
+	0x15e4: 0x55ef, // 嗯
+	0x15e5: 0x55e5, // 嗥
+	0x15e6: 0x55f2, // 嗲
+	0x15e7: 0x55f3, // 嗳
+	0x15e8: 0x55cc, // 嗌
+	0x15e9: 0x55cd, // 嗍
+	0x15ea: 0x55e8, // 嗨
+	0x15eb: 0x55f5, // 嗵
+	0x15ec: 0x55e4, // 嗤
+	0x15ed: 0x8f94, // 辔
+	0x15ee: 0x561e, // 嘞
+	0x15ef: 0x5608, // 嘈
+	0x15f0: 0x560c, // 嘌
+	0x15f1: 0x5601, // 嘁
+	0x15f2: 0x5624, // 嘤
+	0x15f3: 0x5623, // 嘣
+	0x15f4: 0x55fe, // 嗾
+	0x15f5: 0x5600, // 嘀
+	0x15f6: 0x5627, // 嘧
+	0x15f7: 0x562d, // 嘭
+	0x15f8: 0x5658, // 噘
+	0x15f9: 0x5639, // 嘹
+	0x15fa: 0x5657, // 噗
+	0x15fb: 0x562c, // 嘬
+	0x15fc: 0x564d, // 噍
+	0x15fd: 0x5662, // 噢
+	0x15fe: 0x5659, // 噙
+	0x15ff: 0x565c, // 噜
+	0x1600: 0x564c, // 噌
+	0x1601: 0x5654, // 噔
+	0x1602: 0x5686, // 嚆
+	0x1603: 0x5664, // 噤
+	0x1604: 0x5671, // 噱
+	0x1605: 0x566b, // 噫
+	0x1606: 0x567b, // 噻
+	0x1607: 0x567c, // 噼
+	0x1608: 0x5685, // 嚅
+	0x1609: 0x5693, // 嚓
+	0x160a: 0x56af, // 嚯
+	0x160b: 0x56d4, // 囔
+	0x160c: 0x56d7, // 囗
+	0x160d: 0x56dd, // 囝
+	0x160e: 0x56e1, // 囡
+	0x160f: 0x56f5, // 囵
+	0x1610: 0x56eb, // 囫
+	0x1611: 0x56f9, // 囹
+	0x1612: 0x56ff, // 囿
+	0x1613: 0x5704, // 圄
+	0x1614: 0x570a, // 圊
+	0x1615: 0x5709, // 圉
+	0x1616: 0x571c, // 圜
+	0x1617: 0x5e0f, // 帏
+	0x1618: 0x5e19, // 帙
+	0x1619: 0x5e14, // 帔
+	0x161a: 0x5e11, // 帑
+	0x161b: 0x5e31, // 帱
+	0x161c: 0x5e3b, // 帻
+	0x161d: 0x5e3c, // 帼
+	0x1620: 0x5e37, // 帷
+	0x1621: 0x5e44, // 幄
+	0x1622: 0x5e54, // 幔
+	0x1623: 0x5e5b, // 幛
+	0x1624: 0x5e5e, // 幞
+	0x1625: 0x5e61, // 幡
+	0x1626: 0x5c8c, // 岌
+	0x1627: 0x5c7a, // 屺
+	0x1628: 0x5c8d, // 岍
+	0x1629: 0x5c90, // 岐
+	0x162a: 0x5c96, // 岖
+	0x162b: 0x5c88, // 岈
+	0x162c: 0x5c98, // 岘
+	0x162d: 0x5c99, // 岙
+	0x162e: 0x5c91, // 岑
+	0x162f: 0x5c9a, // 岚
+	0x1630: 0x5c9c, // 岜
+	0x1631: 0x5cb5, // 岵
+	0x1632: 0x5ca2, // 岢
+	0x1633: 0x5cbd, // 岽
+	0x1634: 0x5cac, // 岬
+	0x1635: 0x5cab, // 岫
+	0x1636: 0x5cb1, // 岱
+	0x1637: 0x5ca3, // 岣
+	0x1638: 0x5cc1, // 峁
+	0x1639: 0x5cb7, // 岷
+	0x163a: 0x5cc4, // 峄
+	0x163b: 0x5cd2, // 峒
+	0x163c: 0x5ce4, // 峤
+	0x163d: 0x5ccb, // 峋
+	0x163e: 0x5ce5, // 峥
+	0x163f: 0x5d02, // 崂
+	0x1640: 0x5d03, // 崃
+	0x1641: 0x5d27, // 崧
+	0x1642: 0x5d26, // 崦
+	0x1643: 0x5d2e, // 崮
+	0x1644: 0x5d24, // 崤
+	0x1645: 0x5d1e, // 崞
+	0x1646: 0x5d06, // 崆
+	0x1647: 0x5d1b, // 崛
+	0x1648: 0x5d58, // 嵘
+	0x1649: 0x5d3e, // 崾
+	0x164a: 0x5d34, // 崴
+	0x164b: 0x5d3d, // 崽
+	0x164c: 0x5d6c, // 嵬
+	0x164d: 0x5d5b, // 嵛
+	0x164e: 0x5d6f, // 嵯
+	0x164f: 0x5d5d, // 嵝
+	0x1650: 0x5d6b, // 嵫
+	0x1651: 0x5d4b, // 嵋
+	0x1652: 0x5d4a, // 嵊
+	0x1653: 0x5d69, // 嵩
+	0x1654: 0x5d74, // 嵴
+	0x1655: 0x5d82, // 嶂
+	0x1656: 0x5d99, // 嶙
+	0x1657: 0x5d9d, // 嶝
+	0x1658: 0x8c73, // 豳
+	0x1659: 0x5db7, // 嶷
+	0x165a: 0x5dc5, // 巅
+	0x165b: 0x5f73, // 彳
+	0x165c: 0x5f77, // 彷
+	0x165d: 0x5f82, // 徂
+	0x165e: 0x5f87, // 徇
+	0x165f: 0x5f89, // 徉
+	0x1660: 0x5f8c, // 後
+	0x1661: 0x5f95, // 徕
+	0x1662: 0x5f99, // 徙
+	0x1663: 0x5f9c, // 徜
+	0x1664: 0x5fa8, // 徨
+	0x1665: 0x5fad, // 徭
+	0x1666: 0x5fb5, // 徵
+	0x1667: 0x5fbc, // 徼
+	0x1668: 0x8862, // 衢
+	0x1669: 0x5f61, // 彡
+	0x166a: 0x72ad, // 犭
+	0x166b: 0x72b0, // 犰
+	0x166c: 0x72b4, // 犴
+	0x166d: 0x72b7, // 犷
+	0x166e: 0x72b8, // 犸
+	0x166f: 0x72c3, // 狃
+	0x1670: 0x72c1, // 狁
+	0x1671: 0x72ce, // 狎
+	0x1672: 0x72cd, // 狍
+	0x1673: 0x72d2, // 狒
+	0x1674: 0x72e8, // 狨
+	0x1675: 0x72ef, // 狯
+	0x1676: 0x72e9, // 狩
+	0x1677: 0x72f2, // 狲
+	0x1678: 0x72f4, // 狴
+	0x1679: 0x72f7, // 狷
+	0x167a: 0x7301, // 猁
+	0x167b: 0x72f3, // 狳
+	0x167c: 0x7303, // 猃
+	0x167d: 0x72fa, // 狺
+	0x1680: 0x72fb, // 狻
+	0x1681: 0x7317, // 猗
+	0x1682: 0x7313, // 猓
+	0x1683: 0x7321, // 猡
+	0x1684: 0x730a, // 猊
+	0x1685: 0x731e, // 猞
+	0x1686: 0x731d, // 猝
+	0x1687: 0x7315, // 猕
+	0x1688: 0x7322, // 猢
+	0x1689: 0x7339, // 猹
+	0x168a: 0x7325, // 猥
+	0x168b: 0x732c, // 猬
+	0x168c: 0x7338, // 猸
+	0x168d: 0x7331, // 猱
+	0x168e: 0x7350, // 獐
+	0x168f: 0x734d, // 獍
+	0x1690: 0x7357, // 獗
+	0x1691: 0x7360, // 獠
+	0x1692: 0x736c, // 獬
+	0x1693: 0x736f, // 獯
+	0x1694: 0x737e, // 獾
+	0x1695: 0x821b, // 舛
+	0x1696: 0x5925, // 夥
+	0x1697: 0x98e7, // 飧
+	0x1698: 0x5924, // 夤
+	0x1699: 0x5902, // 夂
+	0x169a: 0x9963, // 饣
+	0x169b: 0x9967, // 饧
+	0x169c: 0x9968, // 饨
+	0x169d: 0x9969, // 饩
+	0x169e: 0x996a, // 饪
+	0x169f: 0x996b, // 饫
+	0x16a0: 0x996c, // 饬
+	0x16a1: 0x9974, // 饴
+	0x16a2: 0x9977, // 饷
+	0x16a3: 0x997d, // 饽
+	0x16a4: 0x9980, // 馀
+	0x16a5: 0x9984, // 馄
+	0x16a6: 0x9987, // 馇
+	0x16a7: 0x998a, // 馊
+	0x16a8: 0x998d, // 馍
+	0x16a9: 0x9990, // 馐
+	0x16aa: 0x9991, // 馑
+	0x16ab: 0x9993, // 馓
+	0x16ac: 0x9994, // 馔
+	0x16ad: 0x9995, // 馕
+	0x16ae: 0x5e80, // 庀
+	0x16af: 0x5e91, // 庑
+	0x16b0: 0x5e8b, // 庋
+	0x16b1: 0x5e96, // 庖
+	0x16b2: 0x5ea5, // 庥
+	0x16b3: 0x5ea0, // 庠
+	0x16b4: 0x5eb9, // 庹
+	0x16b5: 0x5eb5, // 庵
+	0x16b6: 0x5ebe, // 庾
+	0x16b7: 0x5eb3, // 庳
+	0x16b8: 0x8d53, // 赓
+	0x16b9: 0x5ed2, // 廒
+	0x16ba: 0x5ed1, // 廑
+	0x16bb: 0x5edb, // 廛
+	0x16bc: 0x5ee8, // 廨
+	0x16bd: 0x5eea, // 廪
+	0x16be: 0x81ba, // 膺
+	0x16bf: 0x5fc4, // 忄
+	0x16c0: 0x5fc9, // 忉
+	0x16c1: 0x5fd6, // 忖
+	0x16c2: 0x5fcf, // 忏
+	0x16c3: 0x6003, // 怃
+	0x16c4: 0x5fee, // 忮
+	0x16c5: 0x6004, // 怄
+	0x16c6: 0x5fe1, // 忡
+	0x16c7: 0x5fe4, // 忤
+	0x16c8: 0x5ffe, // 忾
+	0x16c9: 0x6005, // 怅
+	0x16ca: 0x6006, // 怆
+	0x16cb: 0x5fea, // 忪
+	0x16cc: 0x5fed, // 忭
+	0x16cd: 0x5ff8, // 忸
+	0x16ce: 0x6019, // 怙
+	0x16cf: 0x6035, // 怵
+	0x16d0: 0x6026, // 怦
+	0x16d1: 0x601b, // 怛
+	0x16d2: 0x600f, // 怏
+	0x16d3: 0x600d, // 怍
+	0x16d4: 0x6029, // 怩
+	0x16d5: 0x602b, // 怫
+	0x16d6: 0x600a, // 怊
+	0x16d7: 0x603f, // 怿
+	0x16d8: 0x6021, // 怡
+	0x16d9: 0x6078, // 恸
+	0x16da: 0x6079, // 恹
+	0x16db: 0x607b, // 恻
+	0x16dc: 0x607a, // 恺
+	0x16dd: 0x6042, // 恂
+	0x16e0: 0x606a, // 恪
+	0x16e1: 0x607d, // 恽
+	0x16e2: 0x6096, // 悖
+	0x16e3: 0x609a, // 悚
+	0x16e4: 0x60ad, // 悭
+	0x16e5: 0x609d, // 悝
+	0x16e6: 0x6083, // 悃
+	0x16e7: 0x6092, // 悒
+	0x16e8: 0x608c, // 悌
+	0x16e9: 0x609b, // 悛
+	0x16ea: 0x60ec, // 惬
+	0x16eb: 0x60bb, // 悻
+	0x16ec: 0x60b1, // 悱
+	0x16ed: 0x60dd, // 惝
+	0x16ee: 0x60d8, // 惘
+	0x16ef: 0x60c6, // 惆
+	0x16f0: 0x60da, // 惚
+	0x16f1: 0x60b4, // 悴
+	0x16f2: 0x6120, // 愠
+	0x16f3: 0x6126, // 愦
+	0x16f4: 0x6115, // 愕
+	0x16f5: 0x6123, // 愣
+	0x16f6: 0x60f4, // 惴
+	0x16f7: 0x6100, // 愀
+	0x16f8: 0x610e, // 愎
+	0x16f9: 0x612b, // 愫
+	0x16fa: 0x614a, // 慊
+	0x16fb: 0x6175, // 慵
+	0x16fc: 0x61ac, // 憬
+	0x16fd: 0x6194, // 憔
+	0x16fe: 0x61a7, // 憧
+	0x16ff: 0x61b7, // 憷
+	0x1700: 0x61d4, // 懔
+	0x1701: 0x61f5, // 懵
+	0x1702: 0x5fdd, // 忝
+	0x1703: 0x96b3, // 隳
+	0x1704: 0x95e9, // 闩
+	0x1705: 0x95eb, // 闫
+	0x1706: 0x95f1, // 闱
+	0x1707: 0x95f3, // 闳
+	0x1708: 0x95f5, // 闵
+	0x1709: 0x95f6, // 闶
+	0x170a: 0x95fc, // 闼
+	0x170b: 0x95fe, // 闾
+	0x170c: 0x9603, // 阃
+	0x170d: 0x9604, // 阄
+	0x170e: 0x9606, // 阆
+	0x170f: 0x9608, // 阈
+	0x1710: 0x960a, // 阊
+	0x1711: 0x960b, // 阋
+	0x1712: 0x960c, // 阌
+	0x1713: 0x960d, // 阍
+	0x1714: 0x960f, // 阏
+	0x1715: 0x9612, // 阒
+	0x1716: 0x9615, // 阕
+	0x1717: 0x9616, // 阖
+	0x1718: 0x9617, // 阗
+	0x1719: 0x9619, // 阙
+	0x171a: 0x961a, // 阚
+	0x171b: 0x4e2c, // 丬
+	0x171c: 0x723f, // 爿
+	0x171d: 0x6215, // 戕
+	0x171e: 0x6c35, // 氵
+	0x171f: 0x6c54, // 汔
+	0x1720: 0x6c5c, // 汜
+	0x1721: 0x6c4a, // 汊
+	0x1722: 0x6ca3, // 沣
+	0x1723: 0x6c85, // 沅
+	0x1724: 0x6c90, // 沐
+	0x1725: 0x6c94, // 沔
+	0x1726: 0x6c8c, // 沌
+	0x1727: 0x6c68, // 汨
+	0x1728: 0x6c69, // 汩
+	0x1729: 0x6c74, // 汴
+	0x172a: 0x6c76, // 汶
+	0x172b: 0x6c86, // 沆
+	0x172c: 0x6ca9, // 沩
+	0x172d: 0x6cd0, // 泐
+	0x172e: 0x6cd4, // 泔
+	0x172f: 0x6cad, // 沭
+	0x1730: 0x6cf7, // 泷
+	0x1731: 0x6cf8, // 泸
+	0x1732: 0x6cf1, // 泱
+	0x1733: 0x6cd7, // 泗
+	0x1734: 0x6cb2, // 沲
+	0x1735: 0x6ce0, // 泠
+	0x1736: 0x6cd6, // 泖
+	0x1737: 0x6cfa, // 泺
+	0x1738: 0x6ceb, // 泫
+	0x1739: 0x6cee, // 泮
+	0x173a: 0x6cb1, // 沱
+	0x173b: 0x6cd3, // 泓
+	0x173c: 0x6cef, // 泯
+	0x173d: 0x6cfe, // 泾
+	0x1740: 0x6d39, // 洹
+	0x1741: 0x6d27, // 洧
+	0x1742: 0x6d0c, // 洌
+	0x1743: 0x6d43, // 浃
+	0x1744: 0x6d48, // 浈
+	0x1745: 0x6d07, // 洇
+	0x1746: 0x6d04, // 洄
+	0x1747: 0x6d19, // 洙
+	0x1748: 0x6d0e, // 洎
+	0x1749: 0x6d2b, // 洫
+	0x174a: 0x6d4d, // 浍
+	0x174b: 0x6d2e, // 洮
+	0x174c: 0x6d35, // 洵
+	0x174d: 0x6d1a, // 洚
+	0x174e: 0x6d4f, // 浏
+	0x174f: 0x6d52, // 浒
+	0x1750: 0x6d54, // 浔
+	0x1751: 0x6d33, // 洳
+	0x1752: 0x6d91, // 涑
+	0x1753: 0x6d6f, // 浯
+	0x1754: 0x6d9e, // 涞
+	0x1755: 0x6da0, // 涠
+	0x1756: 0x6d5e, // 浞
+	0x1757: 0x6d93, // 涓
+	0x1758: 0x6d94, // 涔
+	0x1759: 0x6d5c, // 浜
+	0x175a: 0x6d60, // 浠
+	0x175b: 0x6d7c, // 浼
+	0x175c: 0x6d63, // 浣
+	0x175d: 0x6e1a, // 渚
+	0x175e: 0x6dc7, // 淇
+	0x175f: 0x6dc5, // 淅
+	0x1760: 0x6dde, // 淞
+	0x1761: 0x6e0e, // 渎
+	0x1762: 0x6dbf, // 涿
+	0x1763: 0x6de0, // 淠
+	0x1764: 0x6e11, // 渑
+	0x1765: 0x6de6, // 淦
+	0x1766: 0x6ddd, // 淝
+	0x1767: 0x6dd9, // 淙
+	0x1768: 0x6e16, // 渖
+	0x1769: 0x6dab, // 涫
+	0x176a: 0x6e0c, // 渌
+	0x176b: 0x6dae, // 涮
+	0x176c: 0x6e2b, // 渫
+	0x176d: 0x6e6e, // 湮
+	0x176e: 0x6e4e, // 湎
+	0x176f: 0x6e6b, // 湫
+	0x1770: 0x6eb2, // 溲
+	0x1771: 0x6e5f, // 湟
+	0x1772: 0x6e86, // 溆
+	0x1773: 0x6e53, // 湓
+	0x1774: 0x6e54, // 湔
+	0x1775: 0x6e32, // 渲
+	0x1776: 0x6e25, // 渥
+	0x1777: 0x6e44, // 湄
+	0x1778: 0x6edf, // 滟
+	0x1779: 0x6eb1, // 溱
+	0x177a: 0x6e98, // 溘
+	0x177b: 0x6ee0, // 滠
+	0x177c: 0x6f2d, // 漭
+	0x177d: 0x6ee2, // 滢
+	0x177e: 0x6ea5, // 溥
+	0x177f: 0x6ea7, // 溧
+	0x1780: 0x6ebd, // 溽
+	0x1781: 0x6ebb, // 溻
+	0x1782: 0x6eb7, // 溷
+	0x1783: 0x6ed7, // 滗
+	0x1784: 0x6eb4, // 溴
+	0x1785: 0x6ecf, // 滏
+	0x1786: 0x6e8f, // 溏
+	0x1787: 0x6ec2, // 滂
+	0x1788: 0x6e9f, // 溟
+	0x1789: 0x6f62, // 潢
+	0x178a: 0x6f46, // 潆
+	0x178b: 0x6f47, // 潇
+	0x178c: 0x6f24, // 漤
+	0x178d: 0x6f15, // 漕
+	0x178e: 0x6ef9, // 滹
+	0x178f: 0x6f2f, // 漯
+	0x1790: 0x6f36, // 漶
+	0x1791: 0x6f4b, // 潋
+	0x1792: 0x6f74, // 潴
+	0x1793: 0x6f2a, // 漪
+	0x1794: 0x6f09, // 漉
+	0x1795: 0x6f29, // 漩
+	0x1796: 0x6f89, // 澉
+	0x1797: 0x6f8d, // 澍
+	0x1798: 0x6f8c, // 澌
+	0x1799: 0x6f78, // 潸
+	0x179a: 0x6f72, // 潲
+	0x179b: 0x6f7c, // 潼
+	0x179c: 0x6f7a, // 潺
+	0x179d: 0x6fd1, // 濑
+	0x17a0: 0x6fc9, // 濉
+	0x17a1: 0x6fa7, // 澧
+	0x17a2: 0x6fb9, // 澹
+	0x17a3: 0x6fb6, // 澶
+	0x17a4: 0x6fc2, // 濂
+	0x17a5: 0x6fe1, // 濡
+	0x17a6: 0x6fee, // 濮
+	0x17a7: 0x6fde, // 濞
+	0x17a8: 0x6fe0, // 濠
+	0x17a9: 0x6fef, // 濯
+	0x17aa: 0x701a, // 瀚
+	0x17ab: 0x7023, // 瀣
+	0x17ac: 0x701b, // 瀛
+	0x17ad: 0x7039, // 瀹
+	0x17ae: 0x7035, // 瀵
+	0x17af: 0x704f, // 灏
+	0x17b0: 0x705e, // 灞
+	0x17b1: 0x5b80, // 宀
+	0x17b2: 0x5b84, // 宄
+	0x17b3: 0x5b95, // 宕
+	0x17b4: 0x5b93, // 宓
+	0x17b5: 0x5ba5, // 宥
+	0x17b6: 0x5bb8, // 宸
+	0x17b7: 0x752f, // 甯
+	0x17b8: 0x9a9e, // 骞
+	0x17b9: 0x6434, // 搴
+	0x17ba: 0x5be4, // 寤
+	0x17bb: 0x5bee, // 寮
+	0x17bc: 0x8930, // 褰
+	0x17bd: 0x5bf0, // 寰
+	0x17be: 0x8e47, // 蹇
+	0x17bf: 0x8b07, // 謇
+	0x17c0: 0x8fb6, // 辶
+	0x17c1: 0x8fd3, // 迓
+	0x17c2: 0x8fd5, // 迕
+	0x17c3: 0x8fe5, // 迥
+	0x17c4: 0x8fee, // 迮
+	0x17c5: 0x8fe4, // 迤
+	0x17c6: 0x8fe9, // 迩
+	0x17c7: 0x8fe6, // 迦
+	0x17c8: 0x8ff3, // 迳
+	0x17c9: 0x8fe8, // 迨
+	0x17ca: 0x9005, // 逅
+	0x17cb: 0x9004, // 逄
+	0x17cc: 0x900b, // 逋
+	0x17cd: 0x9026, // 逦
+	0x17ce: 0x9011, // 逑
+	0x17cf: 0x900d, // 逍
+	0x17d0: 0x9016, // 逖
+	0x17d1: 0x9021, // 逡
+	0x17d2: 0x9035, // 逵
+	0x17d3: 0x9036, // 逶
+	0x17d4: 0x902d, // 逭
+	0x17d5: 0x902f, // 逯
+	0x17d6: 0x9044, // 遄
+	0x17d7: 0x9051, // 遑
+	0x17d8: 0x9052, // 遒
+	0x17d9: 0x9050, // 遐
+	0x17da: 0x9068, // 遨
+	0x17db: 0x9058, // 遘
+	0x17dc: 0x9062, // 遢
+	0x17dd: 0x905b, // 遛
+	0x17de: 0x66b9, // 暹
+	0x17df: 0x9074, // 遴
+	0x17e0: 0x907d, // 遽
+	0x17e1: 0x9082, // 邂
+	0x17e2: 0x9088, // 邈
+	0x17e3: 0x9083, // 邃
+	0x17e4: 0x908b, // 邋
+	0x17e5: 0x5f50, // 彐
+	0x17e6: 0x5f57, // 彗
+	0x17e7: 0x5f56, // 彖
+	0x17e8: 0x5f58, // 彘
+	0x17e9: 0x5c3b, // 尻
+	0x17ea: 0x54ab, // 咫
+	0x17eb: 0x5c50, // 屐
+	0x17ec: 0x5c59, // 屙
+	0x17ed: 0x5b71, // 孱
+	0x17ee: 0x5c63, // 屣
+	0x17ef: 0x5c66, // 屦
+	0x17f0: 0x7fbc, // 羼
+	0x17f1: 0x5f2a, // 弪
+	0x17f2: 0x5f29, // 弩
+	0x17f3: 0x5f2d, // 弭
+	0x17f4: 0x8274, // 艴
+	0x17f5: 0x5f3c, // 弼
+	0x17f6: 0x9b3b, // 鬻
+	0x17f7: 0x5c6e, // 屮
+	0x17f8: 0x5981, // 妁
+	0x17f9: 0x5983, // 妃
+	0x17fa: 0x598d, // 妍
+	0x17fb: 0x59a9, // 妩
+	0x17fc: 0x59aa, // 妪
+	0x17fd: 0x59a3, // 妣
+	0x1800: 0x5997, // 妗
+	0x1801: 0x59ca, // 姊
+	0x1802: 0x59ab, // 妫
+	0x1803: 0x599e, // 妞
+	0x1804: 0x59a4, // 妤
+	0x1805: 0x59d2, // 姒
+	0x1806: 0x59b2, // 妲
+	0x1807: 0x59af, // 妯
+	0x1808: 0x59d7, // 姗
+	0x1809: 0x59be, // 妾
+	0x180a: 0x5a05, // 娅
+	0x180b: 0x5a06, // 娆
+	0x180c: 0x59dd, // 姝
+	0x180d: 0x5a08, // 娈
+	0x180e: 0x59e3, // 姣
+	0x180f: 0x59d8, // 姘
+	0x1810: 0x59f9, // 姹
+	0x1811: 0x5a0c, // 娌
+	0x1812: 0x5a09, // 娉
+	0x1813: 0x5a32, // 娲
+	0x1814: 0x5a34, // 娴
+	0x1815: 0x5a11, // 娑
+	0x1816: 0x5a23, // 娣
+	0x1817: 0x5a13, // 娓
+	0x1818: 0x5a40, // 婀
+	0x1819: 0x5a67, // 婧
+	0x181a: 0x5a4a, // 婊
+	0x181b: 0x5a55, // 婕
+	0x181c: 0x5a3c, // 娼
+	0x181d: 0x5a62, // 婢
+	0x181e: 0x5a75, // 婵
+	0x181f: 0x80ec, // 胬
+	0x1820: 0x5aaa, // 媪
+	0x1821: 0x5a9b, // 媛
+	0x1822: 0x5a77, // 婷
+	0x1823: 0x5a7a, // 婺
+	0x1824: 0x5abe, // 媾
+	0x1825: 0x5aeb, // 嫫
+	0x1826: 0x5ab2, // 媲
+	0x1827: 0x5ad2, // 嫒
+	0x1828: 0x5ad4, // 嫔
+	0x1829: 0x5ab8, // 媸
+	0x182a: 0x5ae0, // 嫠
+	0x182b: 0x5ae3, // 嫣
+	0x182c: 0x5af1, // 嫱
+	0x182d: 0x5ad6, // 嫖
+	0x182e: 0x5ae6, // 嫦
+	0x182f: 0x5ad8, // 嫘
+	0x1830: 0x5adc, // 嫜
+	0x1831: 0x5b09, // 嬉
+	0x1832: 0x5b17, // 嬗
+	0x1833: 0x5b16, // 嬖
+	0x1834: 0x5b32, // 嬲
+	0x1835: 0x5b37, // 嬷
+	0x1836: 0x5b40, // 孀
+	0x1837: 0x5c15, // 尕
+	0x1838: 0x5c1c, // 尜
+	0x1839: 0x5b5a, // 孚
+	0x183a: 0x5b65, // 孥
+	0x183b: 0x5b73, // 孳
+	0x183c: 0x5b51, // 孑
+	0x183d: 0x5b53, // 孓
+	0x183e: 0x5b62, // 孢
+	0x183f: 0x9a75, // 驵
+	0x1840: 0x9a77, // 驷
+	0x1841: 0x9a78, // 驸
+	0x1842: 0x9a7a, // 驺
+	0x1843: 0x9a7f, // 驿
+	0x1844: 0x9a7d, // 驽
+	0x1845: 0x9a80, // 骀
+	0x1846: 0x9a81, // 骁
+	0x1847: 0x9a85, // 骅
+	0x1848: 0x9a88, // 骈
+	0x1849: 0x9a8a, // 骊
+	0x184a: 0x9a90, // 骐
+	0x184b: 0x9a92, // 骒
+	0x184c: 0x9a93, // 骓
+	0x184d: 0x9a96, // 骖
+	0x184e: 0x9a98, // 骘
+	0x184f: 0x9a9b, // 骛
+	0x1850: 0x9a9c, // 骜
+	0x1851: 0x9a9d, // 骝
+	0x1852: 0x9a9f, // 骟
+	0x1853: 0x9aa0, // 骠
+	0x1854: 0x9aa2, // 骢
+	0x1855: 0x9aa3, // 骣
+	0x1856: 0x9aa5, // 骥
+	0x1857: 0x9aa7, // 骧
+	0x1858: 0x7e9f, // 纟
+	0x1859: 0x7ea1, // 纡
+	0x185a: 0x7ea3, // 纣
+	0x185b: 0x7ea5, // 纥
+	0x185c: 0x7ea8, // 纨
+	0x185d: 0x7ea9, // 纩
+	0x1860: 0x7ead, // 纭
+	0x1861: 0x7eb0, // 纰
+	0x1862: 0x7ebe, // 纾
+	0x1863: 0x7ec0, // 绀
+	0x1864: 0x7ec1, // 绁
+	0x1865: 0x7ec2, // 绂
+	0x1866: 0x7ec9, // 绉
+	0x1867: 0x7ecb, // 绋
+	0x1868: 0x7ecc, // 绌
+	0x1869: 0x7ed0, // 绐
+	0x186a: 0x7ed4, // 绔
+	0x186b: 0x7ed7, // 绗
+	0x186c: 0x7edb, // 绛
+	0x186d: 0x7ee0, // 绠
+	0x186e: 0x7ee1, // 绡
+	0x186f: 0x7ee8, // 绨
+	0x1870: 0x7eeb, // 绫
+	0x1871: 0x7eee, // 绮
+	0x1872: 0x7eef, // 绯
+	0x1873: 0x7ef1, // 绱
+	0x1874: 0x7ef2, // 绲
+	0x1875: 0x7f0d, // 缍
+	0x1876: 0x7ef6, // 绶
+	0x1877: 0x7efa, // 绺
+	0x1878: 0x7efb, // 绻
+	0x1879: 0x7efe, // 绾
+	0x187a: 0x7f01, // 缁
+	0x187b: 0x7f02, // 缂
+	0x187c: 0x7f03, // 缃
+	0x187d: 0x7f07, // 缇
+	0x187e: 0x7f08, // 缈
+	0x187f: 0x7f0b, // 缋
+	0x1880: 0x7f0c, // 缌
+	0x1881: 0x7f0f, // 缏
+	0x1882: 0x7f11, // 缑
+	0x1883: 0x7f12, // 缒
+	0x1884: 0x7f17, // 缗
+	0x1885: 0x7f19, // 缙
+	0x1886: 0x7f1c, // 缜
+	0x1887: 0x7f1b, // 缛
+	0x1888: 0x7f1f, // 缟
+	0x1889: 0x7f21, // 缡
+	0x188a: 0x7f22, // 缢
+	0x188b: 0x7f23, // 缣
+	0x188c: 0x7f24, // 缤
+	0x188d: 0x7f25, // 缥
+	0x188e: 0x7f26, // 缦
+	0x188f: 0x7f27, // 缧
+	0x1890: 0x7f2a, // 缪
+	0x1891: 0x7f2b, // 缫
+	0x1892: 0x7f2c, // 缬
+	0x1893: 0x7f2d, // 缭
+	0x1894: 0x7f2f, // 缯
+	0x1895: 0x7f30, // 缰
+	0x1896: 0x7f31, // 缱
+	0x1897: 0x7f32, // 缲
+	0x1898: 0x7f33, // 缳
+	0x1899: 0x7f35, // 缵
+	0x189a: 0x5e7a, // 幺
+	0x189b: 0x757f, // 畿
+	0x189c: 0x5ddb, // 巛
+	0x189d: 0x753e, // 甾
+	0x189e: 0x9095, // 邕
+	0x189f: 0x738e, // 玎
+	0x18a0: 0x7391, // 玑
+	0x18a1: 0x73ae, // 玮
+	0x18a2: 0x73a2, // 玢
+	0x18a3: 0x739f, // 玟
+	0x18a4: 0x73cf, // 珏
+	0x18a5: 0x73c2, // 珂
+	0x18a6: 0x73d1, // 珑
+	0x18a7: 0x73b7, // 玷
+	0x18a8: 0x73b3, // 玳
+	0x18a9: 0x73c0, // 珀
+	0x18aa: 0x73c9, // 珉
+	0x18ab: 0x73c8, // 珈
+	0x18ac: 0x73e5, // 珥
+	0x18ad: 0x73d9, // 珙
+	0x18ae: 0x987c, // 顼
+	0x18af: 0x740a, // 琊
+	0x18b0: 0x73e9, // 珩
+	0x18b1: 0x73e7, // 珧
+	0x18b2: 0x73de, // 珞
+	0x18b3: 0x73ba, // 玺
+	0x18b4: 0x73f2, // 珲
+	0x18b5: 0x740f, // 琏
+	0x18b6: 0x742a, // 琪
+	0x18b7: 0x745b, // 瑛
+	0x18b8: 0x7426, // 琦
+	0x18b9: 0x7425, // 琥
+	0x18ba: 0x7428, // 琨
+	0x18bb: 0x7430, // 琰
+	0x18bc: 0x742e, // 琮
+	0x18bd: 0x742c, // 琬
+	0x18c0: 0x741b, // 琛
+	0x18c1: 0x741a, // 琚
+	0x18c2: 0x7441, // 瑁
+	0x18c3: 0x745c, // 瑜
+	0x18c4: 0x7457, // 瑗
+	0x18c5: 0x7455, // 瑕
+	0x18c6: 0x7459, // 瑙
+	0x18c7: 0x7477, // 瑷
+	0x18c8: 0x746d, // 瑭
+	0x18c9: 0x747e, // 瑾
+	0x18ca: 0x749c, // 璜
+	0x18cb: 0x748e, // 璎
+	0x18cc: 0x7480, // 璀
+	0x18cd: 0x7481, // 璁
+	0x18ce: 0x7487, // 璇
+	0x18cf: 0x748b, // 璋
+	0x18d0: 0x749e, // 璞
+	0x18d1: 0x74a8, // 璨
+	0x18d2: 0x74a9, // 璩
+	0x18d3: 0x7490, // 璐
+	0x18d4: 0x74a7, // 璧
+	0x18d5: 0x74d2, // 瓒
+	0x18d6: 0x74ba, // 璺
+	0x18d7: 0x97ea, // 韪
+	0x18d8: 0x97eb, // 韫
+	0x18d9: 0x97ec, // 韬
+	0x18da: 0x674c, // 杌
+	0x18db: 0x6753, // 杓
+	0x18dc: 0x675e, // 杞
+	0x18dd: 0x6748, // 杈
+	0x18de: 0x6769, // 杩
+	0x18df: 0x67a5, // 枥
+	0x18e0: 0x6787, // 枇
+	0x18e1: 0x676a, // 杪
+	0x18e2: 0x6773, // 杳
+	0x18e3: 0x6798, // 枘
+	0x18e4: 0x67a7, // 枧
+	0x18e5: 0x6775, // 杵
+	0x18e6: 0x67a8, // 枨
+	0x18e7: 0x679e, // 枞
+	0x18e8: 0x67ad, // 枭
+	0x18e9: 0x678b, // 枋
+	0x18ea: 0x6777, // 杷
+	0x18eb: 0x677c, // 杼
+	0x18ec: 0x67f0, // 柰
+	0x18ed: 0x6809, // 栉
+	0x18ee: 0x67d8, // 柘
+	0x18ef: 0x680a, // 栊
+	0x18f0: 0x67e9, // 柩
+	0x18f1: 0x67b0, // 枰
+	0x18f2: 0x680c, // 栌
+	0x18f3: 0x67d9, // 柙
+	0x18f4: 0x67b5, // 枵
+	0x18f5: 0x67da, // 柚
+	0x18f6: 0x67b3, // 枳
+	0x18f7: 0x67dd, // 柝
+	0x18f8: 0x6800, // 栀
+	0x18f9: 0x67c3, // 柃
+	0x18fa: 0x67b8, // 枸
+	0x18fb: 0x67e2, // 柢
+	0x18fc: 0x680e, // 栎
+	0x18fd: 0x67c1, // 柁
+	0x18fe: 0x67fd, // 柽
+	0x18ff: 0x6832, // 栲
+	0x1900: 0x6833, // 栳
+	0x1901: 0x6860, // 桠
+	0x1902: 0x6861, // 桡
+	0x1903: 0x684e, // 桎
+	0x1904: 0x6862, // 桢
+	0x1905: 0x6844, // 桄
+	0x1906: 0x6864, // 桤
+	0x1907: 0x6883, // 梃
+	0x1908: 0x681d, // 栝
+	0x1909: 0x6855, // 桕
+	0x190a: 0x6866, // 桦
+	0x190b: 0x6841, // 桁
+	0x190c: 0x6867, // 桧
+	0x190d: 0x6840, // 桀
+	0x190e: 0x683e, // 栾
+	0x190f: 0x684a, // 桊
+	0x1910: 0x6849, // 桉
+	0x1911: 0x6829, // 栩
+	0x1912: 0x68b5, // 梵
+	0x1913: 0x688f, // 梏
+	0x1914: 0x6874, // 桴
+	0x1915: 0x6877, // 桷
+	0x1916: 0x6893, // 梓
+	0x1917: 0x686b, // 桫
+	0x1918: 0x68c2, // 棂
+	0x1919: 0x696e, // 楮
+	0x191a: 0x68fc, // 棼
+	0x191b: 0x691f, // 椟
+	0x191c: 0x6920, // 椠
+	0x191d: 0x68f9, // 棹
+	0x1920: 0x6924, // 椤
+	0x1921: 0x68f0, // 棰
+	0x1922: 0x690b, // 椋
+	0x1923: 0x6901, // 椁
+	0x1924: 0x6957, // 楗
+	0x1925: 0x68e3, // 棣
+	0x1926: 0x6910, // 椐
+	0x1927: 0x6971, // 楱
+	0x1928: 0x6939, // 椹
+	0x1929: 0x6960, // 楠
+	0x192a: 0x6942, // 楂
+	0x192b: 0x695d, // 楝
+	0x192c: 0x6984, // 榄
+	0x192d: 0x696b, // 楫
+	0x192e: 0x6980, // 榀
+	0x192f: 0x6998, // 榘
+	0x1930: 0x6978, // 楸
+	0x1931: 0x6934, // 椴
+	0x1932: 0x69cc, // 槌
+	0x1933: 0x6987, // 榇
+	0x1934: 0x6988, // 榈
+	0x1935: 0x69ce, // 槎
+	0x1936: 0x6989, // 榉
+	0x1937: 0x6966, // 楦
+	0x1938: 0x6963, // 楣
+	0x1939: 0x6979, // 楹
+	0x193a: 0x699b, // 榛
+	0x193b: 0x69a7, // 榧
+	0x193c: 0x69bb, // 榻
+	0x193d: 0x69ab, // 榫
+	0x193e: 0x69ad, // 榭
+	0x193f: 0x69d4, // 槔
+	0x1940: 0x69b1, // 榱
+	0x1941: 0x69c1, // 槁
+	0x1942: 0x69ca, // 槊
+	0x1943: 0x69df, // 槟
+	0x1944: 0x6995, // 榕
+	0x1945: 0x69e0, // 槠
+	0x1946: 0x698d, // 榍
+	0x1947: 0x69ff, // 槿
+	0x1948: 0x6a2f, // 樯
+	0x1949: 0x69ed, // 槭
+	0x194a: 0x6a17, // 樗
+	0x194b: 0x6a18, // 樘
+	0x194c: 0x6a65, // 橥
+	0x194d: 0x69f2, // 槲
+	0x194e: 0x6a44, // 橄
+	0x194f: 0x6a3e, // 樾
+	0x1950: 0x6aa0, // 檠
+	0x1951: 0x6a50, // 橐
+	0x1952: 0x6a5b, // 橛
+	0x1953: 0x6a35, // 樵
+	0x1954: 0x6a8e, // 檎
+	0x1955: 0x6a79, // 橹
+	0x1956: 0x6a3d, // 樽
+	0x1957: 0x6a28, // 樨
+	0x1958: 0x6a58, // 橘
+	0x1959: 0x6a7c, // 橼
+	0x195a: 0x6a91, // 檑
+	0x195b: 0x6a90, // 檐
+	0x195c: 0x6aa9, // 檩
+	0x195d: 0x6a97, // 檗
+	0x195e: 0x6aab, // 檫
+	0x195f: 0x7337, // 猷
+	0x1960: 0x7352, // 獒
+	0x1961: 0x6b81, // 殁
+	0x1962: 0x6b82, // 殂
+	0x1963: 0x6b87, // 殇
+	0x1964: 0x6b84, // 殄
+	0x1965: 0x6b92, // 殒
+	0x1966: 0x6b93, // 殓
+	0x1967: 0x6b8d, // 殍
+	0x1968: 0x6b9a, // 殚
+	0x1969: 0x6b9b, // 殛
+	0x196a: 0x6ba1, // 殡
+	0x196b: 0x6baa, // 殪
+	0x196c: 0x8f6b, // 轫
+	0x196d: 0x8f6d, // 轭
+	0x196e: 0x8f71, // 轱
+	0x196f: 0x8f72, // 轲
+	0x1970: 0x8f73, // 轳
+	0x1971: 0x8f75, // 轵
+	0x1972: 0x8f76, // 轶
+	0x1973: 0x8f78, // 轸
+	0x1974: 0x8f77, // 轷
+	0x1975: 0x8f79, // 轹
+	0x1976: 0x8f7a, // 轺
+	0x1977: 0x8f7c, // 轼
+	0x1978: 0x8f7e, // 轾
+	0x1979: 0x8f81, // 辁
+	0x197a: 0x8f82, // 辂
+	0x197b: 0x8f84, // 辄
+	0x197c: 0x8f87, // 辇
+	0x197d: 0x8f8b, // 辋
+	0x1980: 0x8f8d, // 辍
+	0x1981: 0x8f8e, // 辎
+	0x1982: 0x8f8f, // 辏
+	0x1983: 0x8f98, // 辘
+	0x1984: 0x8f9a, // 辚
+	0x1985: 0x8ece, // 軎
+	0x1986: 0x620b, // 戋
+	0x1987: 0x6217, // 戗
+	0x1988: 0x621b, // 戛
+	0x1989: 0x621f, // 戟
+	0x198a: 0x6222, // 戢
+	0x198b: 0x6221, // 戡
+	0x198c: 0x6225, // 戥
+	0x198d: 0x6224, // 戤
+	0x198e: 0x622c, // 戬
+	0x198f: 0x81e7, // 臧
+	0x1990: 0x74ef, // 瓯
+	0x1991: 0x74f4, // 瓴
+	0x1992: 0x74ff, // 瓿
+	0x1993: 0x750f, // 甏
+	0x1994: 0x7511, // 甑
+	0x1995: 0x7513, // 甓
+	0x1996: 0x6534, // 攴
+	0x1997: 0x65ee, // 旮
+	0x1998: 0x65ef, // 旯
+	0x1999: 0x65f0, // 旰
+	0x199a: 0x660a, // 昊
+	0x199b: 0x6619, // 昙
+	0x199c: 0x6772, // 杲
+	0x199d: 0x6603, // 昃
+	0x199e: 0x6615, // 昕
+	0x199f: 0x6600, // 昀
+	0x19a0: 0x7085, // 炅
+	0x19a1: 0x66f7, // 曷
+	0x19a2: 0x661d, // 昝
+	0x19a3: 0x6634, // 昴
+	0x19a4: 0x6631, // 昱
+	0x19a5: 0x6636, // 昶
+	0x19a6: 0x6635, // 昵
+	0x19a7: 0x8006, // 耆
+	0x19a8: 0x665f, // 晟
+	0x19a9: 0x6654, // 晔
+	0x19aa: 0x6641, // 晁
+	0x19ab: 0x664f, // 晏
+	0x19ac: 0x6656, // 晖
+	0x19ad: 0x6661, // 晡
+	0x19ae: 0x6657, // 晗
+	0x19af: 0x6677, // 晷
+	0x19b0: 0x6684, // 暄
+	0x19b1: 0x668c, // 暌
+	0x19b2: 0x66a7, // 暧
+	0x19b3: 0x669d, // 暝
+	0x19b4: 0x66be, // 暾
+	0x19b5: 0x66db, // 曛
+	0x19b6: 0x66dc, // 曜
+	0x19b7: 0x66e6, // 曦
+	0x19b8: 0x66e9, // 曩
+	0x19b9: 0x8d32, // 贲
+	0x19ba: 0x8d33, // 贳
+	0x19bb: 0x8d36, // 贶
+	0x19bc: 0x8d3b, // 贻
+	0x19bd: 0x8d3d, // 贽
+	0x19be: 0x8d40, // 赀
+	0x19bf: 0x8d45, // 赅
+	0x19c0: 0x8d46, // 赆
+	0x19c1: 0x8d48, // 赈
+	0x19c2: 0x8d49, // 赉
+	0x19c3: 0x8d47, // 赇
+	0x19c4: 0x8d4d, // 赍
+	0x19c5: 0x8d55, // 赕
+	0x19c6: 0x8d59, // 赙
+	0x19c7: 0x89c7, // 觇
+	0x19c8: 0x89ca, // 觊
+	0x19c9: 0x89cb, // 觋
+	0x19ca: 0x89cc, // 觌
+	0x19cb: 0x89ce, // 觎
+	0x19cc: 0x89cf, // 觏
+	0x19cd: 0x89d0, // 觐
+	0x19ce: 0x89d1, // 觑
+	0x19cf: 0x726e, // 牮
+	0x19d0: 0x729f, // 犟
+	0x19d1: 0x725d, // 牝
+	0x19d2: 0x7266, // 牦
+	0x19d3: 0x726f, // 牯
+	0x19d4: 0x727e, // 牾
+	0x19d5: 0x727f, // 牿
+	0x19d6: 0x7284, // 犄
+	0x19d7: 0x728b, // 犋
+	0x19d8: 0x728d, // 犍
+	0x19d9: 0x728f, // 犏
+	0x19da: 0x7292, // 犒
+	0x19db: 0x6308, // 挈
+	0x19dc: 0x6332, // 挲
+	0x19dd: 0x63b0, // 掰
+	0x19e0: 0x643f, // 搿
+	0x19e1: 0x64d8, // 擘
+	0x19e2: 0x8004, // 耄
+	0x19e3: 0x6bea, // 毪
+	0x19e4: 0x6bf3, // 毳
+	0x19e5: 0x6bfd, // 毽
+	0x19e6: 0x6bf5, // 毵
+	0x19e7: 0x6bf9, // 毹
+	0x19e8: 0x6c05, // 氅
+	0x19e9: 0x6c07, // 氇
+	0x19ea: 0x6c06, // 氆
+	0x19eb: 0x6c0d, // 氍
+	0x19ec: 0x6c15, // 氕
+	0x19ed: 0x6c18, // 氘
+	0x19ee: 0x6c19, // 氙
+	0x19ef: 0x6c1a, // 氚
+	0x19f0: 0x6c21, // 氡
+	0x19f1: 0x6c29, // 氩
+	0x19f2: 0x6c24, // 氤
+	0x19f3: 0x6c2a, // 氪
+	0x19f4: 0x6c32, // 氲
+	0x19f5: 0x6535, // 攵
+	0x19f6: 0x6555, // 敕
+	0x19f7: 0x656b, // 敫
+	0x19f8: 0x724d, // 牍
+	0x19f9: 0x7252, // 牒
+	0x19fa: 0x7256, // 牖
+	0x19fb: 0x7230, // 爰
+	0x19fc: 0x8662, // 虢
+	0x19fd: 0x5216, // 刖
+	0x19fe: 0x809f, // 肟
+	0x19ff: 0x809c, // 肜
+	0x1a00: 0x8093, // 肓
+	0x1a01: 0x80bc, // 肼
+	0x1a02: 0x670a, // 朊
+	0x1a03: 0x80bd, // 肽
+	0x1a04: 0x80b1, // 肱
+	0x1a05: 0x80ab, // 肫
+	0x1a06: 0x80ad, // 肭
+	0x1a07: 0x80b4, // 肴
+	0x1a08: 0x80b7, // 肷
+	0x1a09: 0x80e7, // 胧
+	0x1a0a: 0x80e8, // 胨
+	0x1a0b: 0x80e9, // 胩
+	0x1a0c: 0x80ea, // 胪
+	0x1a0d: 0x80db, // 胛
+	0x1a0e: 0x80c2, // 胂
+	0x1a0f: 0x80c4, // 胄
+	0x1a10: 0x80d9, // 胙
+	0x1a11: 0x80cd, // 胍
+	0x1a12: 0x80d7, // 胗
+	0x1a13: 0x6710, // 朐
+	0x1a14: 0x80dd, // 胝
+	0x1a15: 0x80eb, // 胫
+	0x1a16: 0x80f1, // 胱
+	0x1a17: 0x80f4, // 胴
+	0x1a18: 0x80ed, // 胭
+	0x1a19: 0x810d, // 脍
+	0x1a1a: 0x810e, // 脎
+	0x1a1b: 0x80f2, // 胲
+	0x1a1c: 0x80fc, // 胼
+	0x1a1d: 0x6715, // 朕
+	0x1a1e: 0x8112, // 脒
+	0x1a1f: 0x8c5a, // 豚
+	0x1a20: 0x8136, // 脶
+	0x1a21: 0x811e, // 脞
+	0x1a22: 0x812c, // 脬
+	0x1a23: 0x8118, // 脘
+	0x1a24: 0x8132, // 脲
+	0x1a25: 0x8148, // 腈
+	0x1a26: 0x814c, // 腌
+	0x1a27: 0x8153, // 腓
+	0x1a28: 0x8174, // 腴
+	0x1a29: 0x8159, // 腙
+	0x1a2a: 0x815a, // 腚
+	0x1a2b: 0x8171, // 腱
+	0x1a2c: 0x8160, // 腠
+	0x1a2d: 0x8169, // 腩
+	0x1a2e: 0x817c, // 腼
+	0x1a2f: 0x817d, // 腽
+	0x1a30: 0x816d, // 腭
+	0x1a31: 0x8167, // 腧
+	0x1a32: 0x584d, // 塍
+	0x1a33: 0x5ab5, // 媵
+	0x1a34: 0x8188, // 膈
+	0x1a35: 0x8182, // 膂
+	0x1a36: 0x8191, // 膑
+	0x1a37: 0x6ed5, // 滕
+	0x1a38: 0x81a3, // 膣
+	0x1a39: 0x81aa, // 膪
+	0x1a3a: 0x81cc, // 臌
+	0x1a3b: 0x6726, // 朦
+	0x1a3c: 0x81ca, // 臊
+	0x1a3d: 0x81bb, // 膻
+	0x1a40: 0x81c1, // 臁
+	0x1a41: 0x81a6, // 膦
+	0x1a42: 0x6b24, // 欤
+	0x1a43: 0x6b37, // 欷
+	0x1a44: 0x6b39, // 欹
+	0x1a45: 0x6b43, // 歃
+	0x1a46: 0x6b46, // 歆
+	0x1a47: 0x6b59, // 歙
+	0x1a48: 0x98d1, // 飑
+	0x1a49: 0x98d2, // 飒
+	0x1a4a: 0x98d3, // 飓
+	0x1a4b: 0x98d5, // 飕
+	0x1a4c: 0x98d9, // 飙
+	0x1a4d: 0x98da, // 飚
+	0x1a4e: 0x6bb3, // 殳
+	0x1a4f: 0x5f40, // 彀
+	0x1a50: 0x6bc2, // 毂
+	0x1a51: 0x89f3, // 觳
+	0x1a52: 0x6590, // 斐
+	0x1a53: 0x9f51, // 齑
+	0x1a54: 0x6593, // 斓
+	0x1a55: 0x65bc, // 於
+	0x1a56: 0x65c6, // 旆
+	0x1a57: 0x65c4, // 旄
+	0x1a58: 0x65c3, // 旃
+	0x1a59: 0x65cc, // 旌
+	0x1a5a: 0x65ce, // 旎
+	0x1a5b: 0x65d2, // 旒
+	0x1a5c: 0x65d6, // 旖
+	0x1a5d: 0x7080, // 炀
+	0x1a5e: 0x709c, // 炜
+	0x1a5f: 0x7096, // 炖
+	0x1a60: 0x709d, // 炝
+	0x1a61: 0x70bb, // 炻
+	0x1a62: 0x70c0, // 烀
+	0x1a63: 0x70b7, // 炷
+	0x1a64: 0x70ab, // 炫
+	0x1a65: 0x70b1, // 炱
+	0x1a66: 0x70e8, // 烨
+	0x1a67: 0x70ca, // 烊
+	0x1a68: 0x7110, // 焐
+	0x1a69: 0x7113, // 焓
+	0x1a6a: 0x7116, // 焖
+	0x1a6b: 0x712f, // 焯
+	0x1a6c: 0x7131, // 焱
+	0x1a6d: 0x7173, // 煳
+	0x1a6e: 0x715c, // 煜
+	0x1a6f: 0x7168, // 煨
+	0x1a70: 0x7145, // 煅
+	0x1a71: 0x7172, // 煲
+	0x1a72: 0x714a, // 煊
+	0x1a73: 0x7178, // 煸
+	0x1a74: 0x717a, // 煺
+	0x1a75: 0x7198, // 熘
+	0x1a76: 0x71b3, // 熳
+	0x1a77: 0x71b5, // 熵
+	0x1a78: 0x71a8, // 熨
+	0x1a79: 0x71a0, // 熠
+	0x1a7a: 0x71e0, // 燠
+	0x1a7b: 0x71d4, // 燔
+	0x1a7c: 0x71e7, // 燧
+	0x1a7d: 0x71f9, // 燹
+	0x1a7e: 0x721d, // 爝
+	0x1a7f: 0x7228, // 爨
+	0x1a80: 0x706c, // 灬
+	0x1a81: 0x7118, // 焘
+	0x1a82: 0x7166, // 煦
+	0x1a83: 0x71b9, // 熹
+	0x1a84: 0x623e, // 戾
+	0x1a85: 0x623d, // 戽
+	0x1a86: 0x6243, // 扃
+	0x1a87: 0x6248, // 扈
+	0x1a88: 0x6249, // 扉
+	0x1a89: 0x793b, // 礻
+	0x1a8a: 0x7940, // 祀
+	0x1a8b: 0x7946, // 祆
+	0x1a8c: 0x7949, // 祉
+	0x1a8d: 0x795b, // 祛
+	0x1a8e: 0x795c, // 祜
+	0x1a8f: 0x7953, // 祓
+	0x1a90: 0x795a, // 祚
+	0x1a91: 0x7962, // 祢
+	0x1a92: 0x7957, // 祗
+	0x1a93: 0x7960, // 祠
+	0x1a94: 0x796f, // 祯
+	0x1a95: 0x7967, // 祧
+	0x1a96: 0x797a, // 祺
+	0x1a97: 0x7985, // 禅
+	0x1a98: 0x798a, // 禊
+	0x1a99: 0x799a, // 禚
+	0x1a9a: 0x79a7, // 禧
+	0x1a9b: 0x79b3, // 禳
+	0x1a9c: 0x5fd1, // 忑
+	0x1a9d: 0x5fd0, // 忐
+	0x1aa0: 0x603c, // 怼
+	0x1aa1: 0x605d, // 恝
+	0x1aa2: 0x605a, // 恚
+	0x1aa3: 0x6067, // 恧
+	0x1aa4: 0x6041, // 恁
+	0x1aa5: 0x6059, // 恙
+	0x1aa6: 0x6063, // 恣
+	0x1aa7: 0x60ab, // 悫
+	0x1aa8: 0x6106, // 愆
+	0x1aa9: 0x610d, // 愍
+	0x1aaa: 0x615d, // 慝
+	0x1aab: 0x61a9, // 憩
+	0x1aac: 0x619d, // 憝
+	0x1aad: 0x61cb, // 懋
+	0x1aae: 0x61d1, // 懑
+	0x1aaf: 0x6206, // 戆
+	0x1ab0: 0x8080, // 肀
+	0x1ab1: 0x807f, // 聿
+	0x1ab2: 0x6c93, // 沓
+	0x1ab3: 0x6cf6, // 泶
+	0x1ab4: 0x6dfc, // 淼
+	0x1ab5: 0x77f6, // 矶
+	0x1ab6: 0x77f8, // 矸
+	0x1ab7: 0x7800, // 砀
+	0x1ab8: 0x7809, // 砉
+	0x1ab9: 0x7817, // 砗
+	0x1aba: 0x7818, // 砘
+	0x1abb: 0x7811, // 砑
+	0x1abc: 0x65ab, // 斫
+	0x1abd: 0x782d, // 砭
+	0x1abe: 0x781c, // 砜
+	0x1abf: 0x781d, // 砝
+	0x1ac0: 0x7839, // 砹
+	0x1ac1: 0x783a, // 砺
+	0x1ac2: 0x783b, // 砻
+	0x1ac3: 0x781f, // 砟
+	0x1ac4: 0x783c, // 砼
+	0x1ac5: 0x7825, // 砥
+	0x1ac6: 0x782c, // 砬
+	0x1ac7: 0x7823, // 砣
+	0x1ac8: 0x7829, // 砩
+	0x1ac9: 0x784e, // 硎
+	0x1aca: 0x786d, // 硭
+	0x1acb: 0x7856, // 硖
+	0x1acc: 0x7857, // 硗
+	0x1acd: 0x7826, // 砦
+	0x1ace: 0x7850, // 硐
+	0x1acf: 0x7847, // 硇
+	0x1ad0: 0x784c, // 硌
+	0x1ad1: 0x786a, // 硪
+	0x1ad2: 0x789b, // 碛
+	0x1ad3: 0x7893, // 碓
+	0x1ad4: 0x789a, // 碚
+	0x1ad5: 0x7887, // 碇
+	0x1ad6: 0x789c, // 碜
+	0x1ad7: 0x78a1, // 碡
+	0x1ad8: 0x78a3, // 碣
+	0x1ad9: 0x78b2, // 碲
+	0x1ada: 0x78b9, // 碹
+	0x1adb: 0x78a5, // 碥
+	0x1adc: 0x78d4, // 磔
+	0x1add: 0x78d9, // 磙
+	0x1ade: 0x78c9, // 磉
+	0x1adf: 0x78ec, // 磬
+	0x1ae0: 0x78f2, // 磲
+	0x1ae1: 0x7905, // 礅
+	0x1ae2: 0x78f4, // 磴
+	0x1ae3: 0x7913, // 礓
+	0x1ae4: 0x7924, // 礤
+	0x1ae5: 0x791e, // 礞
+	0x1ae6: 0x7934, // 礴
+	0x1ae7: 0x9f9b, // 龛
+	0x1ae8: 0x9ef9, // 黹
+	0x1ae9: 0x9efb, // 黻
+	0x1aea: 0x9efc, // 黼
+	0x1aeb: 0x76f1, // 盱
+	0x1aec: 0x7704, // 眄
+	0x1aed: 0x770d, // 眍
+	0x1aee: 0x76f9, // 盹
+	0x1aef: 0x7707, // 眇
+	0x1af0: 0x7708, // 眈
+	0x1af1: 0x771a, // 眚
+	0x1af2: 0x7722, // 眢
+	0x1af3: 0x7719, // 眙
+	0x1af4: 0x772d, // 眭
+	0x1af5: 0x7726, // 眦
+	0x1af6: 0x7735, // 眵
+	0x1af7: 0x7738, // 眸
+	0x1af8: 0x7750, // 睐
+	0x1af9: 0x7751, // 睑
+	0x1afa: 0x7747, // 睇
+	0x1afb: 0x7743, // 睃
+	0x1afc: 0x775a, // 睚
+	0x1afd: 0x7768, // 睨
+	0x1b00: 0x7762, // 睢
+	0x1b01: 0x7765, // 睥
+	0x1b02: 0x777f, // 睿
+	0x1b03: 0x778d, // 瞍
+	0x1b04: 0x777d, // 睽
+	0x1b05: 0x7780, // 瞀
+	0x1b06: 0x778c, // 瞌
+	0x1b07: 0x7791, // 瞑
+	0x1b08: 0x779f, // 瞟
+	0x1b09: 0x77a0, // 瞠
+	0x1b0a: 0x77b0, // 瞰
+	0x1b0b: 0x77b5, // 瞵
+	0x1b0c: 0x77bd, // 瞽
+	0x1b0d: 0x753a, // 町
+	0x1b0e: 0x7540, // 畀
+	0x1b0f: 0x754e, // 畎
+	0x1b10: 0x754b, // 畋
+	0x1b11: 0x7548, // 畈
+	0x1b12: 0x755b, // 畛
+	0x1b13: 0x7572, // 畲
+	0x1b14: 0x7579, // 畹
+	0x1b15: 0x7583, // 疃
+	0x1b16: 0x7f58, // 罘
+	0x1b17: 0x7f61, // 罡
+	0x1b18: 0x7f5f, // 罟
+	0x1b19: 0x8a48, // 詈
+	0x1b1a: 0x7f68, // 罨
+	0x1b1b: 0x7f74, // 罴
+	0x1b1c: 0x7f71, // 罱
+	0x1b1d: 0x7f79, // 罹
+	0x1b1e: 0x7f81, // 羁
+	0x1b1f: 0x7f7e, // 罾
+	0x1b20: 0x76cd, // 盍
+	0x1b21: 0x76e5, // 盥
+	0x1b22: 0x8832, // 蠲
+	0x1b23: 0x9485, // 钅
+	0x1b24: 0x9486, // 钆
+	0x1b25: 0x9487, // 钇
+	0x1b26: 0x948b, // 钋
+	0x1b27: 0x948a, // 钊
+	0x1b28: 0x948c, // 钌
+	0x1b29: 0x948d, // 钍
+	0x1b2a: 0x948f, // 钏
+	0x1b2b: 0x9490, // 钐
+	0x1b2c: 0x9494, // 钔
+	0x1b2d: 0x9497, // 钗
+	0x1b2e: 0x9495, // 钕
+	0x1b2f: 0x949a, // 钚
+	0x1b30: 0x949b, // 钛
+	0x1b31: 0x949c, // 钜
+	0x1b32: 0x94a3, // 钣
+	0x1b33: 0x94a4, // 钤
+	0x1b34: 0x94ab, // 钫
+	0x1b35: 0x94aa, // 钪
+	0x1b36: 0x94ad, // 钭
+	0x1b37: 0x94ac, // 钬
+	0x1b38: 0x94af, // 钯
+	0x1b39: 0x94b0, // 钰
+	0x1b3a: 0x94b2, // 钲
+	0x1b3b: 0x94b4, // 钴
+	0x1b3c: 0x94b6, // 钶
+	0x1b3d: 0x94b7, // 钷
+	0x1b3e: 0x94b8, // 钸
+	0x1b3f: 0x94b9, // 钹
+	0x1b40: 0x94ba, // 钺
+	0x1b41: 0x94bc, // 钼
+	0x1b42: 0x94bd, // 钽
+	0x1b43: 0x94bf, // 钿
+	0x1b44: 0x94c4, // 铄
+	0x1b45: 0x94c8, // 铈
+	0x1b46: 0x94c9, // 铉
+	0x1b47: 0x94ca, // 铊
+	0x1b48: 0x94cb, // 铋
+	0x1b49: 0x94cc, // 铌
+	0x1b4a: 0x94cd, // 铍
+	0x1b4b: 0x94ce, // 铎
+	0x1b4c: 0x94d0, // 铐
+	0x1b4d: 0x94d1, // 铑
+	0x1b4e: 0x94d2, // 铒
+	0x1b4f: 0x94d5, // 铕
+	0x1b50: 0x94d6, // 铖
+	0x1b51: 0x94d7, // 铗
+	0x1b52: 0x94d9, // 铙
+	0x1b53: 0x94d8, // 铘
+	0x1b54: 0x94db, // 铛
+	0x1b55: 0x94de, // 铞
+	0x1b56: 0x94df, // 铟
+	0x1b57: 0x94e0, // 铠
+	0x1b58: 0x94e2, // 铢
+	0x1b59: 0x94e4, // 铤
+	0x1b5a: 0x94e5, // 铥
+	0x1b5b: 0x94e7, // 铧
+	0x1b5c: 0x94e8, // 铨
+	0x1b5d: 0x94ea, // 铪
+	0x1b60: 0x94e9, // 铩
+	0x1b61: 0x94eb, // 铫
+	0x1b62: 0x94ee, // 铮
+	0x1b63: 0x94ef, // 铯
+	0x1b64: 0x94f3, // 铳
+	0x1b65: 0x94f4, // 铴
+	0x1b66: 0x94f5, // 铵
+	0x1b67: 0x94f7, // 铷
+	0x1b68: 0x94f9, // 铹
+	0x1b69: 0x94fc, // 铼
+	0x1b6a: 0x94fd, // 铽
+	0x1b6b: 0x94ff, // 铿
+	0x1b6c: 0x9503, // 锃
+	0x1b6d: 0x9502, // 锂
+	0x1b6e: 0x9506, // 锆
+	0x1b6f: 0x9507, // 锇
+	0x1b70: 0x9509, // 锉
+	0x1b71: 0x950a, // 锊
+	0x1b72: 0x950d, // 锍
+	0x1b73: 0x950e, // 锎
+	0x1b74: 0x950f, // 锏
+	0x1b75: 0x9512, // 锒
+	0x1b76: 0x9513, // 锓
+	0x1b77: 0x9514, // 锔
+	0x1b78: 0x9515, // 锕
+	0x1b79: 0x9516, // 锖
+	0x1b7a: 0x9518, // 锘
+	0x1b7b: 0x951b, // 锛
+	0x1b7c: 0x951d, // 锝
+	0x1b7d: 0x951e, // 锞
+	0x1b7e: 0x951f, // 锟
+	0x1b7f: 0x9522, // 锢
+	0x1b80: 0x952a, // 锪
+	0x1b81: 0x952b, // 锫
+	0x1b82: 0x9529, // 锩
+	0x1b83: 0x952c, // 锬
+	0x1b84: 0x9531, // 锱
+	0x1b85: 0x9532, // 锲
+	0x1b86: 0x9534, // 锴
+	0x1b87: 0x9536, // 锶
+	0x1b88: 0x9537, // 锷
+	0x1b89: 0x9538, // 锸
+	0x1b8a: 0x953c, // 锼
+	0x1b8b: 0x953e, // 锾
+	0x1b8c: 0x953f, // 锿
+	0x1b8d: 0x9542, // 镂
+	0x1b8e: 0x9535, // 锵
+	0x1b8f: 0x9544, // 镄
+	0x1b90: 0x9545, // 镅
+	0x1b91: 0x9546, // 镆
+	0x1b92: 0x9549, // 镉
+	0x1b93: 0x954c, // 镌
+	0x1b94: 0x954e, // 镎
+	0x1b95: 0x954f, // 镏
+	0x1b96: 0x9552, // 镒
+	0x1b97: 0x9553, // 镓
+	0x1b98: 0x9554, // 镔
+	0x1b99: 0x9556, // 镖
+	0x1b9a: 0x9557, // 镗
+	0x1b9b: 0x9558, // 镘
+	0x1b9c: 0x9559, // 镙
+	0x1b9d: 0x955b, // 镛
+	0x1b9e: 0x955e, // 镞
+	0x1b9f: 0x955f, // 镟
+	0x1ba0: 0x955d, // 镝
+	0x1ba1: 0x9561, // 镡
+	0x1ba2: 0x9562, // 镢
+	0x1ba3: 0x9564, // 镤
+	0x1ba4: 0x9565, // 镥
+	0x1ba5: 0x9566, // 镦
+	0x1ba6: 0x9567, // 镧
+	0x1ba7: 0x9568, // 镨
+	0x1ba8: 0x9569, // 镩
+	0x1ba9: 0x956a, // 镪
+	0x1baa: 0x956b, // 镫
+	0x1bab: 0x956c, // 镬
+	0x1bac: 0x956f, // 镯
+	0x1bad: 0x9571, // 镱
+	0x1bae: 0x9572, // 镲
+	0x1baf: 0x9573, // 镳
+	0x1bb0: 0x953a, // 锺
+	0x1bb1: 0x77e7, // 矧
+	0x1bb2: 0x77ec, // 矬
+	0x1bb3: 0x96c9, // 雉
+	0x1bb4: 0x79d5, // 秕
+	0x1bb5: 0x79ed, // 秭
+	0x1bb6: 0x79e3, // 秣
+	0x1bb7: 0x79eb, // 秫
+	0x1bb8: 0x7a06, // 稆
+	0x1bb9: 0x5d47, // 嵇
+	0x1bba: 0x7a03, // 稃
+	0x1bbb: 0x7a02, // 稂
+	0x1bbc: 0x7a1e, // 稞
+	0x1bbd: 0x7a14, // 稔
+	0x1bc0: 0x7a39, // 稹
+	0x1bc1: 0x7a37, // 稷
+	0x1bc2: 0x7a51, // 穑
+	0x1bc3: 0x9ecf, // 黏
+	0x1bc4: 0x99a5, // 馥
+	0x1bc5: 0x7a70, // 穰
+	0x1bc6: 0x7688, // 皈
+	0x1bc7: 0x768e, // 皎
+	0x1bc8: 0x7693, // 皓
+	0x1bc9: 0x7699, // 皙
+	0x1bca: 0x76a4, // 皤
+	0x1bcb: 0x74de, // 瓞
+	0x1bcc: 0x74e0, // 瓠
+	0x1bcd: 0x752c, // 甬
+	0x1bce: 0x9e20, // 鸠
+	0x1bcf: 0x9e22, // 鸢
+	0x1bd0: 0x9e28, // 鸨
+	0x1bd1: 0x9e29, // 鸩
+	0x1bd2: 0x9e2a, // 鸪
+	0x1bd3: 0x9e2b, // 鸫
+	0x1bd4: 0x9e2c, // 鸬
+	0x1bd5: 0x9e32, // 鸲
+	0x1bd6: 0x9e31, // 鸱
+	0x1bd7: 0x9e36, // 鸶
+	0x1bd8: 0x9e38, // 鸸
+	0x1bd9: 0x9e37, // 鸷
+	0x1bda: 0x9e39, // 鸹
+	0x1bdb: 0x9e3a, // 鸺
+	0x1bdc: 0x9e3e, // 鸾
+	0x1bdd: 0x9e41, // 鹁
+	0x1bde: 0x9e42, // 鹂
+	0x1bdf: 0x9e44, // 鹄
+	0x1be0: 0x9e46, // 鹆
+	0x1be1: 0x9e47, // 鹇
+	0x1be2: 0x9e48, // 鹈
+	0x1be3: 0x9e49, // 鹉
+	0x1be4: 0x9e4b, // 鹋
+	0x1be5: 0x9e4c, // 鹌
+	0x1be6: 0x9e4e, // 鹎
+	0x1be7: 0x9e51, // 鹑
+	0x1be8: 0x9e55, // 鹕
+	0x1be9: 0x9e57, // 鹗
+	0x1bea: 0x9e5a, // 鹚
+	0x1beb: 0x9e5b, // 鹛
+	0x1bec: 0x9e5c, // 鹜
+	0x1bed: 0x9e5e, // 鹞
+	0x1bee: 0x9e63, // 鹣
+	0x1bef: 0x9e66, // 鹦
+	0x1bf0: 0x9e67, // 鹧
+	0x1bf1: 0x9e68, // 鹨
+	0x1bf2: 0x9e69, // 鹩
+	0x1bf3: 0x9e6a, // 鹪
+	0x1bf4: 0x9e6b, // 鹫
+	0x1bf5: 0x9e6c, // 鹬
+	0x1bf6: 0x9e71, // 鹱
+	0x1bf7: 0x9e6d, // 鹭
+	0x1bf8: 0x9e73, // 鹳
+	0x1bf9: 0x7592, // 疒
+	0x1bfa: 0x7594, // 疔
+	0x1bfb: 0x7596, // 疖
+	0x1bfc: 0x75a0, // 疠
+	0x1bfd: 0x759d, // 疝
+	0x1bfe: 0x75ac, // 疬
+	0x1bff: 0x75a3, // 疣
+	0x1c00: 0x75b3, // 疳
+	0x1c01: 0x75b4, // 疴
+	0x1c02: 0x75b8, // 疸
+	0x1c03: 0x75c4, // 痄
+	0x1c04: 0x75b1, // 疱
+	0x1c05: 0x75b0, // 疰
+	0x1c06: 0x75c3, // 痃
+	0x1c07: 0x75c2, // 痂
+	0x1c08: 0x75d6, // 痖
+	0x1c09: 0x75cd, // 痍
+	0x1c0a: 0x75e3, // 痣
+	0x1c0b: 0x75e8, // 痨
+	0x1c0c: 0x75e6, // 痦
+	0x1c0d: 0x75e4, // 痤
+	0x1c0e: 0x75eb, // 痫
+	0x1c0f: 0x75e7, // 痧
+	0x1c10: 0x7603, // 瘃
+	0x1c11: 0x75f1, // 痱
+	0x1c12: 0x75fc, // 痼
+	0x1c13: 0x75ff, // 痿
+	0x1c14: 0x7610, // 瘐
+	0x1c15: 0x7600, // 瘀
+	0x1c16: 0x7605, // 瘅
+	0x1c17: 0x760c, // 瘌
+	0x1c18: 0x7617, // 瘗
+	0x1c19: 0x760a, // 瘊
+	0x1c1a: 0x7625, // 瘥
+	0x1c1b: 0x7618, // 瘘
+	0x1c1c: 0x7615, // 瘕
+	0x1c1d: 0x7619, // 瘙
+	0x1c20: 0x761b, // 瘛
+	0x1c21: 0x763c, // 瘼
+	0x1c22: 0x7622, // 瘢
+	0x1c23: 0x7620, // 瘠
+	0x1c24: 0x7640, // 癀
+	0x1c25: 0x762d, // 瘭
+	0x1c26: 0x7630, // 瘰
+	0x1c27: 0x763f, // 瘿
+	0x1c28: 0x7635, // 瘵
+	0x1c29: 0x7643, // 癃
+	0x1c2a: 0x763e, // 瘾
+	0x1c2b: 0x7633, // 瘳
+	0x1c2c: 0x764d, // 癍
+	0x1c2d: 0x765e, // 癞
+	0x1c2e: 0x7654, // 癔
+	0x1c2f: 0x765c, // 癜
+	0x1c30: 0x7656, // 癖
+	0x1c31: 0x766b, // 癫
+	0x1c32: 0x766f, // 癯
+	0x1c33: 0x7fca, // 翊
+	0x1c34: 0x7ae6, // 竦
+	0x1c35: 0x7a78, // 穸
+	0x1c36: 0x7a79, // 穹
+	0x1c37: 0x7a80, // 窀
+	0x1c38: 0x7a86, // 窆
+	0x1c39: 0x7a88, // 窈
+	0x1c3a: 0x7a95, // 窕
+	0x1c3b: 0x7aa6, // 窦
+	0x1c3c: 0x7aa0, // 窠
+	0x1c3d: 0x7aac, // 窬
+	0x1c3e: 0x7aa8, // 窨
+	0x1c3f: 0x7aad, // 窭
+	0x1c40: 0x7ab3, // 窳
+	0x1c41: 0x8864, // 衤
+	0x1c42: 0x8869, // 衩
+	0x1c43: 0x8872, // 衲
+	0x1c44: 0x887d, // 衽
+	0x1c45: 0x887f, // 衿
+	0x1c46: 0x8882, // 袂
+	0x1c47: 0x88a2, // 袢
+	0x1c48: 0x88c6, // 裆
+	0x1c49: 0x88b7, // 袷
+	0x1c4a: 0x88bc, // 袼
+	0x1c4b: 0x88c9, // 裉
+	0x1c4c: 0x88e2, // 裢
+	0x1c4d: 0x88ce, // 裎
+	0x1c4e: 0x88e3, // 裣
+	0x1c4f: 0x88e5, // 裥
+	0x1c50: 0x88f1, // 裱
+	0x1c51: 0x891a, // 褚
+	0x1c52: 0x88fc, // 裼
+	0x1c53: 0x88e8, // 裨
+	0x1c54: 0x88fe, // 裾
+	0x1c55: 0x88f0, // 裰
+	0x1c56: 0x8921, // 褡
+	0x1c57: 0x8919, // 褙
+	0x1c58: 0x8913, // 褓
+	0x1c59: 0x891b, // 褛
+	0x1c5a: 0x890a, // 褊
+	0x1c5b: 0x8934, // 褴
+	0x1c5c: 0x892b, // 褫
+	0x1c5d: 0x8936, // 褶
+	0x1c5e: 0x8941, // 襁
+	0x1c5f: 0x8966, // 襦
+	0x1c60: 0x897b, // 襻
+	0x1c61: 0x758b, // 疋
+	0x1c62: 0x80e5, // 胥
+	0x1c63: 0x76b2, // 皲
+	0x1c64: 0x76b4, // 皴
+	0x1c65: 0x77dc, // 矜
+	0x1c66: 0x8012, // 耒
+	0x1c67: 0x8014, // 耔
+	0x1c68: 0x8016, // 耖
+	0x1c69: 0x801c, // 耜
+	0x1c6a: 0x8020, // 耠
+	0x1c6b: 0x8022, // 耢
+	0x1c6c: 0x8025, // 耥
+	0x1c6d: 0x8026, // 耦
+	0x1c6e: 0x8027, // 耧
+	0x1c6f: 0x8029, // 耩
+	0x1c70: 0x8028, // 耨
+	0x1c71: 0x8031, // 耱
+	0x1c72: 0x800b, // 耋
+	0x1c73: 0x8035, // 耵
+	0x1c74: 0x8043, // 聃
+	0x1c75: 0x8046, // 聆
+	0x1c76: 0x804d, // 聍
+	0x1c77: 0x8052, // 聒
+	0x1c78: 0x8069, // 聩
+	0x1c79: 0x8071, // 聱
+	0x1c7a: 0x8983, // 覃
+	0x1c7b: 0x9878, // 顸
+	0x1c7c: 0x9880, // 颀
+	0x1c7d: 0x9883, // 颃
+	0x1c80: 0x9889, // 颉
+	0x1c81: 0x988c, // 颌
+	0x1c82: 0x988d, // 颍
+	0x1c83: 0x988f, // 颏
+	0x1c84: 0x9894, // 颔
+	0x1c85: 0x989a, // 颚
+	0x1c86: 0x989b, // 颛
+	0x1c87: 0x989e, // 颞
+	0x1c88: 0x989f, // 颟
+	0x1c89: 0x98a1, // 颡
+	0x1c8a: 0x98a2, // 颢
+	0x1c8b: 0x98a5, // 颥
+	0x1c8c: 0x98a6, // 颦
+	0x1c8d: 0x864d, // 虍
+	0x1c8e: 0x8654, // 虔
+	0x1c8f: 0x866c, // 虬
+	0x1c90: 0x866e, // 虮
+	0x1c91: 0x867f, // 虿
+	0x1c92: 0x867a, // 虺
+	0x1c93: 0x867c, // 虼
+	0x1c94: 0x867b, // 虻
+	0x1c95: 0x86a8, // 蚨
+	0x1c96: 0x868d, // 蚍
+	0x1c97: 0x868b, // 蚋
+	0x1c98: 0x86ac, // 蚬
+	0x1c99: 0x869d, // 蚝
+	0x1c9a: 0x86a7, // 蚧
+	0x1c9b: 0x86a3, // 蚣
+	0x1c9c: 0x86aa, // 蚪
+	0x1c9d: 0x8693, // 蚓
+	0x1c9e: 0x86a9, // 蚩
+	0x1c9f: 0x86b6, // 蚶
+	0x1ca0: 0x86c4, // 蛄
+	0x1ca1: 0x86b5, // 蚵
+	0x1ca2: 0x86ce, // 蛎
+	0x1ca3: 0x86b0, // 蚰
+	0x1ca4: 0x86ba, // 蚺
+	0x1ca5: 0x86b1, // 蚱
+	0x1ca6: 0x86af, // 蚯
+	0x1ca7: 0x86c9, // 蛉
+	0x1ca8: 0x86cf, // 蛏
+	0x1ca9: 0x86b4, // 蚴
+	0x1caa: 0x86e9, // 蛩
+	0x1cab: 0x86f1, // 蛱
+	0x1cac: 0x86f2, // 蛲
+	0x1cad: 0x86ed, // 蛭
+	0x1cae: 0x86f3, // 蛳
+	0x1caf: 0x86d0, // 蛐
+	0x1cb0: 0x8713, // 蜓
+	0x1cb1: 0x86de, // 蛞
+	0x1cb2: 0x86f4, // 蛴
+	0x1cb3: 0x86df, // 蛟
+	0x1cb4: 0x86d8, // 蛘
+	0x1cb5: 0x86d1, // 蛑
+	0x1cb6: 0x8703, // 蜃
+	0x1cb7: 0x8707, // 蜇
+	0x1cb8: 0x86f8, // 蛸
+	0x1cb9: 0x8708, // 蜈
+	0x1cba: 0x870a, // 蜊
+	0x1cbb: 0x870d, // 蜍
+	0x1cbc: 0x8709, // 蜉
+	0x1cbd: 0x8723, // 蜣
+	0x1cbe: 0x873b, // 蜻
+	0x1cbf: 0x871e, // 蜞
+	0x1cc0: 0x8725, // 蜥
+	0x1cc1: 0x872e, // 蜮
+	0x1cc2: 0x871a, // 蜚
+	0x1cc3: 0x873e, // 蜾
+	0x1cc4: 0x8748, // 蝈
+	0x1cc5: 0x8734, // 蜴
+	0x1cc6: 0x8731, // 蜱
+	0x1cc7: 0x8729, // 蜩
+	0x1cc8: 0x8737, // 蜷
+	0x1cc9: 0x873f, // 蜿
+	0x1cca: 0x8782, // 螂
+	0x1ccb: 0x8722, // 蜢
+	0x1ccc: 0x877d, // 蝽
+	0x1ccd: 0x877e, // 蝾
+	0x1cce: 0x877b, // 蝻
+	0x1ccf: 0x8760, // 蝠
+	0x1cd0: 0x8770, // 蝰
+	0x1cd1: 0x874c, // 蝌
+	0x1cd2: 0x876e, // 蝮
+	0x1cd3: 0x878b, // 螋
+	0x1cd4: 0x8753, // 蝓
+	0x1cd5: 0x8763, // 蝣
+	0x1cd6: 0x877c, // 蝼
+	0x1cd7: 0x8764, // 蝤
+	0x1cd8: 0x8759, // 蝙
+	0x1cd9: 0x8765, // 蝥
+	0x1cda: 0x8793, // 螓
+	0x1cdb: 0x87af, // 螯
+	0x1cdc: 0x87a8, // 螨
+	0x1cdd: 0x87d2, // 蟒
+	0x1ce0: 0x87c6, // 蟆
+	0x1ce1: 0x8788, // 螈
+	0x1ce2: 0x8785, // 螅
+	0x1ce3: 0x87ad, // 螭
+	0x1ce4: 0x8797, // 螗
+	0x1ce5: 0x8783, // 螃
+	0x1ce6: 0x87ab, // 螫
+	0x1ce7: 0x87e5, // 蟥
+	0x1ce8: 0x87ac, // 螬
+	0x1ce9: 0x87b5, // 螵
+	0x1cea: 0x87b3, // 螳
+	0x1ceb: 0x87cb, // 蟋
+	0x1cec: 0x87d3, // 蟓
+	0x1ced: 0x87bd, // 螽
+	0x1cee: 0x87d1, // 蟑
+	0x1cef: 0x87c0, // 蟀
+	0x1cf0: 0x87ca, // 蟊
+	0x1cf1: 0x87db, // 蟛
+	0x1cf2: 0x87ea, // 蟪
+	0x1cf3: 0x87e0, // 蟠
+	0x1cf4: 0x87ee, // 蟮
+	0x1cf5: 0x8816, // 蠖
+	0x1cf6: 0x8813, // 蠓
+	0x1cf7: 0x87fe, // 蟾
+	0x1cf8: 0x880a, // 蠊
+	0x1cf9: 0x881b, // 蠛
+	0x1cfa: 0x8821, // 蠡
+	0x1cfb: 0x8839, // 蠹
+	0x1cfc: 0x883c, // 蠼
+	0x1cfd: 0x7f36, // 缶
+	0x1cfe: 0x7f42, // 罂
+	0x1cff: 0x7f44, // 罄
+	0x1d00: 0x7f45, // 罅
+	0x1d01: 0x8210, // 舐
+	0x1d02: 0x7afa, // 竺
+	0x1d03: 0x7afd, // 竽
+	0x1d04: 0x7b08, // 笈
+	0x1d05: 0x7b03, // 笃
+	0x1d06: 0x7b04, // 笄
+	0x1d07: 0x7b15, // 笕
+	0x1d08: 0x7b0a, // 笊
+	0x1d09: 0x7b2b, // 笫
+	0x1d0a: 0x7b0f, // 笏
+	0x1d0b: 0x7b47, // 筇
+	0x1d0c: 0x7b38, // 笸
+	0x1d0d: 0x7b2a, // 笪
+	0x1d0e: 0x7b19, // 笙
+	0x1d0f: 0x7b2e, // 笮
+	0x1d10: 0x7b31, // 笱
+	0x1d11: 0x7b20, // 笠
+	0x1d12: 0x7b25, // 笥
+	0x1d13: 0x7b24, // 笤
+	0x1d14: 0x7b33, // 笳
+	0x1d15: 0x7b3e, // 笾
+	0x1d16: 0x7b1e, // 笞
+	0x1d17: 0x7b58, // 筘
+	0x1d18: 0x7b5a, // 筚
+	0x1d19: 0x7b45, // 筅
+	0x1d1a: 0x7b75, // 筵
+	0x1d1b: 0x7b4c, // 筌
+	0x1d1c: 0x7b5d, // 筝
+	0x1d1d: 0x7b60, // 筠
+	0x1d1e: 0x7b6e, // 筮
+	0x1d1f: 0x7b7b, // 筻
+	0x1d20: 0x7b62, // 筢
+	0x1d21: 0x7b72, // 筲
+	0x1d22: 0x7b71, // 筱
+	0x1d23: 0x7b90, // 箐
+	0x1d24: 0x7ba6, // 箦
+	0x1d25: 0x7ba7, // 箧
+	0x1d26: 0x7bb8, // 箸
+	0x1d27: 0x7bac, // 箬
+	0x1d28: 0x7b9d, // 箝
+	0x1d29: 0x7ba8, // 箨
+	0x1d2a: 0x7b85, // 箅
+	0x1d2b: 0x7baa, // 箪
+	0x1d2c: 0x7b9c, // 箜
+	0x1d2d: 0x7ba2, // 箢
+	0x1d2e: 0x7bab, // 箫
+	0x1d2f: 0x7bb4, // 箴
+	0x1d30: 0x7bd1, // 篑
+	0x1d31: 0x7bc1, // 篁
+	0x1d32: 0x7bcc, // 篌
+	0x1d33: 0x7bdd, // 篝
+	0x1d34: 0x7bda, // 篚
+	0x1d35: 0x7be5, // 篥
+	0x1d36: 0x7be6, // 篦
+	0x1d37: 0x7bea, // 篪
+	0x1d38: 0x7c0c, // 簌
+	0x1d39: 0x7bfe, // 篾
+	0x1d3a: 0x7bfc, // 篼
+	0x1d3b: 0x7c0f, // 簏
+	0x1d3c: 0x7c16, // 簖
+	0x1d3d: 0x7c0b, // 簋
+	0x1d40: 0x7c1f, // 簟
+	0x1d41: 0x7c2a, // 簪
+	0x1d42: 0x7c26, // 簦
+	0x1d43: 0x7c38, // 簸
+	0x1d44: 0x7c41, // 籁
+	0x1d45: 0x7c40, // 籀
+	0x1d46: 0x81fe, // 臾
+	0x1d47: 0x8201, // 舁
+	0x1d48: 0x8202, // 舂
+	0x1d49: 0x8204, // 舄
+	0x1d4a: 0x81ec, // 臬
+	0x1d4b: 0x8844, // 衄
+	0x1d4c: 0x8221, // 舡
+	0x1d4d: 0x8222, // 舢
+	0x1d4e: 0x8223, // 舣
+	0x1d4f: 0x822d, // 舭
+	0x1d50: 0x822f, // 舯
+	0x1d51: 0x8228, // 舨
+	0x1d52: 0x822b, // 舫
+	0x1d53: 0x8238, // 舸
+	0x1d54: 0x823b, // 舻
+	0x1d55: 0x8233, // 舳
+	0x1d56: 0x8234, // 舴
+	0x1d57: 0x823e, // 舾
+	0x1d58: 0x8244, // 艄
+	0x1d59: 0x8249, // 艉
+	0x1d5a: 0x824b, // 艋
+	0x1d5b: 0x824f, // 艏
+	0x1d5c: 0x825a, // 艚
+	0x1d5d: 0x825f, // 艟
+	0x1d5e: 0x8268, // 艨
+	0x1d5f: 0x887e, // 衾
+	0x1d60: 0x8885, // 袅
+	0x1d61: 0x8888, // 袈
+	0x1d62: 0x88d8, // 裘
+	0x1d63: 0x88df, // 裟
+	0x1d64: 0x895e, // 襞
+	0x1d65: 0x7f9d, // 羝
+	0x1d66: 0x7f9f, // 羟
+	0x1d67: 0x7fa7, // 羧
+	0x1d68: 0x7faf, // 羯
+	0x1d69: 0x7fb0, // 羰
+	0x1d6a: 0x7fb2, // 羲
+	0x1d6b: 0x7c7c, // 籼
+	0x1d6c: 0x6549, // 敉
+	0x1d6d: 0x7c91, // 粑
+	0x1d6e: 0x7c9d, // 粝
+	0x1d6f: 0x7c9c, // 粜
+	0x1d70: 0x7c9e, // 粞
+	0x1d71: 0x7ca2, // 粢
+	0x1d72: 0x7cb2, // 粲
+	0x1d73: 0x7cbc, // 粼
+	0x1d74: 0x7cbd, // 粽
+	0x1d75: 0x7cc1, // 糁
+	0x1d76: 0x7cc7, // 糇
+	0x1d77: 0x7ccc, // 糌
+	0x1d78: 0x7ccd, // 糍
+	0x1d79: 0x7cc8, // 糈
+	0x1d7a: 0x7cc5, // 糅
+	0x1d7b: 0x7cd7, // 糗
+	0x1d7c: 0x7ce8, // 糨
+	0x1d7d: 0x826e, // 艮
+	0x1d7e: 0x66a8, // 暨
+	0x1d7f: 0x7fbf, // 羿
+	0x1d80: 0x7fce, // 翎
+	0x1d81: 0x7fd5, // 翕
+	0x1d82: 0x7fe5, // 翥
+	0x1d83: 0x7fe1, // 翡
+	0x1d84: 0x7fe6, // 翦
+	0x1d85: 0x7fe9, // 翩
+	0x1d86: 0x7fee, // 翮
+	0x1d87: 0x7ff3, // 翳
+	0x1d88: 0x7cf8, // 糸
+	0x1d89: 0x7d77, // 絷
+	0x1d8a: 0x7da6, // 綦
+	0x1d8b: 0x7dae, // 綮
+	0x1d8c: 0x7e47, // 繇
+	0x1d8d: 0x7e9b, // 纛
+	0x1d8e: 0x9eb8, // 麸
+	0x1d8f: 0x9eb4, // 麴
+	0x1d90: 0x8d73, // 赳
+	0x1d91: 0x8d84, // 趄
+	0x1d92: 0x8d94, // 趔
+	0x1d93: 0x8d91, // 趑
+	0x1d94: 0x8db1, // 趱
+	0x1d95: 0x8d67, // 赧
+	0x1d96: 0x8d6d, // 赭
+	0x1d97: 0x8c47, // 豇
+	0x1d98: 0x8c49, // 豉
+	0x1d99: 0x914a, // 酊
+	0x1d9a: 0x9150, // 酐
+	0x1d9b: 0x914e, // 酎
+	0x1d9c: 0x914f, // 酏
+	0x1d9d: 0x9164, // 酤
+	0x1da0: 0x9162, // 酢
+	0x1da1: 0x9161, // 酡
+	0x1da2: 0x9170, // 酰
+	0x1da3: 0x9169, // 酩
+	0x1da4: 0x916f, // 酯
+	0x1da5: 0x917d, // 酽
+	0x1da6: 0x917e, // 酾
+	0x1da7: 0x9172, // 酲
+	0x1da8: 0x9174, // 酴
+	0x1da9: 0x9179, // 酹
+	0x1daa: 0x918c, // 醌
+	0x1dab: 0x9185, // 醅
+	0x1dac: 0x9190, // 醐
+	0x1dad: 0x918d, // 醍
+	0x1dae: 0x9191, // 醑
+	0x1daf: 0x91a2, // 醢
+	0x1db0: 0x91a3, // 醣
+	0x1db1: 0x91aa, // 醪
+	0x1db2: 0x91ad, // 醭
+	0x1db3: 0x91ae, // 醮
+	0x1db4: 0x91af, // 醯
+	0x1db5: 0x91b5, // 醵
+	0x1db6: 0x91b4, // 醴
+	0x1db7: 0x91ba, // 醺
+	0x1db8: 0x8c55, // 豕
+	0x1db9: 0x9e7e, // 鹾
+	0x1dba: 0x8db8, // 趸
+	0x1dbb: 0x8deb, // 跫
+	0x1dbc: 0x8e05, // 踅
+	0x1dbd: 0x8e59, // 蹙
+	0x1dbe: 0x8e69, // 蹩
+	0x1dbf: 0x8db5, // 趵
+	0x1dc0: 0x8dbf, // 趿
+	0x1dc1: 0x8dbc, // 趼
+	0x1dc2: 0x8dba, // 趺
+	0x1dc3: 0x8dc4, // 跄
+	0x1dc4: 0x8dd6, // 跖
+	0x1dc5: 0x8dd7, // 跗
+	0x1dc6: 0x8dda, // 跚
+	0x1dc7: 0x8dde, // 跞
+	0x1dc8: 0x8dce, // 跎
+	0x1dc9: 0x8dcf, // 跏
+	0x1dca: 0x8ddb, // 跛
+	0x1dcb: 0x8dc6, // 跆
+	0x1dcc: 0x8dec, // 跬
+	0x1dcd: 0x8df7, // 跷
+	0x1dce: 0x8df8, // 跸
+	0x1dcf: 0x8de3, // 跣
+	0x1dd0: 0x8df9, // 跹
+	0x1dd1: 0x8dfb, // 跻
+	0x1dd2: 0x8de4, // 跤
+	0x1dd3: 0x8e09, // 踉
+	0x1dd4: 0x8dfd, // 跽
+	0x1dd5: 0x8e14, // 踔
+	0x1dd6: 0x8e1d, // 踝
+	0x1dd7: 0x8e1f, // 踟
+	0x1dd8: 0x8e2c, // 踬
+	0x1dd9: 0x8e2e, // 踮
+	0x1dda: 0x8e23, // 踣
+	0x1ddb: 0x8e2f, // 踯
+	0x1ddc: 0x8e3a, // 踺
+	0x1ddd: 0x8e40, // 蹀
+	0x1dde: 0x8e39, // 踹
+	0x1ddf: 0x8e35, // 踵
+	0x1de0: 0x8e3d, // 踽
+	0x1de1: 0x8e31, // 踱
+	0x1de2: 0x8e49, // 蹉
+	0x1de3: 0x8e41, // 蹁
+	0x1de4: 0x8e42, // 蹂
+	0x1de5: 0x8e51, // 蹑
+	0x1de6: 0x8e52, // 蹒
+	0x1de7: 0x8e4a, // 蹊
+	0x1de8: 0x8e70, // 蹰
+	0x1de9: 0x8e76, // 蹶
+	0x1dea: 0x8e7c, // 蹼
+	0x1deb: 0x8e6f, // 蹯
+	0x1dec: 0x8e74, // 蹴
+	0x1ded: 0x8e85, // 躅
+	0x1dee: 0x8e8f, // 躏
+	0x1def: 0x8e94, // 躔
+	0x1df0: 0x8e90, // 躐
+	0x1df1: 0x8e9c, // 躜
+	0x1df2: 0x8e9e, // 躞
+	0x1df3: 0x8c78, // 豸
+	0x1df4: 0x8c82, // 貂
+	0x1df5: 0x8c8a, // 貊
+	0x1df6: 0x8c85, // 貅
+	0x1df7: 0x8c98, // 貘
+	0x1df8: 0x8c94, // 貔
+	0x1df9: 0x659b, // 斛
+	0x1dfa: 0x89d6, // 觖
+	0x1dfb: 0x89de, // 觞
+	0x1dfc: 0x89da, // 觚
+	0x1dfd: 0x89dc, // 觜
+	0x1e00: 0x89e5, // 觥
+	0x1e01: 0x89eb, // 觫
+	0x1e02: 0x89ef, // 觯
+	0x1e03: 0x8a3e, // 訾
+	0x1e04: 0x8b26, // 謦
+	0x1e05: 0x9753, // 靓
+	0x1e06: 0x96e9, // 雩
+	0x1e07: 0x96f3, // 雳
+	0x1e08: 0x96ef, // 雯
+	0x1e09: 0x9706, // 霆
+	0x1e0a: 0x9701, // 霁
+	0x1e0b: 0x9708, // 霈
+	0x1e0c: 0x970f, // 霏
+	0x1e0d: 0x970e, // 霎
+	0x1e0e: 0x972a, // 霪
+	0x1e0f: 0x972d, // 霭
+	0x1e10: 0x9730, // 霰
+	0x1e11: 0x973e, // 霾
+	0x1e12: 0x9f80, // 龀
+	0x1e13: 0x9f83, // 龃
+	0x1e14: 0x9f85, // 龅
+	0x1e15: 0x9f86, // 龆
+	0x1e16: 0x9f87, // 龇
+	0x1e17: 0x9f88, // 龈
+	0x1e18: 0x9f89, // 龉
+	0x1e19: 0x9f8a, // 龊
+	0x1e1a: 0x9f8c, // 龌
+	0x1e1b: 0x9efe, // 黾
+	0x1e1c: 0x9f0b, // 鼋
+	0x1e1d: 0x9f0d, // 鼍
+	0x1e1e: 0x96b9, // 隹
+	0x1e1f: 0x96bc, // 隼
+	0x1e20: 0x96bd, // 隽
+	0x1e21: 0x96ce, // 雎
+	0x1e22: 0x96d2, // 雒
+	0x1e23: 0x77bf, // 瞿
+	0x1e24: 0x96e0, // 雠
+	0x1e25: 0x928e, // 銎
+	0x1e26: 0x92ae, // 銮
+	0x1e27: 0x92c8, // 鋈
+	0x1e28: 0x933e, // 錾
+	0x1e29: 0x936a, // 鍪
+	0x1e2a: 0x93ca, // 鏊
+	0x1e2b: 0x938f, // 鎏
+	0x1e2c: 0x943e, // 鐾
+	0x1e2d: 0x946b, // 鑫
+	0x1e2e: 0x9c7f, // 鱿
+	0x1e2f: 0x9c82, // 鲂
+	0x1e30: 0x9c85, // 鲅
+	0x1e31: 0x9c86, // 鲆
+	0x1e32: 0x9c87, // 鲇
+	0x1e33: 0x9c88, // 鲈
+	0x1e34: 0x7a23, // 稣
+	0x1e35: 0x9c8b, // 鲋
+	0x1e36: 0x9c8e, // 鲎
+	0x1e37: 0x9c90, // 鲐
+	0x1e38: 0x9c91, // 鲑
+	0x1e39: 0x9c92, // 鲒
+	0x1e3a: 0x9c94, // 鲔
+	0x1e3b: 0x9c95, // 鲕
+	0x1e3c: 0x9c9a, // 鲚
+	0x1e3d: 0x9c9b, // 鲛
+	0x1e3e: 0x9c9e, // 鲞
+	0x1e3f: 0x9c9f, // 鲟
+	0x1e40: 0x9ca0, // 鲠
+	0x1e41: 0x9ca1, // 鲡
+	0x1e42: 0x9ca2, // 鲢
+	0x1e43: 0x9ca3, // 鲣
+	0x1e44: 0x9ca5, // 鲥
+	0x1e45: 0x9ca6, // 鲦
+	0x1e46: 0x9ca7, // 鲧
+	0x1e47: 0x9ca8, // 鲨
+	0x1e48: 0x9ca9, // 鲩
+	0x1e49: 0x9cab, // 鲫
+	0x1e4a: 0x9cad, // 鲭
+	0x1e4b: 0x9cae, // 鲮
+	0x1e4c: 0x9cb0, // 鲰
+	0x1e4d: 0x9cb1, // 鲱
+	0x1e4e: 0x9cb2, // 鲲
+	0x1e4f: 0x9cb3, // 鲳
+	0x1e50: 0x9cb4, // 鲴
+	0x1e51: 0x9cb5, // 鲵
+	0x1e52: 0x9cb6, // 鲶
+	0x1e53: 0x9cb7, // 鲷
+	0x1e54: 0x9cba, // 鲺
+	0x1e55: 0x9cbb, // 鲻
+	0x1e56: 0x9cbc, // 鲼
+	0x1e57: 0x9cbd, // 鲽
+	0x1e58: 0x9cc4, // 鳄
+	0x1e59: 0x9cc5, // 鳅
+	0x1e5a: 0x9cc6, // 鳆
+	0x1e5b: 0x9cc7, // 鳇
+	0x1e5c: 0x9cca, // 鳊
+	0x1e5d: 0x9ccb, // 鳋
+	0x1e60: 0x9ccc, // 鳌
+	0x1e61: 0x9ccd, // 鳍
+	0x1e62: 0x9cce, // 鳎
+	0x1e63: 0x9ccf, // 鳏
+	0x1e64: 0x9cd0, // 鳐
+	0x1e65: 0x9cd3, // 鳓
+	0x1e66: 0x9cd4, // 鳔
+	0x1e67: 0x9cd5, // 鳕
+	0x1e68: 0x9cd7, // 鳗
+	0x1e69: 0x9cd8, // 鳘
+	0x1e6a: 0x9cd9, // 鳙
+	0x1e6b: 0x9cdc, // 鳜
+	0x1e6c: 0x9cdd, // 鳝
+	0x1e6d: 0x9cdf, // 鳟
+	0x1e6e: 0x9ce2, // 鳢
+	0x1e6f: 0x977c, // 靼
+	0x1e70: 0x9785, // 鞅
+	0x1e71: 0x9791, // 鞑
+	0x1e72: 0x9792, // 鞒
+	0x1e73: 0x9794, // 鞔
+	0x1e74: 0x97af, // 鞯
+	0x1e75: 0x97ab, // 鞫
+	0x1e76: 0x97a3, // 鞣
+	0x1e77: 0x97b2, // 鞲
+	0x1e78: 0x97b4, // 鞴
+	0x1e79: 0x9ab1, // 骱
+	0x1e7a: 0x9ab0, // 骰
+	0x1e7b: 0x9ab7, // 骷
+	0x1e7c: 0x9e58, // 鹘
+	0x1e7d: 0x9ab6, // 骶
+	0x1e7e: 0x9aba, // 骺
+	0x1e7f: 0x9abc, // 骼
+	0x1e80: 0x9ac1, // 髁
+	0x1e81: 0x9ac0, // 髀
+	0x1e82: 0x9ac5, // 髅
+	0x1e83: 0x9ac2, // 髂
+	0x1e84: 0x9acb, // 髋
+	0x1e85: 0x9acc, // 髌
+	0x1e86: 0x9ad1, // 髑
+	0x1e87: 0x9b45, // 魅
+	0x1e88: 0x9b43, // 魃
+	0x1e89: 0x9b47, // 魇
+	0x1e8a: 0x9b49, // 魉
+	0x1e8b: 0x9b48, // 魈
+	0x1e8c: 0x9b4d, // 魍
+	0x1e8d: 0x9b51, // 魑
+	0x1e8e: 0x98e8, // 飨
+	0x1e8f: 0x990d, // 餍
+	0x1e90: 0x992e, // 餮
+	0x1e91: 0x9955, // 饕
+	0x1e92: 0x9954, // 饔
+	0x1e93: 0x9adf, // 髟
+	0x1e94: 0x9ae1, // 髡
+	0x1e95: 0x9ae6, // 髦
+	0x1e96: 0x9aef, // 髯
+	0x1e97: 0x9aeb, // 髫
+	0x1e98: 0x9afb, // 髻
+	0x1e99: 0x9aed, // 髭
+	0x1e9a: 0x9af9, // 髹
+	0x1e9b: 0x9b08, // 鬈
+	0x1e9c: 0x9b0f, // 鬏
+	0x1e9d: 0x9b13, // 鬓
+	0x1e9e: 0x9b1f, // 鬟
+	0x1e9f: 0x9b23, // 鬣
+	0x1ea0: 0x9ebd, // 麽
+	0x1ea1: 0x9ebe, // 麾
+	0x1ea2: 0x7e3b, // 縻
+	0x1ea3: 0x9e82, // 麂
+	0x1ea4: 0x9e87, // 麇
+	0x1ea5: 0x9e88, // 麈
+	0x1ea6: 0x9e8b, // 麋
+	0x1ea7: 0x9e92, // 麒
+	0x1ea8: 0x93d6, // 鏖
+	0x1ea9: 0x9e9d, // 麝
+	0x1eaa: 0x9e9f, // 麟
+	0x1eab: 0x9edb, // 黛
+	0x1eac: 0x9edc, // 黜
+	0x1ead: 0x9edd, // 黝
+	0x1eae: 0x9ee0, // 黠
+	0x1eaf: 0x9edf, // 黟
+	0x1eb0: 0x9ee2, // 黢
+	0x1eb1: 0x9ee9, // 黩
+	0x1eb2: 0x9ee7, // 黧
+	0x1eb3: 0x9ee5, // 黥
+	0x1eb4: 0x9eea, // 黪
+	0x1eb5: 0x9eef, // 黯
+	0x1eb6: 0x9f22, // 鼢
+	0x1eb7: 0x9f2c, // 鼬
+	0x1eb8: 0x9f2f, // 鼯
+	0x1eb9: 0x9f39, // 鼹
+	0x1eba: 0x9f37, // 鼷
+	0x1ebb: 0x9f3d, // 鼽
+	0x1ebc: 0x9f3e, // 鼾
+	0x1ebd: 0x9f44, // 齄
+}
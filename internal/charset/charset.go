@@ -0,0 +1,103 @@
+// Package charset converts the data in character sets designated by ECI (Extended Channel Interpretation) into UTF-8.
+package charset
+
+//go:generate go run gen_table/main.go
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/shogo82148/qrcode/internal/bitstream"
+)
+
+// ECI assignment numbers.
+const (
+	ISO8859_1 = 3
+	ShiftJIS  = 20
+	UTF16BE   = 25
+	UTF8      = 26
+	ASCII     = 27
+)
+
+// Decode converts data in the character set of the ECI assignment number eci into UTF-8.
+// Invalid bytes are replaced with U+FFFD.
+func Decode(eci int, data []byte) (string, error) {
+	switch {
+	case eci == 1 || eci == ISO8859_1:
+		return decodeLatin1(data), nil
+	case 4 <= eci && eci <= 18 && eci != 14:
+		// ECI 4 is ISO/IEC 8859-2, ..., ECI 18 is ISO/IEC 8859-16.
+		// ISO/IEC 8859-12 doesn't exist.
+		return decodeISO8859(&iso8859[eci-2], data), nil
+	case eci == ShiftJIS:
+		return decodeShiftJIS(data), nil
+	case eci == UTF16BE:
+		return decodeUTF16BE(data), nil
+	case eci == UTF8 || eci == ASCII:
+		return strings.ToValidUTF8(string(data), string(utf8.RuneError)), nil
+	}
+	return "", fmt.Errorf("charset: unsupported ECI: %d", eci)
+}
+
+func decodeLatin1(data []byte) string {
+	var buf strings.Builder
+	buf.Grow(len(data))
+	for _, b := range data {
+		buf.WriteRune(rune(b))
+	}
+	return buf.String()
+}
+
+func decodeISO8859(table *[128]rune, data []byte) string {
+	var buf strings.Builder
+	buf.Grow(len(data))
+	for _, b := range data {
+		switch {
+		case b < 0x80:
+			buf.WriteByte(b)
+		case table[b-0x80] == 0:
+			buf.WriteRune(utf8.RuneError)
+		default:
+			buf.WriteRune(table[b-0x80])
+		}
+	}
+	return buf.String()
+}
+
+func decodeShiftJIS(data []byte) string {
+	var buf strings.Builder
+	buf.Grow(len(data))
+	for i := 0; i < len(data); i++ {
+		b := data[i]
+		switch {
+		case b < 0x80:
+			buf.WriteByte(b)
+		case 0xa1 <= b && b <= 0xdf:
+			// half-width katakana
+			buf.WriteRune(rune(b) - 0xa1 + 0xff61)
+		default:
+			r, size, ok := bitstream.KanjiPrefix(data[i:], true)
+			if !ok {
+				buf.WriteRune(utf8.RuneError)
+				continue
+			}
+			buf.WriteRune(r)
+			i += size - 1
+		}
+	}
+	return buf.String()
+}
+
+func decodeUTF16BE(data []byte) string {
+	u := make([]uint16, 0, len(data)/2)
+	for i := 0; i+1 < len(data); i += 2 {
+		u = append(u, uint16(data[i])<<8|uint16(data[i+1]))
+	}
+	s := string(utf16.Decode(u))
+	if len(data)%2 != 0 {
+		s += string(utf8.RuneError)
+	}
+	return s
+}
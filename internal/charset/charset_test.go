@@ -0,0 +1,36 @@
+package charset
+
+import "testing"
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		eci  int
+		data []byte
+		want string
+	}{
+		{ISO8859_1, []byte("caf\xe9"), "café"},
+		{4, []byte("\xa3\xf3d\xbc"), "Łódź"},              // ISO/IEC 8859-2
+		{7, []byte("\xbc\xde\xe1\xda\xd2\xd0"), "Москва"}, // ISO/IEC 8859-5
+		{17, []byte("\xa4"), "€"},                         // ISO/IEC 8859-15
+		{ShiftJIS, []byte("\x93\x5f\xb1A"), "点ｱA"},
+		{UTF16BE, []byte("\x70\xb9\x00A"), "点A"},
+		{UTF8, []byte("点A"), "点A"},
+		{UTF8, []byte("\xff"), "�"},
+	}
+	for _, tt := range tests {
+		got, err := Decode(tt.eci, tt.data)
+		if err != nil {
+			t.Errorf("ECI %d: %v", tt.eci, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ECI %d: got %q, want %q", tt.eci, got, tt.want)
+		}
+	}
+}
+
+func TestDecode_Unsupported(t *testing.T) {
+	if _, err := Decode(14, []byte("a")); err == nil {
+		t.Error("want error, but not")
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// parts are the parts of ISO/IEC 8859. Part 12 was abandoned.
+var parts = []int{2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 13, 14, 15, 16}
+
+func main() {
+	var buf bytes.Buffer
+	w := &buf
+	fmt.Fprintln(w, "// Code generated by gen_table/main.go; DO NOT EDIT.")
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "package charset")
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "// iso8859 is the upper half (0x80-0xff) of ISO/IEC 8859-n indexed by n.")
+	fmt.Fprintln(w, "// 0 means that the byte is not assigned.")
+	fmt.Fprintln(w, "var iso8859 = [...][128]rune{")
+	for _, part := range parts {
+		table, err := loadIndex(part)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Fprintf(w, "%d: {", part)
+		for i, r := range table {
+			if i%16 == 0 {
+				fmt.Fprintln(w)
+			}
+			fmt.Fprintf(w, "0x%04x,", r)
+		}
+		fmt.Fprintf(w, "\n},\n")
+	}
+	fmt.Fprintln(w, "}")
+
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("table_gen.go", out, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func loadIndex(part int) (*[128]rune, error) {
+	f, err := os.Open(filepath.Join("gen_table", fmt.Sprintf("index-iso-8859-%d.txt", part)))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var table [128]rune
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		row := scanner.Bytes()
+		row = bytes.TrimSpace(row)
+		if len(row) == 0 || row[0] == '#' {
+			continue
+		}
+		pointer, rest, ok := bytes.Cut(row, []byte("\t"))
+		if !ok {
+			return nil, errors.New("invalid row")
+		}
+		unicode, _, ok := bytes.Cut(rest, []byte("\t"))
+		if !ok {
+			return nil, errors.New("invalid row")
+		}
+
+		p, err := strconv.ParseInt(string(pointer), 10, 0)
+		if err != nil {
+			return nil, err
+		}
+		if p < 0 || p >= int64(len(table)) {
+			return nil, fmt.Errorf("invalid pointer: %d", p)
+		}
+		u, err := strconv.ParseUint(string(unicode), 0, 16)
+		if err != nil {
+			return nil, err
+		}
+		table[p] = rune(u)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return &table, nil
+}
@@ -0,0 +1,273 @@
+package detector
+
+import (
+	"math"
+	"sort"
+
+	"github.com/shogo82148/qrcode/bitmap"
+)
+
+// FinderPattern is a candidate of the finder pattern.
+type FinderPattern struct {
+	Point
+
+	// ModuleSize is the estimated size of a module in pixels.
+	ModuleSize float64
+
+	// Count is the number of times the pattern was found.
+	Count int
+}
+
+// FindFinderPatterns finds the patterns with dark-light-dark-light-dark ratio 1:1:3:1:1.
+// The results are sorted by Count in descending order.
+func FindFinderPatterns(img *bitmap.Image) []FinderPattern {
+	// based on https://github.com/zxing/zxing/blob/99e9b34f5afc21fdaeead283d5ed0bc1314cbec1/core/src/main/java/com/google/zxing/qrcode/detector/FinderPatternFinder.java
+	f := &finderPatternFinder{img: img}
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		var counts [5]int
+		state := 0
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			if img.BinaryAt(x, y) {
+				// dark pixel
+				if state&1 == 1 {
+					state++
+				}
+				counts[state]++
+				continue
+			}
+
+			// light pixel
+			if state&1 == 1 {
+				counts[state]++
+				continue
+			}
+			if state != 4 {
+				state++
+				counts[state]++
+				continue
+			}
+			if foundPatternCross(counts) {
+				f.handlePossibleCenter(counts, x, y)
+			}
+			counts = [5]int{counts[2], counts[3], counts[4], 1, 0}
+			state = 3
+		}
+		if state == 4 && foundPatternCross(counts) {
+			f.handlePossibleCenter(counts, bounds.Max.X, y)
+		}
+	}
+
+	sort.SliceStable(f.patterns, func(i, j int) bool {
+		return f.patterns[i].Count > f.patterns[j].Count
+	})
+	return f.patterns
+}
+
+type finderPatternFinder struct {
+	img      *bitmap.Image
+	patterns []FinderPattern
+}
+
+// foundPatternCross returns whether counts match the ratio 1:1:3:1:1.
+func foundPatternCross(counts [5]int) bool {
+	total := 0
+	for _, c := range counts {
+		if c == 0 {
+			return false
+		}
+		total += c
+	}
+	if total < 7 {
+		return false
+	}
+	moduleSize := float64(total) / 7
+	maxVariance := moduleSize / 2
+	return math.Abs(moduleSize-float64(counts[0])) < maxVariance &&
+		math.Abs(moduleSize-float64(counts[1])) < maxVariance &&
+		math.Abs(3*moduleSize-float64(counts[2])) < 3*maxVariance &&
+		math.Abs(moduleSize-float64(counts[3])) < maxVariance &&
+		math.Abs(moduleSize-float64(counts[4])) < maxVariance
+}
+
+// centerFromEnd returns the center of the pattern that ends at end.
+func centerFromEnd(counts [5]int, end int) float64 {
+	return float64(end-counts[4]-counts[3]) - float64(counts[2])/2
+}
+
+func (f *finderPatternFinder) handlePossibleCenter(counts [5]int, x, y int) {
+	total := counts[0] + counts[1] + counts[2] + counts[3] + counts[4]
+	centerX := centerFromEnd(counts, x)
+	centerY, ok := f.crossCheckVertical(y, int(centerX), counts[2], total)
+	if !ok {
+		return
+	}
+	centerX, ok = f.crossCheckHorizontal(int(centerX), int(centerY), counts[2], total)
+	if !ok {
+		return
+	}
+	moduleSize := float64(total) / 7
+
+	for i, p := range f.patterns {
+		if p.aboutEquals(moduleSize, centerX, centerY) {
+			n := float64(p.Count)
+			f.patterns[i] = FinderPattern{
+				Point: Point{
+					X: (n*p.X + centerX) / (n + 1),
+					Y: (n*p.Y + centerY) / (n + 1),
+				},
+				ModuleSize: (n*p.ModuleSize + moduleSize) / (n + 1),
+				Count:      p.Count + 1,
+			}
+			return
+		}
+	}
+	f.patterns = append(f.patterns, FinderPattern{
+		Point:      Point{X: centerX, Y: centerY},
+		ModuleSize: moduleSize,
+		Count:      1,
+	})
+}
+
+func (p FinderPattern) aboutEquals(moduleSize, x, y float64) bool {
+	if math.Abs(y-p.Y) <= moduleSize && math.Abs(x-p.X) <= moduleSize {
+		diff := math.Abs(moduleSize - p.ModuleSize)
+		return diff <= 1 || diff <= p.ModuleSize
+	}
+	return false
+}
+
+// crossCheckVertical checks the pattern vertically and returns its center.
+func (f *finderPatternFinder) crossCheckVertical(startY, centerX, maxCount, originalTotal int) (float64, bool) {
+	img := f.img
+	bounds := img.Bounds()
+	var counts [5]int
+
+	// Start counting up from center
+	y := startY
+	for y >= bounds.Min.Y && img.BinaryAt(centerX, y) {
+		counts[2]++
+		y--
+	}
+	if y < bounds.Min.Y {
+		return 0, false
+	}
+	for y >= bounds.Min.Y && !img.BinaryAt(centerX, y) && counts[1] <= maxCount {
+		counts[1]++
+		y--
+	}
+	if y < bounds.Min.Y || counts[1] > maxCount {
+		return 0, false
+	}
+	for y >= bounds.Min.Y && img.BinaryAt(centerX, y) && counts[0] <= maxCount {
+		counts[0]++
+		y--
+	}
+	if counts[0] > maxCount {
+		return 0, false
+	}
+
+	// Now also count down from center
+	y = startY + 1
+	for y < bounds.Max.Y && img.BinaryAt(centerX, y) {
+		counts[2]++
+		y++
+	}
+	if y >= bounds.Max.Y {
+		return 0, false
+	}
+	for y < bounds.Max.Y && !img.BinaryAt(centerX, y) && counts[3] < maxCount {
+		counts[3]++
+		y++
+	}
+	if y >= bounds.Max.Y || counts[3] >= maxCount {
+		return 0, false
+	}
+	for y < bounds.Max.Y && img.BinaryAt(centerX, y) && counts[4] < maxCount {
+		counts[4]++
+		y++
+	}
+	if counts[4] >= maxCount {
+		return 0, false
+	}
+
+	// If we found a finder-pattern-like section, but its size is more than 40% different than
+	// the original, assume it's a false positive
+	total := counts[0] + counts[1] + counts[2] + counts[3] + counts[4]
+	if 5*abs(total-originalTotal) >= 2*originalTotal {
+		return 0, false
+	}
+	if !foundPatternCross(counts) {
+		return 0, false
+	}
+	return centerFromEnd(counts, y), true
+}
+
+// crossCheckHorizontal checks the pattern horizontally and returns its center.
+func (f *finderPatternFinder) crossCheckHorizontal(startX, centerY, maxCount, originalTotal int) (float64, bool) {
+	img := f.img
+	bounds := img.Bounds()
+	var counts [5]int
+
+	x := startX
+	for x >= bounds.Min.X && img.BinaryAt(x, centerY) {
+		counts[2]++
+		x--
+	}
+	if x < bounds.Min.X {
+		return 0, false
+	}
+	for x >= bounds.Min.X && !img.BinaryAt(x, centerY) && counts[1] <= maxCount {
+		counts[1]++
+		x--
+	}
+	if x < bounds.Min.X || counts[1] > maxCount {
+		return 0, false
+	}
+	for x >= bounds.Min.X && img.BinaryAt(x, centerY) && counts[0] <= maxCount {
+		counts[0]++
+		x--
+	}
+	if counts[0] > maxCount {
+		return 0, false
+	}
+
+	x = startX + 1
+	for x < bounds.Max.X && img.BinaryAt(x, centerY) {
+		counts[2]++
+		x++
+	}
+	if x >= bounds.Max.X {
+		return 0, false
+	}
+	for x < bounds.Max.X && !img.BinaryAt(x, centerY) && counts[3] < maxCount {
+		counts[3]++
+		x++
+	}
+	if x >= bounds.Max.X || counts[3] >= maxCount {
+		return 0, false
+	}
+	for x < bounds.Max.X && img.BinaryAt(x, centerY) && counts[4] < maxCount {
+		counts[4]++
+		x++
+	}
+	if counts[4] >= maxCount {
+		return 0, false
+	}
+
+	total := counts[0] + counts[1] + counts[2] + counts[3] + counts[4]
+	if 5*abs(total-originalTotal) >= originalTotal {
+		return 0, false
+	}
+	if !foundPatternCross(counts) {
+		return 0, false
+	}
+	return centerFromEnd(counts, x), true
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,345 @@
+package detector
+
+import (
+	"math"
+
+	"github.com/shogo82148/qrcode/bitmap"
+)
+
+// Sampler reads modules of a candidate symbol.
+type Sampler struct {
+	img *bitmap.Image
+	t   *Transform
+}
+
+// Module returns the color of the module at (x, y) in the module coordinate.
+func (s *Sampler) Module(x, y int) bitmap.Color {
+	return sampleAt(s.img, s.t, float64(x)+0.5, float64(y)+0.5)
+}
+
+// SizeFunc reads the format information through s,
+// and returns the width and the height of the symbol.
+// It returns false if s doesn't seem to be a symbol.
+type SizeFunc func(s *Sampler) (width, height int, ok bool)
+
+// DetectMicroQR detects Micro QR codes in img.
+// It returns the candidates of the symbol for each finder pattern,
+// and they are sorted from the most likely.
+func DetectMicroQR(img *bitmap.Image, size SizeFunc) [][]*Result {
+	var results [][]*Result
+	for _, p := range FindFinderPatterns(img) {
+		var candidates []*Result
+		for _, t := range finderFrames(img, p) {
+			w, h, ok := size(&Sampler{img: img, t: t})
+			if !ok {
+				continue
+			}
+			t = refineTiming(img, t, w, h)
+			candidates = append(candidates, newResult(img, t, w, h))
+		}
+		if len(candidates) > 0 {
+			results = append(results, candidates)
+		}
+	}
+	return results
+}
+
+// DetectRMQR detects rMQR codes in img.
+// It returns the candidates of the symbol for each finder pattern,
+// and they are sorted from the most likely.
+func DetectRMQR(img *bitmap.Image, size SizeFunc) [][]*Result {
+	var results [][]*Result
+	for _, p := range FindFinderPatterns(img) {
+		var candidates []*Result
+		for _, t := range finderFrames(img, p) {
+			w, h, ok := size(&Sampler{img: img, t: t})
+			if !ok {
+				continue
+			}
+
+			t = refineTiming(img, t, w, h)
+
+			// the sub-finder pattern at the bottom-right corner
+			// is same as the alignment pattern of QR codes.
+			// a small error of the angle makes a large error at the far end, so search widely.
+			radius := math.Max(4, 0.05*float64(w)) * p.ModuleSize
+			if s, ok := findAlignmentPattern(img, t, float64(w)-2.5, float64(h)-2.5, radius); ok {
+				t = adjustFrame(t, s, w, h)
+			}
+			candidates = append(candidates, newResult(img, t, w, h))
+		}
+		if len(candidates) > 0 {
+			results = append(results, candidates)
+		}
+	}
+	return results
+}
+
+func newResult(img *bitmap.Image, t *Transform, w, h int) *Result {
+	fw, fh := float64(w), float64(h)
+	return &Result{
+		Bitmap: Sample(img, t, w, h),
+		Corners: [4]Point{
+			t.Apply(Point{0, 0}),
+			t.Apply(Point{fw, 0}),
+			t.Apply(Point{fw, fh}),
+			t.Apply(Point{0, fh}),
+		},
+	}
+}
+
+// finderFrames returns the candidates of the transform around the finder pattern p.
+// The transforms map the module coordinate, where the center of the finder pattern is (3.5, 3.5),
+// to the image coordinate.
+// The finder pattern is symmetric, so there are 8 candidates for 4 rotations with and without mirroring.
+func finderFrames(img *bitmap.Image, p FinderPattern) []*Transform {
+	angle, moduleSize, ok := estimateFinderAxis(img, p)
+	if !ok {
+		return nil
+	}
+
+	center := refineFinderCenter(img, p.Point, angle, moduleSize)
+
+	frames := make([]*Transform, 0, 8)
+	for i := 0; i < 4; i++ {
+		sin, cos := math.Sincos(angle + float64(i)*math.Pi/2)
+		ux := Point{cos * moduleSize, sin * moduleSize}
+		for _, mirror := range []float64{1, -1} {
+			uy := Point{-sin * moduleSize * mirror, cos * moduleSize * mirror}
+			frames = append(frames, frameTransform(center, ux, uy))
+		}
+	}
+	return frames
+}
+
+// frameTransform returns the affine transform that maps
+// (3.5, 3.5) to center, and the unit vectors to ux and uy.
+func frameTransform(center, ux, uy Point) *Transform {
+	return &Transform{
+		a11: ux.X, a21: uy.X, a31: center.X - 3.5*ux.X - 3.5*uy.X,
+		a12: ux.Y, a22: uy.Y, a32: center.Y - 3.5*ux.Y - 3.5*uy.Y,
+		a13: 0, a23: 0, a33: 1,
+	}
+}
+
+// adjustFrame adjusts the x-axis of t so that the center of the sub-finder pattern
+// in the bottom-right corner maps to s.
+func adjustFrame(t *Transform, s Point, w, h int) *Transform {
+	center := t.Apply(Point{3.5, 3.5})
+	uy := Point{t.a21, t.a22}
+	dx := float64(w) - 6
+	dy := float64(h) - 6
+	ux := Point{
+		X: (s.X - center.X - dy*uy.X) / dx,
+		Y: (s.Y - center.Y - dy*uy.Y) / dx,
+	}
+	return frameTransform(center, ux, uy)
+}
+
+// refineTiming adjusts the axes of t so that they fit the timing patterns
+// along the top edge and the left edge.
+// It corrects the small error of the angle and the aspect ratio that the finder pattern can't tell.
+func refineTiming(img *bitmap.Image, t *Transform, w, h int) *Transform {
+	center := t.Apply(Point{3.5, 3.5})
+	ux := Point{t.a11, t.a12}
+	uy := Point{t.a21, t.a22}
+
+	// bestScale returns the scale of u that fits the timing pattern best and its score.
+	bestScale := func(u Point, score func(u Point) int) (float64, int) {
+		bestScore := -1
+		var sum, count float64
+		for i := -10; i <= 10; i++ {
+			scale := 1 + 0.01*float64(i)
+			s := score(Point{u.X * scale, u.Y * scale})
+			if s > bestScore {
+				bestScore = s
+				sum, count = 0, 0
+			}
+			if s == bestScore {
+				sum += scale
+				count++
+			}
+		}
+		return sum / count, bestScore
+	}
+	fit := func(angle float64) (rx, ry Point, score int) {
+		rx, ry = rotate(ux, angle), rotate(uy, angle)
+		sx, scoreX := bestScale(rx, func(u Point) int {
+			return timingMatch(img, frameTransform(center, u, ry), w, 1, 0)
+		})
+		sy, scoreY := bestScale(ry, func(u Point) int {
+			return timingMatch(img, frameTransform(center, rx, u), h, 0, 1)
+		})
+		rx = Point{rx.X * sx, rx.Y * sx}
+		ry = Point{ry.X * sy, ry.Y * sy}
+		return rx, ry, scoreX + scoreY
+	}
+
+	// search from the smaller angle, and prefer it if the scores are same.
+	bestScore := -1
+	var bestX, bestY Point
+	for i := 0; i <= 16; i++ {
+		angle := float64((i+1)/2) * 0.5 * math.Pi / 180
+		if i%2 == 0 {
+			angle = -angle
+		}
+		rx, ry, score := fit(angle)
+		if score > bestScore {
+			bestScore = score
+			bestX, bestY = rx, ry
+		}
+	}
+	return frameTransform(center, bestX, bestY)
+}
+
+// rotate rotates u by angle.
+func rotate(u Point, angle float64) Point {
+	sin, cos := math.Sincos(angle)
+	return Point{
+		X: u.X*cos - u.Y*sin,
+		Y: u.X*sin + u.Y*cos,
+	}
+}
+
+// timingMatch returns the number of the modules that match
+// the edge of the finder pattern and the timing pattern in the direction (dx, dy) from the origin.
+func timingMatch(img *bitmap.Image, t *Transform, n, dx, dy int) int {
+	match := 0
+	for i := 0; i < n; i++ {
+		want := bitmap.Color(i < 7 || i%2 == 0)
+		if sampleAt(img, t, float64(i*dx)+0.5, float64(i*dy)+0.5) == want {
+			match++
+		}
+	}
+	return match
+}
+
+// estimateFinderAxis estimates the rotation angle and the module size of the finder pattern.
+// The angle is in [0, π/2).
+func estimateFinderAxis(img *bitmap.Image, p FinderPattern) (angle, moduleSize float64, ok bool) {
+	// the finder pattern looks larger when it's rotated,
+	// because FindFinderPatterns measures it horizontally and vertically.
+	bestScore := -1
+	var scores [90]int
+	for i := range scores {
+		theta := float64(i) * math.Pi / 180
+		size := p.ModuleSize * math.Cos(foldAngle(theta))
+		scores[i] = finderScore(img, p.Point, theta, size)
+		if scores[i] > bestScore {
+			bestScore = scores[i]
+		}
+	}
+
+	// use the circular mean of the best angles.
+	var sumSin, sumCos float64
+	for i, score := range scores {
+		if score == bestScore {
+			sin, cos := math.Sincos(4 * float64(i) * math.Pi / 180)
+			sumSin += sin
+			sumCos += cos
+		}
+	}
+	angle = math.Atan2(sumSin, sumCos) / 4
+	if angle < 0 {
+		angle += math.Pi / 2
+	}
+
+	// refine the module size.
+	// several sizes may match equally well, so use the mean of them.
+	base := p.ModuleSize * math.Cos(foldAngle(angle))
+	bestScore = -1
+	var sumSize, count float64
+	for i := -4; i <= 4; i++ {
+		size := base * (1 + 0.025*float64(i))
+		score := finderScore(img, p.Point, angle, size)
+		if score > bestScore {
+			bestScore = score
+			sumSize, count = 0, 0
+		}
+		if score == bestScore {
+			sumSize += size
+			count++
+		}
+	}
+	moduleSize = sumSize / count
+
+	// 7x7 modules and 3x3 samples per module.
+	if bestScore < 49*9*8/10 {
+		return 0, 0, false
+	}
+	return angle, moduleSize, true
+}
+
+// refineFinderCenter searches the center of the finder pattern in sub-pixel precision.
+func refineFinderCenter(img *bitmap.Image, center Point, angle, moduleSize float64) Point {
+	step := moduleSize / 8
+	bestScore := -1
+	var sumX, sumY, count float64
+	for j := -4; j <= 4; j++ {
+		for i := -4; i <= 4; i++ {
+			c := Point{center.X + float64(i)*step, center.Y + float64(j)*step}
+			score := finderScore(img, c, angle, moduleSize)
+			if score > bestScore {
+				bestScore = score
+				sumX, sumY, count = 0, 0, 0
+			}
+			if score == bestScore {
+				sumX += c.X
+				sumY += c.Y
+				count++
+			}
+		}
+	}
+	return Point{X: sumX / count, Y: sumY / count}
+}
+
+// foldAngle folds theta into [0, π/4].
+func foldAngle(theta float64) float64 {
+	theta = math.Mod(theta, math.Pi/2)
+	if theta < 0 {
+		theta += math.Pi / 2
+	}
+	if theta > math.Pi/4 {
+		theta = math.Pi/2 - theta
+	}
+	return theta
+}
+
+// finderScore returns how well the finder pattern matches
+// when it is rotated by theta and its module size is moduleSize.
+func finderScore(img *bitmap.Image, center Point, theta, moduleSize float64) int {
+	sin, cos := math.Sincos(theta)
+	score := 0
+	for j := -3; j <= 3; j++ {
+		for i := -3; i <= 3; i++ {
+			// dark-light-dark from the outside.
+			want := ring(i, j) != 2
+			for _, dj := range [...]float64{-0.3, 0, 0.3} {
+				for _, di := range [...]float64{-0.3, 0, 0.3} {
+					x := (float64(i) + di) * moduleSize
+					y := (float64(j) + dj) * moduleSize
+					px := center.X + x*cos - y*sin
+					py := center.Y + x*sin + y*cos
+					if bool(img.BinaryAt(int(math.Floor(px)), int(math.Floor(py)))) == want {
+						score++
+					}
+				}
+			}
+		}
+	}
+	return score
+}
+
+// ring returns the distance from the center in the chessboard metric.
+func ring(i, j int) int {
+	i, j = abs(i), abs(j)
+	if i > j {
+		return i
+	}
+	return j
+}
+
+func sampleAt(img *bitmap.Image, t *Transform, x, y float64) bitmap.Color {
+	p := t.Apply(Point{x, y})
+	return img.BinaryAt(int(math.Floor(p.X)), int(math.Floor(p.Y)))
+}
@@ -0,0 +1,46 @@
+package detector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFoldAngle(t *testing.T) {
+	tests := []struct {
+		in   float64
+		want float64
+	}{
+		{0, 0},
+		{math.Pi / 8, math.Pi / 8},
+		{math.Pi / 4, math.Pi / 4},
+		{3 * math.Pi / 8, math.Pi / 8},
+		{math.Pi / 2, 0},
+		{-math.Pi / 8, math.Pi / 8},
+		{5 * math.Pi / 8, math.Pi / 8},
+	}
+	for _, tt := range tests {
+		got := foldAngle(tt.in)
+		if math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("foldAngle(%v): got %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestFrameTransform(t *testing.T) {
+	tr := frameTransform(Point{100, 50}, Point{0, 2}, Point{-2, 0})
+	tests := []struct {
+		in   Point
+		want Point
+	}{
+		{Point{3.5, 3.5}, Point{100, 50}},
+		{Point{4.5, 3.5}, Point{100, 52}},
+		{Point{3.5, 4.5}, Point{98, 50}},
+		{Point{0, 0}, Point{107, 43}},
+	}
+	for _, tt := range tests {
+		got := tr.Apply(tt.in)
+		if math.Abs(got.X-tt.want.X) > 1e-9 || math.Abs(got.Y-tt.want.Y) > 1e-9 {
+			t.Errorf("Apply(%v): got %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
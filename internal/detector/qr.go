@@ -0,0 +1,421 @@
+package detector
+
+import (
+	"errors"
+	"image"
+	"math"
+	"sort"
+
+	"github.com/shogo82148/qrcode/bitmap"
+)
+
+// ErrNotFound is returned when no symbol is found in the image.
+var ErrNotFound = errors.New("detector: symbol not found")
+
+// Result is a detected symbol.
+type Result struct {
+	// Bitmap is the sampled symbol.
+	// One pixel corresponds to one module, and there is no quiet zone.
+	Bitmap *bitmap.Image
+
+	// Corners are the corners of the symbol in the image.
+	// The order is top-left, top-right, bottom-right and bottom-left.
+	Corners [4]Point
+
+	// finders are the finder patterns of QR codes.
+	// The order is top-left, top-right and bottom-left.
+	finders [3]FinderPattern
+}
+
+// Center returns the center of the symbol in the image.
+func (r *Result) Center() Point {
+	var x, y float64
+	for _, p := range r.Corners {
+		x += p.X
+		y += p.Y
+	}
+	return Point{X: x / 4, Y: y / 4}
+}
+
+// Contains reports whether p is inside the symbol.
+func (r *Result) Contains(p Point) bool {
+	var positive, negative bool
+	for i := range r.Corners {
+		c := cross(r.Corners[i], r.Corners[(i+1)%4], p)
+		positive = positive || c > 0
+		negative = negative || c < 0
+	}
+	return !(positive && negative)
+}
+
+// Overlaps reports whether r and other seem to be the same symbol.
+func (r *Result) Overlaps(other *Result) bool {
+	return r.Contains(other.Center()) || other.Contains(r.Center())
+}
+
+// Polygon returns the corners in the integer coordinate.
+func (r *Result) Polygon() [4]image.Point {
+	var ret [4]image.Point
+	for i, p := range r.Corners {
+		ret[i] = image.Pt(int(math.Round(p.X)), int(math.Round(p.Y)))
+	}
+	return ret
+}
+
+// DetectQR detects a QR code in img.
+func DetectQR(img *bitmap.Image) (*Result, error) {
+	patterns := FindFinderPatterns(img)
+	topLeft, topRight, bottomLeft, ok := selectBestPatterns(patterns)
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return detectQR(img, topLeft, topRight, bottomLeft)
+}
+
+// DetectAllQR detects QR codes in img.
+// The results are the candidates of the symbols and sorted from the most likely.
+// Some of them may be the same symbol, so check it with Result.Overlaps.
+func DetectAllQR(img *bitmap.Image) []*Result {
+	patterns := FindFinderPatterns(img)
+	if len(patterns) > 32 {
+		patterns = patterns[:32]
+	}
+
+	type triple struct {
+		topLeft, topRight, bottomLeft FinderPattern
+		score                         float64
+	}
+	var triples []triple
+	for i := 0; i < len(patterns); i++ {
+		for j := i + 1; j < len(patterns); j++ {
+			for k := j + 1; k < len(patterns); k++ {
+				a, b, c, score, ok := orderPatterns(patterns[i], patterns[j], patterns[k])
+				if ok {
+					triples = append(triples, triple{a, b, c, score})
+				}
+			}
+		}
+	}
+	sort.SliceStable(triples, func(i, j int) bool {
+		return triples[i].score < triples[j].score
+	})
+
+	results := make([]*Result, 0, len(triples))
+	for _, t := range triples {
+		if result, err := detectQR(img, t.topLeft, t.topRight, t.bottomLeft); err == nil {
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
+func detectQR(img *bitmap.Image, topLeft, topRight, bottomLeft FinderPattern) (*Result, error) {
+	moduleSize := axisModuleSize(topLeft, topRight, bottomLeft)
+	estimated := estimateDimension(topLeft, topRight, bottomLeft, moduleSize)
+
+	var best *Transform
+	var bestDimension int
+	bestScore := -1.0
+	for _, dimension := range []int{estimated, estimated - 4, estimated + 4} {
+		if dimension < 21 || dimension > 177 {
+			continue
+		}
+		t := qrTransform(img, topLeft, topRight, bottomLeft, dimension, moduleSize)
+		score := timingScore(img, t, dimension)
+		if score > bestScore {
+			best = t
+			bestDimension = dimension
+			bestScore = score
+		}
+	}
+	if best == nil {
+		return nil, ErrNotFound
+	}
+	return newQRResult(img, best, bestDimension, topLeft, topRight, bottomLeft), nil
+}
+
+// ResampleQR samples the QR code r again, assuming that it has dimension x dimension modules.
+// It is useful when the dimension is known from the version information.
+func ResampleQR(img *bitmap.Image, r *Result, dimension int) (*Result, error) {
+	if dimension < 21 || dimension > 177 || dimension%4 != 1 {
+		return nil, ErrNotFound
+	}
+	topLeft, topRight, bottomLeft := r.finders[0], r.finders[1], r.finders[2]
+	if topLeft.ModuleSize == 0 {
+		// r is not a QR code.
+		return nil, ErrNotFound
+	}
+	moduleSize := axisModuleSize(topLeft, topRight, bottomLeft)
+	t := qrTransform(img, topLeft, topRight, bottomLeft, dimension, moduleSize)
+	return newQRResult(img, t, dimension, topLeft, topRight, bottomLeft), nil
+}
+
+// FinderSamplers returns the samplers around the finder patterns of the QR code r.
+// Each sampler uses the module coordinate where the center of the finder pattern is (3.5, 3.5),
+// and its module size is measured from the finder pattern itself.
+// So it can read the modules near the finder pattern even if the dimension of r is wrong.
+// The order is top-left, top-right and bottom-left.
+func FinderSamplers(img *bitmap.Image, r *Result) ([3]*Sampler, bool) {
+	var samplers [3]*Sampler
+	topLeft, topRight, bottomLeft := r.finders[0], r.finders[1], r.finders[2]
+	if topLeft.ModuleSize == 0 {
+		// r is not a QR code.
+		return samplers, false
+	}
+
+	// the directions of the axes.
+	dx := Point{topRight.X - topLeft.X, topRight.Y - topLeft.Y}
+	dy := Point{bottomLeft.X - topLeft.X, bottomLeft.Y - topLeft.Y}
+	lx := math.Hypot(dx.X, dx.Y)
+	ly := math.Hypot(dy.X, dy.Y)
+
+	for i, p := range r.finders {
+		angle, moduleSize, ok := estimateFinderAxis(img, p)
+		if !ok {
+			return samplers, false
+		}
+		center := refineFinderCenter(img, p.Point, angle, moduleSize)
+		ux := Point{dx.X / lx * moduleSize, dx.Y / lx * moduleSize}
+		uy := Point{dy.X / ly * moduleSize, dy.Y / ly * moduleSize}
+		samplers[i] = &Sampler{img: img, t: frameTransform(center, ux, uy)}
+	}
+	return samplers, true
+}
+
+func newQRResult(img *bitmap.Image, t *Transform, dimension int, topLeft, topRight, bottomLeft FinderPattern) *Result {
+	result := newResult(img, t, dimension, dimension)
+	result.finders = [3]FinderPattern{topLeft, topRight, bottomLeft}
+	return result
+}
+
+// selectBestPatterns selects three finder patterns that are most likely to be a QR code.
+func selectBestPatterns(patterns []FinderPattern) (topLeft, topRight, bottomLeft FinderPattern, ok bool) {
+	if len(patterns) > 16 {
+		patterns = patterns[:16]
+	}
+
+	bestScore := math.Inf(1)
+	for i := 0; i < len(patterns); i++ {
+		for j := i + 1; j < len(patterns); j++ {
+			for k := j + 1; k < len(patterns); k++ {
+				a, b, c, score, found := orderPatterns(patterns[i], patterns[j], patterns[k])
+				if found && score < bestScore {
+					topLeft, topRight, bottomLeft = a, b, c
+					bestScore = score
+					ok = true
+				}
+			}
+		}
+	}
+	return
+}
+
+// orderPatterns orders three finder patterns to top-left, top-right and bottom-left,
+// and returns how far they are from the ideal arrangement.
+func orderPatterns(p0, p1, p2 FinderPattern) (topLeft, topRight, bottomLeft FinderPattern, score float64, ok bool) {
+	// the module sizes must be similar.
+	minSize := math.Min(p0.ModuleSize, math.Min(p1.ModuleSize, p2.ModuleSize))
+	maxSize := math.Max(p0.ModuleSize, math.Max(p1.ModuleSize, p2.ModuleSize))
+	if maxSize > minSize*1.4 {
+		return
+	}
+
+	// the top-left pattern is opposite to the longest side.
+	d01 := p0.distance(p1.Point)
+	d12 := p1.distance(p2.Point)
+	d02 := p0.distance(p2.Point)
+	var a, b, hypotenuse float64
+	switch {
+	case d12 >= d01 && d12 >= d02:
+		topLeft, topRight, bottomLeft = p0, p1, p2
+		a, b, hypotenuse = d01, d02, d12
+	case d02 >= d01 && d02 >= d12:
+		topLeft, topRight, bottomLeft = p1, p0, p2
+		a, b, hypotenuse = d01, d12, d02
+	default:
+		topLeft, topRight, bottomLeft = p2, p0, p1
+		a, b, hypotenuse = d02, d12, d01
+	}
+
+	// the smallest QR code has 14 modules between the centers of finder patterns.
+	moduleSize := axisModuleSize(topLeft, topRight, bottomLeft)
+	if math.Min(a, b) < 12*moduleSize {
+		return
+	}
+
+	// the patterns must make an isosceles right triangle.
+	ratio := math.Abs(a-b) / math.Max(a, b)
+	pythagoras := math.Abs(hypotenuse*hypotenuse-a*a-b*b) / (hypotenuse * hypotenuse)
+	if ratio > 0.3 || pythagoras > 0.3 {
+		return
+	}
+
+	// ensure the order is clockwise.
+	if cross(topLeft.Point, topRight.Point, bottomLeft.Point) < 0 {
+		topRight, bottomLeft = bottomLeft, topRight
+	}
+
+	score = ratio + pythagoras - 0.01*float64(p0.Count+p1.Count+p2.Count)
+	ok = true
+	return
+}
+
+// axisModuleSize returns the module size along the axis from the top-left to the top-right.
+// FindFinderPatterns measures the patterns horizontally and vertically,
+// so they look larger by 1/cos(theta) when the symbol is rotated by theta.
+func axisModuleSize(topLeft, topRight, bottomLeft FinderPattern) float64 {
+	moduleSize := (topLeft.ModuleSize + topRight.ModuleSize + bottomLeft.ModuleSize) / 3
+	theta := math.Atan2(topRight.Y-topLeft.Y, topRight.X-topLeft.X)
+	return moduleSize * math.Cos(foldAngle(theta))
+}
+
+// cross returns the z-component of (b-a)x(c-a).
+func cross(a, b, c Point) float64 {
+	return (b.X-a.X)*(c.Y-a.Y) - (b.Y-a.Y)*(c.X-a.X)
+}
+
+// estimateDimension estimates the number of modules on a side.
+func estimateDimension(topLeft, topRight, bottomLeft FinderPattern, moduleSize float64) int {
+	tltr := int(math.Round(topLeft.distance(topRight.Point) / moduleSize))
+	tlbl := int(math.Round(topLeft.distance(bottomLeft.Point) / moduleSize))
+	dimension := (tltr+tlbl)/2 + 7
+	switch dimension % 4 {
+	case 0:
+		dimension++
+	case 2:
+		dimension--
+	case 3:
+		dimension += 2
+	}
+	return dimension
+}
+
+// qrTransform returns the transform from the module coordinate to the image coordinate.
+func qrTransform(img *bitmap.Image, topLeft, topRight, bottomLeft FinderPattern, dimension int, moduleSize float64) *Transform {
+	d := float64(dimension)
+
+	// estimate the position of the bottom-right corner.
+	bottomRight := Point{
+		X: topRight.X - topLeft.X + bottomLeft.X,
+		Y: topRight.Y - topLeft.Y + bottomLeft.Y,
+	}
+	target := d - 3.5
+	if dimension > 21 {
+		// search the alignment pattern at the bottom-right corner.
+		affine := QuadrilateralToQuadrilateral(
+			3.5, 3.5, d-3.5, 3.5, d-3.5, d-3.5, 3.5, d-3.5,
+			topLeft.X, topLeft.Y, topRight.X, topRight.Y,
+			bottomRight.X, bottomRight.Y, bottomLeft.X, bottomLeft.Y,
+		)
+		// the perspective distortion moves it away from the estimated position,
+		// so widen the search gradually before falling back to the affine estimate.
+		for _, radius := range []float64{4, 8, 16} {
+			if p, ok := findAlignmentPattern(img, affine, d-6.5, d-6.5, radius*moduleSize); ok {
+				bottomRight = p
+				target = d - 6.5
+				break
+			}
+		}
+	}
+
+	return QuadrilateralToQuadrilateral(
+		3.5, 3.5, d-3.5, 3.5, target, target, 3.5, d-3.5,
+		topLeft.X, topLeft.Y, topRight.X, topRight.Y,
+		bottomRight.X, bottomRight.Y, bottomLeft.X, bottomLeft.Y,
+	)
+}
+
+// findAlignmentPattern searches the alignment pattern within radius pixels around (x, y) in the module coordinate.
+// If there are several candidates, it chooses the nearest one to (x, y).
+func findAlignmentPattern(img *bitmap.Image, t *Transform, x, y, radius float64) (Point, bool) {
+	estimated := t.Apply(Point{x, y})
+
+	// the unit vectors of the module coordinate in the image.
+	dx := t.Apply(Point{x + 1, y})
+	dy := t.Apply(Point{x, y + 1})
+	ux := Point{dx.X - estimated.X, dx.Y - estimated.Y}
+	uy := Point{dy.X - estimated.X, dy.Y - estimated.Y}
+
+	r := int(math.Ceil(radius))
+	cx, cy := int(math.Round(estimated.X)), int(math.Round(estimated.Y))
+	bestScore := 0
+	var candidates []Point
+	for py := cy - r; py <= cy+r; py++ {
+		for px := cx - r; px <= cx+r; px++ {
+			score := 0
+			for j := -2; j <= 2; j++ {
+				for i := -2; i <= 2; i++ {
+					// the alignment pattern is dark-light-dark from the outside.
+					want := ring(i, j) != 1
+					sx := float64(px) + 0.5 + float64(i)*ux.X + float64(j)*uy.X
+					sy := float64(py) + 0.5 + float64(i)*ux.Y + float64(j)*uy.Y
+					if bool(img.BinaryAt(int(math.Floor(sx)), int(math.Floor(sy)))) == want {
+						score++
+					}
+				}
+			}
+			if score > bestScore {
+				bestScore = score
+				candidates = candidates[:0]
+			}
+			if score == bestScore {
+				candidates = append(candidates, Point{X: float64(px) + 0.5, Y: float64(py) + 0.5})
+			}
+		}
+	}
+	if bestScore < 23 {
+		return Point{}, false
+	}
+
+	// a wide search may hit other alignment patterns.
+	nearest := candidates[0]
+	for _, p := range candidates[1:] {
+		if p.distance(estimated) < nearest.distance(estimated) {
+			nearest = p
+		}
+	}
+
+	// there may be several best positions if the module is larger than a pixel.
+	// use the center of them.
+	moduleSize := math.Max(math.Hypot(ux.X, ux.Y), math.Hypot(uy.X, uy.Y))
+	var sumX, sumY, count float64
+	for _, p := range candidates {
+		if p.distance(nearest) <= moduleSize {
+			sumX += p.X
+			sumY += p.Y
+			count++
+		}
+	}
+	return Point{X: sumX / count, Y: sumY / count}, true
+}
+
+// timingScore returns the ratio of the modules that match the timing patterns.
+func timingScore(img *bitmap.Image, t *Transform, dimension int) float64 {
+	var match, total int
+	for i := 8; i < dimension-8; i++ {
+		want := bitmap.Color(i%2 == 0)
+		if sampleAt(img, t, float64(i)+0.5, 6.5) == want {
+			match++
+		}
+		if sampleAt(img, t, 6.5, float64(i)+0.5) == want {
+			match++
+		}
+		total += 2
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(match) / float64(total)
+}
+
+// Sample samples the grid of width x height modules.
+// t transforms the module coordinate to the image coordinate.
+func Sample(img *bitmap.Image, t *Transform, width, height int) *bitmap.Image {
+	ret := bitmap.New(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			ret.SetBinary(x, y, sampleAt(img, t, float64(x)+0.5, float64(y)+0.5))
+		}
+	}
+	return ret
+}
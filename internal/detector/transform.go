@@ -0,0 +1,101 @@
+package detector
+
+import "math"
+
+// Point is a point in the image coordinate.
+type Point struct {
+	X, Y float64
+}
+
+func (p Point) distance(q Point) float64 {
+	return math.Hypot(p.X-q.X, p.Y-q.Y)
+}
+
+// Transform is a perspective transform.
+type Transform struct {
+	a11, a12, a13 float64
+	a21, a22, a23 float64
+	a31, a32, a33 float64
+}
+
+// QuadrilateralToQuadrilateral returns the perspective transform that maps
+// the quadrilateral (x0, y0)-(x1, y1)-(x2, y2)-(x3, y3)
+// to the quadrilateral (x0p, y0p)-(x1p, y1p)-(x2p, y2p)-(x3p, y3p).
+func QuadrilateralToQuadrilateral(
+	x0, y0, x1, y1, x2, y2, x3, y3 float64,
+	x0p, y0p, x1p, y1p, x2p, y2p, x3p, y3p float64,
+) *Transform {
+	// from https://github.com/zxing/zxing/blob/99e9b34f5afc21fdaeead283d5ed0bc1314cbec1/core/src/main/java/com/google/zxing/common/PerspectiveTransform.java
+	qToS := quadrilateralToSquare(x0, y0, x1, y1, x2, y2, x3, y3)
+	sToQ := squareToQuadrilateral(x0p, y0p, x1p, y1p, x2p, y2p, x3p, y3p)
+	return sToQ.times(qToS)
+}
+
+// Apply applies the transform to p.
+func (t *Transform) Apply(p Point) Point {
+	denominator := t.a13*p.X + t.a23*p.Y + t.a33
+	return Point{
+		X: (t.a11*p.X + t.a21*p.Y + t.a31) / denominator,
+		Y: (t.a12*p.X + t.a22*p.Y + t.a32) / denominator,
+	}
+}
+
+func squareToQuadrilateral(x0, y0, x1, y1, x2, y2, x3, y3 float64) *Transform {
+	dx3 := x0 - x1 + x2 - x3
+	dy3 := y0 - y1 + y2 - y3
+	if dx3 == 0 && dy3 == 0 {
+		// Affine
+		return &Transform{
+			a11: x1 - x0, a21: x2 - x1, a31: x0,
+			a12: y1 - y0, a22: y2 - y1, a32: y0,
+			a13: 0, a23: 0, a33: 1,
+		}
+	}
+
+	dx1 := x1 - x2
+	dx2 := x3 - x2
+	dy1 := y1 - y2
+	dy2 := y3 - y2
+	denominator := dx1*dy2 - dx2*dy1
+	a13 := (dx3*dy2 - dx2*dy3) / denominator
+	a23 := (dx1*dy3 - dx3*dy1) / denominator
+	return &Transform{
+		a11: x1 - x0 + a13*x1, a21: x3 - x0 + a23*x3, a31: x0,
+		a12: y1 - y0 + a13*y1, a22: y3 - y0 + a23*y3, a32: y0,
+		a13: a13, a23: a23, a33: 1,
+	}
+}
+
+func quadrilateralToSquare(x0, y0, x1, y1, x2, y2, x3, y3 float64) *Transform {
+	// Here, the adjoint serves as the inverse
+	return squareToQuadrilateral(x0, y0, x1, y1, x2, y2, x3, y3).adjoint()
+}
+
+func (t *Transform) adjoint() *Transform {
+	// Adjoint is the transpose of the cofactor matrix:
+	return &Transform{
+		a11: t.a22*t.a33 - t.a23*t.a32,
+		a21: t.a23*t.a31 - t.a21*t.a33,
+		a31: t.a21*t.a32 - t.a22*t.a31,
+		a12: t.a13*t.a32 - t.a12*t.a33,
+		a22: t.a11*t.a33 - t.a13*t.a31,
+		a32: t.a12*t.a31 - t.a11*t.a32,
+		a13: t.a12*t.a23 - t.a13*t.a22,
+		a23: t.a13*t.a21 - t.a11*t.a23,
+		a33: t.a11*t.a22 - t.a12*t.a21,
+	}
+}
+
+func (t *Transform) times(other *Transform) *Transform {
+	return &Transform{
+		a11: t.a11*other.a11 + t.a21*other.a12 + t.a31*other.a13,
+		a21: t.a11*other.a21 + t.a21*other.a22 + t.a31*other.a23,
+		a31: t.a11*other.a31 + t.a21*other.a32 + t.a31*other.a33,
+		a12: t.a12*other.a11 + t.a22*other.a12 + t.a32*other.a13,
+		a22: t.a12*other.a21 + t.a22*other.a22 + t.a32*other.a23,
+		a32: t.a12*other.a31 + t.a22*other.a32 + t.a32*other.a33,
+		a13: t.a13*other.a11 + t.a23*other.a12 + t.a33*other.a13,
+		a23: t.a13*other.a21 + t.a23*other.a22 + t.a33*other.a23,
+		a33: t.a13*other.a31 + t.a23*other.a32 + t.a33*other.a33,
+	}
+}
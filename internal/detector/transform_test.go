@@ -0,0 +1,31 @@
+package detector
+
+import (
+	"math"
+	"testing"
+)
+
+func TestQuadrilateralToQuadrilateral(t *testing.T) {
+	// from https://github.com/zxing/zxing/blob/99e9b34f5afc21fdaeead283d5ed0bc1314cbec1/core/src/test/java/com/google/zxing/common/PerspectiveTransformTestCase.java
+	transform := QuadrilateralToQuadrilateral(
+		2, 3, 10, 4, 16, 15, 4, 9,
+		103, 110, 300, 120, 290, 270, 150, 280,
+	)
+	tests := []struct {
+		in   Point
+		want Point
+	}{
+		{Point{2, 3}, Point{103, 110}},
+		{Point{10, 4}, Point{300, 120}},
+		{Point{16, 15}, Point{290, 270}},
+		{Point{4, 9}, Point{150, 280}},
+		{Point{0.5, 0.5}, Point{7.1516876, -64.60185}},
+		{Point{50, 50}, Point{328.09116, 334.16385}},
+	}
+	for _, tt := range tests {
+		got := transform.Apply(tt.in)
+		if math.Abs(got.X-tt.want.X) > 1e-3 || math.Abs(got.Y-tt.want.Y) > 1e-3 {
+			t.Errorf("Apply(%v): got %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
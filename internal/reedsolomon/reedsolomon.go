@@ -1,6 +1,7 @@
 package reedsolomon
 
 import (
+	"errors"
 	"fmt"
 	"hash"
 
@@ -20,7 +21,10 @@ func New(n int) hash.Hash {
 	return coders[n]()
 }
 
-func Decode(data []byte, twoS int) error {
+// Decode corrects the errors in data in place.
+// twoS is the number of error correction codewords in data.
+// It returns the number of corrected codewords.
+func Decode(data []byte, twoS int) (int, error) {
 	// from https://github.com/zxing/zxing/blob/99e9b34f5afc21fdaeead283d5ed0bc1314cbec1/core/src/main/java/com/google/zxing/common/reedsolomon/ReedSolomonDecoder.java#L49-L86
 
 	syndrome := make(poly.Poly, twoS)
@@ -32,23 +36,26 @@ func Decode(data []byte, twoS int) error {
 		syndrome[len(syndrome)-1-i] = ret
 	}
 	if noError {
-		return nil
+		return 0, nil
 	}
 	sigma, omega, err := poly.EuclideanAlgorithm(poly.NewMonomial(twoS, element.One), syndrome, twoS)
 	if err != nil {
-		return fmt.Errorf("reedsolomon: failed to decode: %w", err)
+		return 0, fmt.Errorf("reedsolomon: failed to decode: %w", err)
 	}
 	errorLocations := findErrorLocations(sigma)
+	if len(errorLocations) != sigma.Degree() {
+		return 0, errors.New("reedsolomon: error locator degree does not match number of roots")
+	}
 	errorMagnitudes := findErrorMagnitudes(omega, errorLocations)
 
 	for i := range errorLocations {
 		pos := len(data) - 1 - element.Log(errorLocations[i])
 		if pos < 0 {
-			return fmt.Errorf("reedsolomon: bad location: %d", pos)
+			return 0, fmt.Errorf("reedsolomon: bad location: %d", pos)
 		}
 		data[pos] = byte(element.Add(element.Element(data[pos]), errorMagnitudes[i]))
 	}
-	return nil
+	return len(errorLocations), nil
 }
 
 func findErrorLocations(sigma poly.Poly) []element.Element {
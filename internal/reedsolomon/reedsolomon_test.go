@@ -82,7 +82,7 @@ func TestDecode_NoError(t *testing.T) {
 		0b0011_0000,
 	}
 
-	if err := Decode(data, 2); err != nil {
+	if _, err := Decode(data, 2); err != nil {
 		t.Fatal(err)
 	}
 }
@@ -101,7 +101,7 @@ func TestDecode_WithError(t *testing.T) {
 		0b0011_0000,
 	}
 
-	if err := Decode(data, 2); err != nil {
+	if _, err := Decode(data, 2); err != nil {
 		t.Fatal(err)
 	}
 
@@ -134,7 +134,46 @@ func TestDecode_FailCorrection(t *testing.T) {
 		0b0011_0000 ^ 0b0101_0101, /* Error! */
 	}
 
-	if err := Decode(data, 2); err == nil {
+	if _, err := Decode(data, 2); err == nil {
 		t.Error("want error, but not")
 	}
 }
+
+func TestDecode_MultipleErrors(t *testing.T) {
+	// JIS X 0510: 2018
+	// 附属書1
+	// シンボルの符号化例
+	want := []byte{
+		// data
+		0b0001_0000, 0b0010_0000, 0b0000_1100, 0b0101_0110,
+		0b0110_0001, 0b1000_0000,
+
+		0b1110_1100, 0b0001_0001,
+		0b1110_1100, 0b0001_0001,
+		0b1110_1100, 0b0001_0001,
+		0b1110_1100, 0b0001_0001,
+		0b1110_1100, 0b0001_0001,
+
+		// error correction codes
+		0b1010_0101, 0b0010_0100, 0b1101_0100, 0b1100_0001,
+		0b1110_1101, 0b0011_0110, 0b1100_0111, 0b1000_0111,
+		0b0010_1100, 0b0101_0101,
+	}
+	data := append([]byte(nil), want...)
+	data[0] ^= 0xff
+	data[3] ^= 0x01
+	data[9] ^= 0x80
+	data[17] ^= 0x55
+	data[25] ^= 0xaa
+
+	n, err := Decode(data, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 5 {
+		t.Errorf("unexpected number of corrections: got %d, want %d", n, 5)
+	}
+	if !bytes.Equal(data, want) {
+		t.Errorf("got %08b, want %08b", data, want)
+	}
+}
@@ -2,6 +2,8 @@ package microqr
 
 import (
 	"errors"
+	"fmt"
+	"image"
 	"io"
 	"math/bits"
 	"strconv"
@@ -9,28 +11,141 @@ import (
 	"github.com/shogo82148/qrcode/bitmap"
 	internalbitmap "github.com/shogo82148/qrcode/internal/bitmap"
 	"github.com/shogo82148/qrcode/internal/bitstream"
+	"github.com/shogo82148/qrcode/internal/detector"
 	"github.com/shogo82148/qrcode/internal/reedsolomon"
 )
 
+// DecodeInfo is the detail of decoding a Micro QR code.
+type DecodeInfo struct {
+	// Transform is the transform that was applied to the image to decode it.
+	Transform bitmap.Transform
+
+	// FormatCopy is the copy of the format information that was used.
+	// Micro QR codes have only one copy, so it is always 1.
+	FormatCopy int
+
+	// FormatDistance is the Hamming distance between the format information
+	// and the nearest valid codeword, i.e. the number of bit errors.
+	FormatDistance int
+
+	// Corrected is the number of corrected codewords in each block.
+	// Micro QR codes always have exactly one block.
+	Corrected []int
+}
+
+// Decode decodes a Micro QR code from the image.
+// Unlike DecodeBitmap, img can be an arbitrary image such as a photograph.
+func Decode(img image.Image) (*QRCode, error) {
+	for _, binarize := range [...]func(image.Image) *bitmap.Image{bitmap.BinarizeHybrid, bitmap.Binarize} {
+		binimg := binarize(img)
+
+		// try light-on-dark symbols too.
+		for _, t := range [...]bitmap.Transform{{}, {Invert: true}} {
+			if qr, ok := decode(t.Apply(binimg)); ok {
+				return qr, nil
+			}
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func decode(binimg *bitmap.Image) (*QRCode, bool) {
+	for _, candidates := range detector.DetectMicroQR(binimg, symbolSize) {
+		for _, result := range candidates {
+			if qr, err := DecodeBitmap(result.Bitmap); err == nil {
+				return qr, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// symbolSize reads the format information, and returns the size of the symbol.
+func symbolSize(s *detector.Sampler) (width, height int, ok bool) {
+	rawFormat := readFormat(s.Module)
+	version, _, _, _, ok := decodeFormat(rawFormat)
+	if !ok {
+		return 0, 0, false
+	}
+	w := 9 + 2*int(version)
+	return w, w, true
+}
+
+// Symbol is a Micro QR code found in an image.
+type Symbol struct {
+	QRCode *QRCode
+
+	// Corners are the corners of the symbol in the image.
+	// The order is top-left, top-right, bottom-right and bottom-left.
+	Corners [4]image.Point
+}
+
+// DecodeAll decodes all Micro QR codes in the image.
+func DecodeAll(img image.Image) []*Symbol {
+	binimg := bitmap.BinarizeHybrid(img)
+	var found []*detector.Result
+	var symbols []*Symbol
+LOOP:
+	for _, candidates := range detector.DetectMicroQR(binimg, symbolSize) {
+		for _, result := range candidates {
+			for _, f := range found {
+				if f.Overlaps(result) {
+					continue LOOP
+				}
+			}
+			qr, err := DecodeBitmap(result.Bitmap)
+			if err != nil {
+				continue
+			}
+			found = append(found, result)
+			symbols = append(symbols, &Symbol{
+				QRCode:  qr,
+				Corners: result.Polygon(),
+			})
+			continue LOOP
+		}
+	}
+	return symbols
+}
+
+// DecodeBitmap decodes a Micro QR code from the bitmap.
+// img must be exactly one pixel per module without quiet zone.
 func DecodeBitmap(img *bitmap.Image) (*QRCode, error) {
+	qr, _, err := DecodeBitmapWithInfo(img)
+	return qr, err
+}
+
+// DecodeBitmapWithInfo is like DecodeBitmap, but it also returns the detail of decoding.
+// It tries the rotated, mirrored and inverted images of img, and reports the used one in DecodeInfo.Transform.
+func DecodeBitmapWithInfo(img *bitmap.Image) (*QRCode, *DecodeInfo, error) {
+	var firstErr error
+	for _, t := range bitmap.Transforms() {
+		qr, info, err := decodeBitmap(t.Apply(img))
+		if err == nil {
+			info.Transform = t
+			return qr, info, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return nil, nil, firstErr
+}
+
+func decodeBitmap(img *bitmap.Image) (*QRCode, *DecodeInfo, error) {
 	bounds := img.Bounds()
 	version := Version((bounds.Dx() - 9) / 2)
 	binimg := internalbitmap.Import(img)
 	w := 8 + 2*int(version)
 
 	// decode format
-	var rawFormat uint
-	for i := 0; i < 8; i++ {
-		if binimg.BinaryAt(8, i+1) {
-			rawFormat |= 1 << i
-		}
-		if binimg.BinaryAt(i+1, 8) {
-			rawFormat |= 1 << (14 - i)
-		}
-	}
-	version, level, mask, ok := decodeFormat(rawFormat)
+	rawFormat := readFormat(binimg.BinaryAt)
+	version, level, mask, formatDistance, ok := decodeFormat(rawFormat)
 	if !ok {
-		return nil, errors.New("qr code not found")
+		return nil, nil, ErrFormatNotFound
+	}
+	if bounds.Dx() != 9+2*int(version) || bounds.Dy() != bounds.Dx() {
+		return nil, nil, fmt.Errorf("microqr: invalid size for version %d: %dx%d", version, bounds.Dx(), bounds.Dy())
 	}
 
 	w = 8 + 2*int(version)
@@ -78,28 +193,55 @@ func DecodeBitmap(img *bitmap.Image) (*QRCode, error) {
 		}
 	}
 
-	data := buf.Bytes()
-	if err := reedsolomon.Decode(data, qrCapacity.MaxError); err != nil {
-		return nil, err
+	data := buf.Bytes()[:qrCapacity.Total]
+	n, err := reedsolomon.Decode(data, qrCapacity.Correction)
+	if err != nil || n > qrCapacity.MaxError {
+		return nil, nil, &TooManyErrorsError{Block: 0}
 	}
 	data = data[:qrCapacity.Data]
 	buf0 := bitstream.NewBuffer(data)
 
+	var qr *QRCode
 	switch version {
 	case 1:
-		return decodeVersion1(buf0, mask, level)
+		qr, err = decodeVersion1(buf0, mask, level)
 	case 2:
-		return decodeVersion2(buf0, mask, level)
+		qr, err = decodeVersion2(buf0, mask, level)
 	case 3:
-		return decodeVersion3(buf0, mask, level)
+		qr, err = decodeVersion3(buf0, mask, level)
 	case 4:
-		return decodeVersion4(buf0, mask, level)
+		qr, err = decodeVersion4(buf0, mask, level)
 	default:
 		panic("invalid version: " + strconv.Itoa(int(version)))
 	}
+	if err != nil {
+		return nil, nil, err
+	}
+	info := &DecodeInfo{
+		FormatCopy:     1,
+		FormatDistance: formatDistance,
+		Corrected:      []int{n},
+	}
+	return qr, info, nil
+}
+
+// readFormat reads the format information through at.
+func readFormat(at func(x, y int) bitmap.Color) uint {
+	var rawFormat uint
+	for i := 0; i < 8; i++ {
+		if at(8, i+1) {
+			rawFormat |= 1 << i
+		}
+		if at(i+1, 8) {
+			rawFormat |= 1 << (14 - i)
+		}
+	}
+	return rawFormat
 }
 
-func decodeFormat(raw uint) (Version, Level, Mask, bool) {
+// decodeFormat returns the format nearest to raw, and the Hamming distance between them.
+// It returns false if raw has more than 3 bit errors.
+func decodeFormat(raw uint) (version Version, level Level, mask Mask, distance int, ok bool) {
 	idx := 0
 	min := bits.OnesCount(encodedFormat[0] ^ raw)
 	for i, pattern := range encodedFormat {
@@ -109,11 +251,11 @@ func decodeFormat(raw uint) (Version, Level, Mask, bool) {
 			min = count
 		}
 	}
-	if min >= 3 {
-		return 0, 0, 0, false
+	if min > 3 {
+		return 0, 0, 0, 0, false
 	}
 	format := rawFormatTable[idx>>2]
-	return format.version, format.level, Mask(idx & 0b11), true
+	return format.version, format.level, Mask(idx & 0b11), min, true
 }
 
 func decodeVersion1(buf *bitstream.Buffer, mask Mask, level Level) (*QRCode, error) {
@@ -189,7 +331,7 @@ LOOP:
 				return nil, err
 			}
 		default:
-			return nil, errors.New("qrcode: unknown mode: " + strconv.Itoa(int(mode)))
+			return nil, &InvalidModeError{Mode: Mode(mode)}
 		}
 		segments = append(segments, Segment{
 			Mode: Mode(mode),
@@ -271,7 +413,7 @@ LOOP:
 				return nil, err
 			}
 		default:
-			return nil, errors.New("qrcode: unknown mode: " + strconv.Itoa(int(mode)))
+			return nil, &InvalidModeError{Mode: Mode(mode)}
 		}
 		segments = append(segments, Segment{
 			Mode: Mode(mode),
@@ -353,7 +495,7 @@ LOOP:
 				return nil, err
 			}
 		default:
-			return nil, errors.New("qrcode: unknown mode: " + strconv.Itoa(int(mode)))
+			return nil, &InvalidModeError{Mode: Mode(mode)}
 		}
 		if len(data) == 0 {
 			continue
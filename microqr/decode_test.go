@@ -3,9 +3,11 @@ package microqr
 import (
 	"bytes"
 	"image"
+	"image/color"
 	"image/png"
 	"math"
 	"os"
+	"reflect"
 	"testing"
 
 	bitmap "github.com/shogo82148/qrcode/bitmap"
@@ -210,3 +212,178 @@ func TestDecodeBitmap6(t *testing.T) {
 func round(x float64) int {
 	return int(math.Round(x))
 }
+
+func TestDecodeBitmapWithInfo_Damaged(t *testing.T) {
+	qr, err := New([]byte("01234567"), WithLevel(LevelM))
+	if err != nil {
+		t.Fatal(err)
+	}
+	binimg, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// scratch the symbol.
+	w := binimg.Bounds().Dx()
+	for y := w - 3; y < w; y++ {
+		for x := w - 3; x < w; x++ {
+			binimg.SetBinary(x, y, !binimg.BinaryAt(x, y))
+		}
+	}
+
+	got, info, err := DecodeBitmapWithInfo(binimg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got.Segments[0].Data) != "01234567" {
+		t.Errorf("unexpected data: got %q, want %q", got.Segments[0].Data, "01234567")
+	}
+	if len(info.Corrected) != 1 || info.Corrected[0] < 2 {
+		t.Errorf("unexpected corrected codewords: %v", info.Corrected)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"01.png", "MICROQR"},
+		{"02.png", "12345"},
+		{"03.png", "1haicso"},
+		{"04.png", "AINIX"},
+		{"05.png", "0000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := os.Open("testdata/" + tt.name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Close()
+			img, err := png.Decode(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			qr, err := Decode(img)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := string(qr.Segments[0].Data); got != tt.want {
+				t.Errorf("unexpected data: got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecode_Rotate(t *testing.T) {
+	data := "MICRO QR 12345"
+	img, err := Encode([]byte(data), WithModuleSize(8), WithLevel(LevelL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, angle := range []float64{0, 10, 45, 80, 135, 200, 300} {
+		rotated := rotate(img, angle*math.Pi/180)
+		qr, err := Decode(rotated)
+		if err != nil {
+			t.Errorf("angle %v: %v", angle, err)
+			continue
+		}
+		var got []byte
+		for _, seg := range qr.Segments {
+			got = append(got, seg.Data...)
+		}
+		if string(got) != data {
+			t.Errorf("angle %v: unexpected data: got %q, want %q", angle, got, data)
+		}
+	}
+}
+
+// rotate rotates img by angle.
+func rotate(img image.Image, angle float64) image.Image {
+	bounds := img.Bounds()
+	size := int(float64(bounds.Dx()) * 1.5)
+	ret := image.NewGray(image.Rect(0, 0, size, size))
+	cx, cy := float64(size)/2, float64(size)/2
+	sx, sy := float64(bounds.Min.X+bounds.Max.X)/2, float64(bounds.Min.Y+bounds.Max.Y)/2
+	sin, cos := math.Sincos(angle)
+	for Y := 0; Y < size; Y++ {
+		for X := 0; X < size; X++ {
+			u, v := float64(X)-cx, float64(Y)-cy
+			x := cos*u + sin*v + sx
+			y := -sin*u + cos*v + sy
+			p := image.Pt(int(math.Floor(x)), int(math.Floor(y)))
+			if !p.In(bounds) {
+				ret.SetGray(X, Y, color.Gray{Y: 0xff})
+				continue
+			}
+			ret.Set(X, Y, img.At(p.X, p.Y))
+		}
+	}
+	return ret
+}
+
+func TestDecodeBitmapWithInfo_Transform(t *testing.T) {
+	qr, err := New([]byte("01234567"), WithLevel(LevelM))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tr := range bitmap.Transforms() {
+		img := tr.Apply(want)
+		got, info, err := DecodeBitmapWithInfo(img)
+		if err != nil {
+			t.Errorf("%+v: %v", tr, err)
+			continue
+		}
+		if string(got.Segments[0].Data) != "01234567" {
+			t.Errorf("%+v: unexpected data: got %q, want %q", tr, got.Segments[0].Data, "01234567")
+		}
+
+		// info.Transform restores the original image.
+		restored := info.Transform.Apply(img)
+		if !reflect.DeepEqual(restored, want) {
+			t.Errorf("%+v: unexpected transform: %+v", tr, info.Transform)
+		}
+	}
+}
+
+func TestDecodeBitmapWithInfo_FormatDistance(t *testing.T) {
+	qr, err := New([]byte("01234567"), WithLevel(LevelM))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := DecodeBitmap(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 3 bit errors in the format information.
+	for i := 1; i <= 3; i++ {
+		img.SetBinary(8, i, !img.BinaryAt(8, i))
+	}
+
+	got, info, err := DecodeBitmapWithInfo(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Version != want.Version || got.Level != want.Level || got.Mask != want.Mask {
+		t.Errorf("unexpected format: got %v/%v/%v, want %v/%v/%v", got.Version, got.Level, got.Mask, want.Version, want.Level, want.Mask)
+	}
+	if info.FormatCopy != 1 {
+		t.Errorf("unexpected format copy: got %d, want 1", info.FormatCopy)
+	}
+	if info.FormatDistance != 3 {
+		t.Errorf("unexpected format distance: got %d, want 3", info.FormatDistance)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"image"
 	"image/color"
+	"io"
 	"math"
 	"unicode/utf8"
 
@@ -12,6 +13,7 @@ import (
 	internalbitmap "github.com/shogo82148/qrcode/internal/bitmap"
 	"github.com/shogo82148/qrcode/internal/bitstream"
 	"github.com/shogo82148/qrcode/internal/reedsolomon"
+	"github.com/shogo82148/qrcode/render"
 )
 
 func New(data []byte, opts ...EncodeOptions) (*QRCode, error) {
@@ -20,10 +22,76 @@ func New(data []byte, opts ...EncodeOptions) (*QRCode, error) {
 	if lv < 0 || lv >= 4 {
 		return nil, fmt.Errorf("qrcode: invalid level: %d", lv)
 	}
+	if myopts.Version < 0 || myopts.Version > 4 {
+		return nil, fmt.Errorf("microqr: invalid version: %d", myopts.Version)
+	}
+	if myopts.MinVersion < 0 || myopts.MinVersion > 4 {
+		return nil, fmt.Errorf("microqr: invalid version: %d", myopts.MinVersion)
+	}
+
+	if myopts.Mask < MaskAuto || myopts.Mask >= maskMax {
+		return nil, fmt.Errorf("microqr: invalid mask: %d", myopts.Mask)
+	}
+
+	var qr *QRCode
+	var err error
 	if myopts.Kanji {
-		return newFromKanji(lv, data)
+		qr, err = newFromKanji(lv, data, myopts.ShiftJIS)
 	} else {
-		return newQR(lv, data)
+		qr, err = newQR(lv, data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := selectVersion(qr, myopts); err != nil {
+		return nil, err
+	}
+	qr.Mask = myopts.Mask
+	return qr, nil
+}
+
+// selectVersion changes the version of qr to satisfy WithVersion and WithMinVersion,
+// and then raises the level if WithBoostLevel is set.
+func selectVersion(qr *QRCode, opts encodeOptions) error {
+	if opts.Version != 0 {
+		if formatTable[opts.Version][qr.Level] < 0 {
+			return fmt.Errorf("microqr: invalid version-level pair: %d-%s", opts.Version, qr.Level)
+		}
+		if !fits(qr.Level, opts.Version, qr.Segments) {
+			return newDataTooLargeError(qr.Level, opts.Version, qr.Segments)
+		}
+		qr.Version = opts.Version
+	} else {
+		version := qr.Version
+		for version <= 4 && (version < opts.MinVersion || !fits(qr.Level, version, qr.Segments)) {
+			version++
+		}
+		if version > 4 {
+			return newDataTooLargeError(qr.Level, largestVersion(qr.Level), qr.Segments)
+		}
+		qr.Version = version
+	}
+
+	if opts.BoostLevel {
+		boostLevel(qr)
+	}
+	return nil
+}
+
+// boostLevel raises the level of qr while the data fit in the same version.
+func boostLevel(qr *QRCode) {
+	levels := [...]Level{LevelL, LevelM, LevelQ}
+	for i, lv := range levels {
+		if lv != qr.Level {
+			continue
+		}
+		for _, higher := range levels[i+1:] {
+			if !fits(higher, qr.Version, qr.Segments) {
+				return
+			}
+			qr.Level = higher
+		}
+		return
 	}
 }
 
@@ -168,7 +236,7 @@ func newQR(level Level, data []byte) (*QRCode, error) {
 
 	version := calcVersion(level, segments)
 	if version == 0 {
-		return nil, errors.New("microqr: data too large")
+		return nil, newDataTooLargeError(level, largestVersion(level), segments)
 	}
 
 	return &QRCode{
@@ -179,7 +247,7 @@ func newQR(level Level, data []byte) (*QRCode, error) {
 	}, nil
 }
 
-func newFromKanji(level Level, data []byte) (*QRCode, error) {
+func newFromKanji(level Level, data []byte, shiftJIS bool) (*QRCode, error) {
 	if len(data) == 0 {
 		version := calcVersion(level, nil)
 		return &QRCode{
@@ -202,6 +270,9 @@ func newFromKanji(level Level, data []byte) (*QRCode, error) {
 		cost     int // = bit length * 6
 		lastMode int
 		data     []byte
+
+		// text is the data in UTF-8 if data is Shift_JIS.
+		text []byte
 	}
 	states := make([][5]state, len(data)+1)
 	states[0][modeNumeric].cost = inf
@@ -209,6 +280,7 @@ func newFromKanji(level Level, data []byte) (*QRCode, error) {
 	states[0][modeBytes].cost = inf
 	states[0][modeKanji].cost = inf
 
+	kanjiRunes, kanjiSizes := bitstream.KanjiRunes(data, shiftJIS)
 	for i := 0; i < len(data); i++ {
 		if i != 0 {
 			states[i][modeInit].cost = inf
@@ -289,8 +361,7 @@ func newFromKanji(level Level, data []byte) (*QRCode, error) {
 		}
 
 		// kanji
-		r, size := utf8.DecodeRune(data[i:])
-		if r != utf8.RuneError && bitstream.IsKanji(r) && i+size < len(states) {
+		if size := kanjiSizes[i]; size > 0 && i+size < len(states) {
 			minCost := states[i][modeInit].cost + (4+12+13)*6
 			lastMode := modeInit
 			for mode := modeInit + 1; mode < modeMax; mode++ {
@@ -308,10 +379,16 @@ func newFromKanji(level Level, data []byte) (*QRCode, error) {
 				states[i+j][modeKanji].cost = inf
 			}
 
+			var text []byte
+			if shiftJIS {
+				// the data of kanji mode segments are UTF-8.
+				text = utf8.AppendRune(nil, kanjiRunes[i])
+			}
 			states[i+size][modeKanji] = state{
 				cost:     minCost,
 				lastMode: lastMode,
 				data:     data[i : i+size],
+				text:     text,
 			}
 		} else if states[i+1][modeKanji].data == nil {
 			states[i+1][modeKanji] = state{
@@ -327,6 +404,12 @@ func newFromKanji(level Level, data []byte) (*QRCode, error) {
 		mode int
 		data []byte
 	}
+	segmentData := func(s state) []byte {
+		if s.text != nil {
+			return s.text
+		}
+		return s.data
+	}
 	best := make([]elem, 0, len(data))
 	minCost := states[len(data)][modeNumeric].cost
 	bestMode := modeNumeric
@@ -338,7 +421,7 @@ func newFromKanji(level Level, data []byte) (*QRCode, error) {
 	}
 	best = append(best, elem{
 		mode: bestMode,
-		data: states[len(data)][bestMode].data,
+		data: segmentData(states[len(data)][bestMode]),
 	})
 	for i := len(data); ; {
 		size := len(states[i][bestMode].data)
@@ -349,7 +432,7 @@ func newFromKanji(level Level, data []byte) (*QRCode, error) {
 		i -= size
 		best = append(best, elem{
 			mode: bestMode,
-			data: states[i][bestMode].data,
+			data: segmentData(states[i][bestMode]),
 		})
 	}
 
@@ -374,7 +457,7 @@ func newFromKanji(level Level, data []byte) (*QRCode, error) {
 
 	version := calcVersion(level, segments)
 	if version == 0 {
-		return nil, errors.New("qrcode: data too large")
+		return nil, newDataTooLargeError(level, largestVersion(level), segments)
 	}
 
 	return &QRCode{
@@ -386,39 +469,85 @@ func newFromKanji(level Level, data []byte) (*QRCode, error) {
 }
 
 func calcVersion(level Level, segments []Segment) Version {
-LOOP:
 	for version := Version(1); version <= 4; version++ {
-		if formatTable[version][level] < 0 {
-			continue
-		}
-		capacity := capacityTable[version][level].DataBits
-		length := 0
-		for _, s := range segments {
-			l, ok := s.length(version)
-			if !ok {
-				continue LOOP
-			}
-			length += l
-			if length > capacity {
-				continue LOOP
-			}
-		}
-		if length <= capacity {
+		if fits(level, version, segments) {
 			return version
 		}
 	}
 	return 0
 }
 
+// fits reports whether the segments fit in the version.
+func fits(level Level, version Version, segments []Segment) bool {
+	if formatTable[version][level] < 0 {
+		return false
+	}
+	capacity := capacityTable[version][level].DataBits
+	length := 0
+	for _, s := range segments {
+		l, ok := s.length(version)
+		if !ok {
+			return false
+		}
+		length += l
+		if length > capacity {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate checks that qr can be encoded as-is.
+// It reports an invalid version, level, mask or segment, a mode that is not available in the version,
+// and data that don't fit in the version.
+func (qr *QRCode) Validate() error {
+	if qr.Version < 1 || qr.Version > 4 {
+		return fmt.Errorf("microqr: invalid version: %d", qr.Version)
+	}
+	if qr.Level < 0 || qr.Level >= 4 {
+		return fmt.Errorf("microqr: invalid level: %d", qr.Level)
+	}
+	if formatTable[qr.Version][qr.Level] < 0 {
+		return fmt.Errorf("microqr: invalid version-level pair: %d-%s", qr.Version, qr.Level)
+	}
+	if qr.Mask < MaskAuto || qr.Mask >= maskMax {
+		return fmt.Errorf("microqr: invalid mask: %d", qr.Mask)
+	}
+	for i := range qr.Segments {
+		s := &qr.Segments[i]
+		if err := s.validate(); err != nil {
+			return err
+		}
+		if _, ok := s.length(qr.Version); !ok {
+			return fmt.Errorf("microqr: %s mode is not available in M%d", s.Mode, qr.Version)
+		}
+	}
+	if !fits(qr.Level, qr.Version, qr.Segments) {
+		return newDataTooLargeError(qr.Level, qr.Version, qr.Segments)
+	}
+	return nil
+}
+
 type EncodeOptions interface {
 	apply(opts *encodeOptions)
 }
 
 type encodeOptions struct {
-	QuiteZone  int
-	ModuleSize float64
-	Level      Level
-	Kanji      bool
+	QuiteZone    int
+	ModuleSize   float64
+	Level        Level
+	Kanji        bool
+	ShiftJIS     bool
+	Version      Version
+	MinVersion   Version
+	Mask         Mask
+	BoostLevel   bool
+	Foreground   color.Color
+	Background   color.Color
+	Scalable     bool
+	Width        int
+	Height       int
+	IntegerScale bool
 }
 
 func newEncodeOptions(opts ...EncodeOptions) encodeOptions {
@@ -427,6 +556,9 @@ func newEncodeOptions(opts ...EncodeOptions) encodeOptions {
 		ModuleSize: 1,
 		Level:      LevelQ,
 		Kanji:      true,
+		Mask:       MaskAuto,
+		Foreground: color.Black,
+		Background: color.White,
 	}
 	for _, o := range opts {
 		o.apply(&myopts)
@@ -474,6 +606,149 @@ func WithKanji(use bool) EncodeOptions {
 	return withKanji(use)
 }
 
+type withVersion Version
+
+func (opt withVersion) apply(opts *encodeOptions) {
+	opts.Version = Version(opt)
+}
+
+// WithVersion fixes the version of Micro QR code. 1 to 4 means M1 to M4.
+// New returns an error if the data does not fit in the version.
+// The zero value means choosing the smallest version automatically.
+func WithVersion(version Version) EncodeOptions {
+	return withVersion(version)
+}
+
+type withMinVersion Version
+
+func (opt withMinVersion) apply(opts *encodeOptions) {
+	opts.MinVersion = Version(opt)
+}
+
+// WithMinVersion makes New choose the smallest version that is at least version.
+func WithMinVersion(version Version) EncodeOptions {
+	return withMinVersion(version)
+}
+
+type withMask Mask
+
+func (opt withMask) apply(opts *encodeOptions) {
+	opts.Mask = Mask(opt)
+}
+
+// WithMask fixes the mask pattern.
+// The default is MaskAuto, which chooses the best mask pattern.
+func WithMask(mask Mask) EncodeOptions {
+	return withMask(mask)
+}
+
+type withShiftJIS bool
+
+func (opt withShiftJIS) apply(opts *encodeOptions) {
+	opts.ShiftJIS = bool(opt)
+}
+
+// WithShiftJIS makes WithKanji(true) treat the data as Shift_JIS instead of UTF-8.
+// The double-byte codes are packed in the kanji mode, and the other bytes are kept as-is.
+func WithShiftJIS(use bool) EncodeOptions {
+	return withShiftJIS(use)
+}
+
+type withBoostLevel bool
+
+func (opt withBoostLevel) apply(opts *encodeOptions) {
+	opts.BoostLevel = bool(opt)
+}
+
+// WithBoostLevel raises the error correction level (L→M→Q) while the data still fit in the same version.
+func WithBoostLevel(use bool) EncodeOptions {
+	return withBoostLevel(use)
+}
+
+type withForeground struct{ c color.Color }
+
+func (opt withForeground) apply(opts *encodeOptions) {
+	opts.Foreground = opt.c
+}
+
+// WithForeground sets the color of the dark modules in Encode and EncodeSVG.
+// The default is black.
+func WithForeground(c color.Color) EncodeOptions {
+	return withForeground{c}
+}
+
+type withBackground struct{ c color.Color }
+
+func (opt withBackground) apply(opts *encodeOptions) {
+	opts.Background = opt.c
+}
+
+// WithBackground sets the color of the light modules and the quiet zone in Encode and EncodeSVG.
+// The default is white, and a fully transparent color leaves the background unpainted.
+// Encode and EncodeSVG return ErrLowContrast if the colors are too close for scanners.
+func WithBackground(c color.Color) EncodeOptions {
+	return withBackground{c}
+}
+
+type withScalable bool
+
+func (opt withScalable) apply(opts *encodeOptions) {
+	opts.Scalable = bool(opt)
+}
+
+// WithScalable makes EncodeSVG omit the width and the height of the image.
+// Only the viewBox is set, so the image fits its container.
+func WithScalable(use bool) EncodeOptions {
+	return withScalable(use)
+}
+
+type withImageSize int
+
+func (opt withImageSize) apply(opts *encodeOptions) {
+	opts.Width = int(opt)
+	opts.Height = int(opt)
+}
+
+// WithImageSize makes Encode draw the image in exactly size x size pixels, instead of WithModuleSize.
+// The leftover pixels are spread evenly over the modules, so the sizes of the modules differ by one pixel at most.
+// Encode returns an error if the image is smaller than the number of modules including the quiet zone.
+func WithImageSize(size int) EncodeOptions {
+	return withImageSize(size)
+}
+
+type withIntegerScale bool
+
+func (opt withIntegerScale) apply(opts *encodeOptions) {
+	opts.IntegerScale = bool(opt)
+}
+
+// WithIntegerScale makes WithImageSize use the same integer size for all modules,
+// and pad the quiet zone with the leftover pixels.
+func WithIntegerScale(use bool) EncodeOptions {
+	return withIntegerScale(use)
+}
+
+// checkContrast checks that scanners can tell the foreground from the background.
+func (opts *encodeOptions) checkContrast() error {
+	if render.Contrast(opts.Foreground, opts.Background) < render.MinContrast {
+		return ErrLowContrast
+	}
+	return nil
+}
+
+func (opts *encodeOptions) renderOptions() *render.Options {
+	return &render.Options{
+		QuietZone:    opts.QuiteZone,
+		ModuleSize:   opts.ModuleSize,
+		Foreground:   opts.Foreground,
+		Background:   opts.Background,
+		Scalable:     opts.Scalable,
+		Width:        opts.Width,
+		Height:       opts.Height,
+		IntegerScale: opts.IntegerScale,
+	}
+}
+
 func Encode(data []byte, opts ...EncodeOptions) (image.Image, error) {
 	qr, err := New(data, opts...)
 	if err != nil {
@@ -484,43 +759,46 @@ func Encode(data []byte, opts ...EncodeOptions) (image.Image, error) {
 
 func (qr *QRCode) Encode(opts ...EncodeOptions) (image.Image, error) {
 	myopts := newEncodeOptions(opts...)
+	if err := myopts.checkContrast(); err != nil {
+		return nil, err
+	}
 
 	binimg, err := qr.EncodeToBitmap()
 	if err != nil {
 		return nil, err
 	}
 
-	w := binimg.Bounds().Dx() + myopts.QuiteZone*2
-	W := int(math.Ceil(float64(w) * myopts.ModuleSize))
-	scale := myopts.ModuleSize
-	dX := float64(myopts.QuiteZone) * scale
-	dY := float64(myopts.QuiteZone) * scale
-
-	// create new paletted image
-	palette := color.Palette{
-		color.White, color.Black,
-	}
-	white := uint8(0)
-	black := uint8(1)
-	bounds := image.Rect(0, 0, W, W)
-	img := image.NewPaletted(bounds, palette)
-
-	// convert bitmap to image
-	for Y := bounds.Min.Y; Y < bounds.Max.Y; Y++ {
-		y := int(math.Floor((float64(Y) - dY) / scale))
-		for X := bounds.Min.X; X < bounds.Max.X; X++ {
-			x := int(math.Floor((float64(X) - dX) / scale))
-			c := binimg.BinaryAt(x, y)
-			if c {
-				img.SetColorIndex(X, Y, black)
-			} else {
-				img.SetColorIndex(X, Y, white)
-			}
-		}
+	img, err := render.Raster(binimg, myopts.renderOptions())
+	if err != nil {
+		return nil, err
 	}
 	return img, nil
 }
 
+// EncodeSVG encodes data into Micro QR code, and writes it to w in the SVG format.
+func EncodeSVG(w io.Writer, data []byte, opts ...EncodeOptions) error {
+	qr, err := New(data, opts...)
+	if err != nil {
+		return err
+	}
+	return qr.EncodeSVG(w, opts...)
+}
+
+// EncodeSVG writes qr to w in the SVG format.
+// The dark modules are merged into outlines.
+func (qr *QRCode) EncodeSVG(w io.Writer, opts ...EncodeOptions) error {
+	myopts := newEncodeOptions(opts...)
+	if err := myopts.checkContrast(); err != nil {
+		return err
+	}
+
+	binimg, err := qr.EncodeToBitmap()
+	if err != nil {
+		return err
+	}
+	return render.SVG(w, binimg, myopts.renderOptions())
+}
+
 // EncodeToBitmap encodes QR Code into bitmap image.
 func (qr *QRCode) EncodeToBitmap() (*bitmap.Image, error) {
 	if qr.Version < 1 || qr.Version > 4 {
@@ -619,7 +897,12 @@ func (qr *QRCode) encodeSegments(buf *bitstream.Buffer) error {
 
 	capacity := capacityTable[qr.Version][qr.Level]
 	if buf.Len() > capacity.DataBits {
-		return errors.New("qrcode: data too large")
+		return &DataTooLargeError{
+			Version:   qr.Version,
+			Level:     qr.Level,
+			Required:  buf.Len(),
+			Available: capacity.DataBits,
+		}
 	}
 
 	// terminate pattern
@@ -668,6 +951,34 @@ func (qr *QRCode) encodeSegments(buf *bitstream.Buffer) error {
 	return nil
 }
 
+// validate checks that the data of s can be encoded in the mode.
+func (s *Segment) validate() error {
+	switch s.Mode {
+	case ModeNumeric:
+		for i, ch := range s.Data {
+			if !bitstream.IsNumeric(ch) {
+				return &InvalidCharacterError{Mode: s.Mode, Offset: i}
+			}
+		}
+	case ModeAlphanumeric:
+		for i, ch := range s.Data {
+			if !bitstream.IsAlphanumeric(ch) {
+				return &InvalidCharacterError{Mode: s.Mode, Offset: i}
+			}
+		}
+	case ModeKanji:
+		for i, r := range string(s.Data) {
+			if !bitstream.IsKanji(r) {
+				return &InvalidCharacterError{Mode: s.Mode, Offset: i}
+			}
+		}
+	case ModeBytes:
+	default:
+		return fmt.Errorf("microqr: unknown mode: %s", s.Mode)
+	}
+	return nil
+}
+
 // length returns the length of s in bits.
 func (s *Segment) length(version Version) (int, bool) {
 	var n int
@@ -748,6 +1059,9 @@ func (s *Segment) length(version Version) (int, bool) {
 }
 
 func (s *Segment) encode(version Version, buf *bitstream.Buffer) error {
+	if err := s.validate(); err != nil {
+		return err
+	}
 	switch s.Mode {
 	case ModeNumeric:
 		return s.encodeNumeric(version, buf)
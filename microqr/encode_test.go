@@ -2,7 +2,12 @@ package microqr
 
 import (
 	"bytes"
+	"errors"
+	"image"
+	"image/color"
 	"image/png"
+	"io"
+	"strings"
 	"testing"
 )
 
@@ -127,6 +132,169 @@ func TestNew6(t *testing.T) {
 	}
 }
 
+func TestNew_WithVersion(t *testing.T) {
+	qr, err := New([]byte("12345"), WithLevel(LevelL), WithVersion(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qr.Version != 3 {
+		t.Errorf("unexpected version: got %v, want %v", qr.Version, 3)
+	}
+
+	// M1 supports only error detection.
+	_, err = New([]byte("12345"), WithLevel(LevelL), WithVersion(1))
+	if err == nil {
+		t.Error("want error, but not")
+	}
+
+	// M2-L can hold only 10 digits.
+	_, err = New([]byte("12345678901"), WithLevel(LevelL), WithVersion(2))
+	if err == nil {
+		t.Error("want error, but not")
+	}
+
+	_, err = New([]byte("12345"), WithVersion(5))
+	if err == nil {
+		t.Error("want error, but not")
+	}
+}
+
+func TestNew_WithMinVersion(t *testing.T) {
+	qr, err := New([]byte("12345"), WithLevel(LevelL), WithMinVersion(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qr.Version != 3 {
+		t.Errorf("unexpected version: got %v, want %v", qr.Version, 3)
+	}
+
+	// only M1 supports error detection.
+	_, err = New([]byte("12345"), WithLevel(LevelCheck), WithMinVersion(2))
+	if err == nil {
+		t.Error("want error, but not")
+	}
+}
+
+func TestNew_WithMask(t *testing.T) {
+	qr, err := New([]byte("12345"), WithLevel(LevelL), WithMask(Mask2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qr.Mask != Mask2 {
+		t.Errorf("unexpected mask: got %v, want %v", qr.Mask, Mask2)
+	}
+
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := DecodeBitmap(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Mask != Mask2 {
+		t.Errorf("unexpected mask: got %v, want %v", got.Mask, Mask2)
+	}
+
+	_, err = New([]byte("12345"), WithMask(8))
+	if err == nil {
+		t.Error("want error, but not")
+	}
+}
+
+func TestNew_WithShiftJIS(t *testing.T) {
+	data := []byte("\x88\x9f\x88\x9f") // "亜亜" in Shift_JIS
+	qr, err := New(data, WithLevel(LevelL), WithKanji(true), WithShiftJIS(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(qr.Segments) != 1 {
+		t.Fatalf("unexpected the length of segment: got %d, want %d", len(qr.Segments), 1)
+	}
+	if qr.Segments[0].Mode != ModeKanji {
+		t.Errorf("got %v, want %v", qr.Segments[0].Mode, ModeKanji)
+	}
+	if !bytes.Equal(qr.Segments[0].Data, []byte("亜亜")) {
+		t.Errorf("got %q, want %q", qr.Segments[0].Data, "亜亜")
+	}
+
+	got, err := qr.ShiftJIS()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("got %x, want %x", got, data)
+	}
+}
+
+func TestNew_WithBoostLevel(t *testing.T) {
+	// M2 supports only L and M.
+	qr, err := New([]byte("123"), WithLevel(LevelL), WithBoostLevel(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qr.Version != 2 {
+		t.Errorf("unexpected version: got %v, want %v", qr.Version, 2)
+	}
+	if qr.Level != LevelM {
+		t.Errorf("unexpected level: got %v, want %v", qr.Level, LevelM)
+	}
+}
+
+func TestSegmentConstructors(t *testing.T) {
+	if _, err := NumericSegment([]byte("0123")); err != nil {
+		t.Error(err)
+	}
+	if _, err := NumericSegment([]byte("012A")); err == nil {
+		t.Error("want error, but not")
+	}
+	if _, err := AlphanumericSegment([]byte("AC-42")); err != nil {
+		t.Error(err)
+	}
+	if _, err := AlphanumericSegment([]byte("ac-42")); err == nil {
+		t.Error("want error, but not")
+	}
+	if _, err := KanjiSegment([]byte("点茗")); err != nil {
+		t.Error(err)
+	}
+	if _, err := KanjiSegment([]byte("点A")); err == nil {
+		t.Error("want error, but not")
+	}
+}
+
+func TestQRCode_Validate(t *testing.T) {
+	alphanumeric, err := AlphanumericSegment([]byte("AC-42"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	qr := &QRCode{
+		Version:  2,
+		Level:    LevelL,
+		Mask:     MaskAuto,
+		Segments: []Segment{alphanumeric},
+	}
+	if err := qr.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := []*QRCode{
+		// M1 supports only the numeric mode.
+		{Version: 1, Level: LevelCheck, Mask: MaskAuto, Segments: []Segment{alphanumeric}},
+		// M1 supports only the error detection.
+		{Version: 1, Level: LevelL, Mask: MaskAuto, Segments: []Segment{{Mode: ModeNumeric, Data: []byte("0")}}},
+		{Version: 5, Level: LevelL, Mask: MaskAuto, Segments: []Segment{alphanumeric}},
+		{Version: 2, Level: LevelL, Mask: maskMax, Segments: []Segment{alphanumeric}},
+		{Version: 2, Level: LevelL, Mask: MaskAuto, Segments: []Segment{{Mode: ModeAlphanumeric, Data: []byte("ac")}}},
+		{Version: 2, Level: LevelL, Mask: MaskAuto, Segments: []Segment{BytesSegment([]byte("a"))}},
+		{Version: 2, Level: LevelL, Mask: MaskAuto, Segments: []Segment{{Mode: ModeNumeric, Data: []byte("0123456789012")}}},
+	}
+	for i, tt := range tests {
+		if err := tt.Validate(); err == nil {
+			t.Errorf("%d: want error, but not", i)
+		}
+	}
+}
+
 func TestNewFromKanji1(t *testing.T) {
 	qr, err := New([]byte("MICROQR"), WithLevel(LevelL), WithKanji(true))
 	if err != nil {
@@ -495,3 +663,103 @@ func TestEncodeToBitmap7(t *testing.T) {
 		t.Errorf("got %08b, want %08b", got, want)
 	}
 }
+
+func TestEncodeSVG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := EncodeSVG(&buf, []byte("HELLO"), WithModuleSize(2)); err != nil {
+		t.Fatal(err)
+	}
+
+	// M4 has 17 modules, and the quiet zone is 4 modules by default.
+	// the outline of the finder pattern comes first.
+	want := `<svg xmlns="http://www.w3.org/2000/svg" version="1.1" viewBox="0 0 25 25" width="50" height="50" shape-rendering="crispEdges">
+<rect width="25" height="25" fill="#ffffff"/>
+<path d="M4 4H11V11H4Z`
+	if !strings.HasPrefix(buf.String(), want) {
+		t.Errorf("unexpected svg: %s", buf.String())
+	}
+}
+
+func TestQRCode_Encode_WithColors(t *testing.T) {
+	qr, err := New([]byte("HELLO"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	darkBlue := color.NRGBA{0x00, 0x20, 0x60, 0xff}
+	img, err := qr.Encode(WithForeground(darkBlue), WithBackground(color.Transparent))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the quiet zone is 4 modules by default, and the finder pattern starts at (4, 4) with a light ring inside.
+	if _, _, _, a := img.At(3, 4).RGBA(); a != 0 {
+		t.Errorf("the quiet zone must be transparent: got %v", img.At(3, 4))
+	}
+	if got := color.NRGBAModel.Convert(img.At(4, 4)); got != darkBlue {
+		t.Errorf("unexpected foreground: got %v, want %v", got, darkBlue)
+	}
+	if _, _, _, a := img.At(5, 5).RGBA(); a != 0 {
+		t.Errorf("the light modules must be transparent: got %v", img.At(5, 5))
+	}
+
+	_, err = qr.Encode(WithForeground(color.Gray{0xe0}), WithBackground(color.White))
+	if !errors.Is(err, ErrLowContrast) {
+		t.Errorf("want ErrLowContrast, got %v", err)
+	}
+	err = qr.EncodeSVG(io.Discard, WithForeground(color.Gray{0xe0}), WithBackground(color.White))
+	if !errors.Is(err, ErrLowContrast) {
+		t.Errorf("want ErrLowContrast, got %v", err)
+	}
+}
+
+func TestQRCode_Encode_WithImageSize(t *testing.T) {
+	qr, err := New([]byte("HELLO"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dark := func(img image.Image, x, y int) bool {
+		return color.GrayModel.Convert(img.At(x, y)).(color.Gray).Y < 0x80
+	}
+
+	// M4 has 17 modules, and 25 modules with the quiet zone of 4 modules.
+	// With the integer scale, a module is 12 pixels, and the leftover 10 pixels are split into both sides.
+	tests := []struct {
+		integer bool
+		x, y    int
+	}{
+		{false, 50, 50},
+		{true, 53, 53},
+	}
+	for _, tt := range tests {
+		img, err := qr.Encode(WithImageSize(310), WithIntegerScale(tt.integer))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if img.Bounds().Dx() != 310 || img.Bounds().Dy() != 310 {
+			t.Errorf("unexpected size: %v", img.Bounds())
+		}
+		if !dark(img, tt.x, tt.y) || dark(img, tt.x-1, tt.y) || dark(img, tt.x, tt.y-1) {
+			t.Errorf("integer scale %v: the finder pattern doesn't start at (%d, %d)", tt.integer, tt.x, tt.y)
+		}
+
+		got, err := Decode(img)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got.Segments[0].Data, []byte("HELLO")) {
+			t.Errorf("unexpected data: %q", got.Segments[0].Data)
+		}
+	}
+
+	// the image must have a pixel per module at least.
+	for i, opt := range []EncodeOptions{
+		WithImageSize(24),
+	} {
+		if _, err := qr.Encode(opt); err == nil {
+			t.Errorf("%d: want error, but not", i)
+		}
+	}
+	if _, err := qr.Encode(WithImageSize(25)); err != nil {
+		t.Error(err)
+	}
+}
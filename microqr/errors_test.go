@@ -0,0 +1,67 @@
+package microqr
+
+import (
+	"errors"
+	"image"
+	"image/draw"
+	"testing"
+
+	"github.com/shogo82148/qrcode/internal/bitstream"
+)
+
+func TestDataTooLargeError(t *testing.T) {
+	_, err := New(make([]byte, 1000), WithLevel(LevelL))
+	if !errors.Is(err, ErrDataTooLarge) {
+		t.Fatalf("want ErrDataTooLarge, got %v", err)
+	}
+	var e *DataTooLargeError
+	if !errors.As(err, &e) {
+		t.Fatalf("want *DataTooLargeError, got %T", err)
+	}
+	if e.Version != 4 || e.Level != LevelL {
+		t.Errorf("unexpected symbol: %v", e)
+	}
+	if e.Required <= e.Available {
+		t.Errorf("required bits must exceed available bits: %v", e)
+	}
+}
+
+func TestInvalidCharacterError(t *testing.T) {
+	_, err := AlphanumericSegment([]byte("ABCa"))
+	if !errors.Is(err, ErrInvalidCharacter) {
+		t.Fatalf("want ErrInvalidCharacter, got %v", err)
+	}
+	var e *InvalidCharacterError
+	if !errors.As(err, &e) {
+		t.Fatalf("want *InvalidCharacterError, got %T", err)
+	}
+	if e.Mode != ModeAlphanumeric || e.Offset != 3 {
+		t.Errorf("unexpected error: %v", e)
+	}
+}
+
+func TestDecode_NotFound(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 100, 100))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+	_, err := Decode(img)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("want ErrNotFound, got %v", err)
+	}
+}
+
+func TestInvalidModeError(t *testing.T) {
+	// M4 has the 3-bit mode indicators, and only 0 to 3 are defined.
+	for _, mode := range []Mode{4, 5, 6, 7} {
+		_, err := decodeVersion4(bitstream.NewBuffer([]byte{byte(mode) << 5, 0x00}), 0, LevelL)
+		if !errors.Is(err, ErrInvalidMode) {
+			t.Fatalf("mode %d: want ErrInvalidMode, got %v", mode, err)
+		}
+		var e *InvalidModeError
+		if !errors.As(err, &e) {
+			t.Fatalf("mode %d: want *InvalidModeError, got %T", mode, err)
+		}
+		if e.Mode != mode {
+			t.Errorf("unexpected mode: got %d, want %d", e.Mode, mode)
+		}
+	}
+}
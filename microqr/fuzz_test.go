@@ -55,7 +55,7 @@ func FuzzNewFromKanji(f *testing.F) {
 		if lv != LevelCheck && lv != LevelL && lv != LevelM && lv != LevelQ {
 			return
 		}
-		qr0, err := newFromKanji(lv, data)
+		qr0, err := newFromKanji(lv, data, false)
 		if err != nil {
 			return
 		}
@@ -0,0 +1,112 @@
+// Package multi decodes QR codes, Micro QR codes and rMQR codes in one image.
+package multi
+
+import (
+	"image"
+
+	"github.com/shogo82148/qrcode"
+	"github.com/shogo82148/qrcode/microqr"
+	"github.com/shogo82148/qrcode/rmqr"
+)
+
+// Type is the type of symbols.
+type Type int
+
+const (
+	// TypeQR is QR code.
+	TypeQR Type = iota + 1
+
+	// TypeMicroQR is Micro QR code.
+	TypeMicroQR
+
+	// TypeRMQR is Rectangular Micro QR Code (rMQR).
+	TypeRMQR
+)
+
+func (typ Type) String() string {
+	switch typ {
+	case TypeQR:
+		return "qrcode"
+	case TypeMicroQR:
+		return "microqr"
+	case TypeRMQR:
+		return "rmqr"
+	}
+	return "unknown"
+}
+
+// Symbol is a symbol found in an image.
+type Symbol struct {
+	Type Type
+
+	// QRCode is the decoded QR code if Type is TypeQR.
+	QRCode *qrcode.QRCode
+
+	// MicroQR is the decoded Micro QR code if Type is TypeMicroQR.
+	MicroQR *microqr.QRCode
+
+	// RMQR is the decoded rMQR code if Type is TypeRMQR.
+	RMQR *rmqr.QRCode
+
+	// Corners are the corners of the symbol in the image.
+	// The order is top-left, top-right, bottom-right and bottom-left.
+	Corners [4]image.Point
+}
+
+// DecodeAll decodes all symbols in the image.
+func DecodeAll(img image.Image) []*Symbol {
+	var symbols []*Symbol
+	for _, s := range qrcode.DecodeAll(img) {
+		symbols = appendSymbol(symbols, &Symbol{
+			Type:    TypeQR,
+			QRCode:  s.QRCode,
+			Corners: s.Corners,
+		})
+	}
+	for _, s := range rmqr.DecodeAll(img) {
+		symbols = appendSymbol(symbols, &Symbol{
+			Type:    TypeRMQR,
+			RMQR:    s.QRCode,
+			Corners: s.Corners,
+		})
+	}
+	for _, s := range microqr.DecodeAll(img) {
+		symbols = appendSymbol(symbols, &Symbol{
+			Type:    TypeMicroQR,
+			MicroQR: s.QRCode,
+			Corners: s.Corners,
+		})
+	}
+	return symbols
+}
+
+// appendSymbol appends s to symbols unless s overlaps the symbols.
+// A finder pattern of a symbol may be misread as another type.
+func appendSymbol(symbols []*Symbol, s *Symbol) []*Symbol {
+	for _, t := range symbols {
+		if t.contains(s.center()) || s.contains(t.center()) {
+			return symbols
+		}
+	}
+	return append(symbols, s)
+}
+
+func (s *Symbol) center() image.Point {
+	var p image.Point
+	for _, c := range s.Corners {
+		p = p.Add(c)
+	}
+	return p.Div(4)
+}
+
+// contains reports whether p is inside the symbol.
+func (s *Symbol) contains(p image.Point) bool {
+	var positive, negative bool
+	for i := range s.Corners {
+		a, b := s.Corners[i], s.Corners[(i+1)%4]
+		c := (b.X-a.X)*(p.Y-a.Y) - (b.Y-a.Y)*(p.X-a.X)
+		positive = positive || c > 0
+		negative = negative || c < 0
+	}
+	return !(positive && negative)
+}
@@ -0,0 +1,105 @@
+package multi
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+
+	"github.com/shogo82148/qrcode"
+	"github.com/shogo82148/qrcode/microqr"
+	"github.com/shogo82148/qrcode/rmqr"
+)
+
+func TestDecodeAll(t *testing.T) {
+	qr1, err := qrcode.Encode([]byte("QR CODE 1"), qrcode.WithModuleSize(4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	qr2, err := qrcode.Encode([]byte("QR CODE 2"), qrcode.WithModuleSize(4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	micro, err := microqr.Encode([]byte("MICRO"), microqr.WithModuleSize(4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rect, err := rmqr.Encode([]byte("RMQR"), rmqr.WithModuleSize(4))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// arrange the symbols side by side.
+	img := image.NewGray(image.Rect(0, 0, 800, 300))
+	draw.Draw(img, img.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	origins := []image.Point{{0, 0}, {150, 0}, {300, 0}, {0, 150}}
+	for i, symbol := range []image.Image{qr1, qr2, micro, rect} {
+		r := symbol.Bounds().Sub(symbol.Bounds().Min).Add(origins[i])
+		draw.Draw(img, r, symbol, symbol.Bounds().Min, draw.Src)
+	}
+
+	symbols := DecodeAll(img)
+	got := map[string]Type{}
+	for _, s := range symbols {
+		var data []byte
+		switch s.Type {
+		case TypeQR:
+			data = s.QRCode.Segments[0].Data
+		case TypeMicroQR:
+			data = s.MicroQR.Segments[0].Data
+		case TypeRMQR:
+			data = s.RMQR.Segments[0].Data
+		}
+		if _, ok := got[string(data)]; ok {
+			t.Errorf("%q is found twice", data)
+		}
+		got[string(data)] = s.Type
+	}
+
+	want := map[string]Type{
+		"QR CODE 1": TypeQR,
+		"QR CODE 2": TypeQR,
+		"MICRO":     TypeMicroQR,
+		"RMQR":      TypeRMQR,
+	}
+	if len(got) != len(want) {
+		t.Errorf("unexpected number of symbols: got %d, want %d", len(got), len(want))
+	}
+	for data, typ := range want {
+		if got[data] != typ {
+			t.Errorf("%q: got %v, want %v", data, got[data], typ)
+		}
+	}
+}
+
+func TestDecodeAll_Corners(t *testing.T) {
+	symbol, err := microqr.Encode([]byte("MICRO"), microqr.WithModuleSize(4), microqr.WithQuiteZone(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img := image.NewGray(image.Rect(0, 0, 200, 200))
+	draw.Draw(img, img.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	origin := image.Pt(50, 60)
+	draw.Draw(img, symbol.Bounds().Add(origin), symbol, image.Point{}, draw.Src)
+
+	symbols := DecodeAll(img)
+	if len(symbols) != 1 {
+		t.Fatalf("unexpected number of symbols: got %d, want 1", len(symbols))
+	}
+
+	// the quiet zone is 2 modules.
+	size := symbol.Bounds().Dx() - 16
+	topLeft := origin.Add(image.Pt(8, 8))
+	want := [4]image.Point{
+		topLeft,
+		topLeft.Add(image.Pt(size, 0)),
+		topLeft.Add(image.Pt(size, size)),
+		topLeft.Add(image.Pt(0, size)),
+	}
+	for i, p := range symbols[0].Corners {
+		d := p.Sub(want[i])
+		if d.X < -2 || d.X > 2 || d.Y < -2 || d.Y > 2 {
+			t.Errorf("corner %d: got %v, want %v", i, p, want[i])
+		}
+	}
+}
@@ -4,8 +4,15 @@ package qrcode
 //go:generate go run genbch/main.go
 
 import (
+	"errors"
 	"math"
 	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/shogo82148/qrcode/gs1"
+	"github.com/shogo82148/qrcode/internal/bitstream"
+	"github.com/shogo82148/qrcode/internal/charset"
 )
 
 type QRCode struct {
@@ -100,10 +107,19 @@ const (
 	// ModeKanji is Japanese Kanji mode.
 	ModeKanji Mode = 0b1000
 
+	// ModeHanzi is Chinese Hanzi mode of GB/T 18284.
+	// The Data must be UTF-8 characters in GB2312.
+	ModeHanzi Mode = 0b1101
+
 	// ModeConnected is connected structure mode.
 	ModeConnected Mode = 0b0011
 
+	// ModeFNC1_1 is FNC1 in the first position.
+	// It indicates that the data is GS1 element strings.
 	ModeFNC1_1 Mode = 0b0101
+
+	// ModeFNC1_2 is FNC1 in the second position.
+	// The Data is the application indicator, which is a letter or two digits.
 	ModeFNC1_2 Mode = 0b1001
 
 	ModeTerminated Mode = 0b0000
@@ -121,6 +137,8 @@ func (mode Mode) String() string {
 		return "bytes"
 	case ModeKanji:
 		return "kanji"
+	case ModeHanzi:
+		return "hanzi"
 	case ModeConnected:
 		return "connected"
 	case ModeFNC1_1:
@@ -135,6 +153,191 @@ func (mode Mode) String() string {
 type Segment struct {
 	Mode Mode
 	Data []byte
+
+	// ECI is the assignment number of ModeECI segment.
+	// It designates the character set of the following ModeBytes segments.
+	ECI ECI
+
+	// StructuredAppend is the header of ModeConnected segment.
+	StructuredAppend StructuredAppend
+}
+
+// StructuredAppend is the header of a symbol in structured append mode,
+// which splits data across up to 16 symbols.
+type StructuredAppend struct {
+	// Index is the position of the symbol, starting from 0.
+	Index int
+
+	// Total is the number of symbols.
+	Total int
+
+	// Parity is the XOR of all bytes of the whole data.
+	Parity byte
+}
+
+// NumericSegment returns a ModeNumeric segment.
+// It returns an error if data includes characters other than [0-9].
+func NumericSegment(data []byte) (Segment, error) {
+	return newSegment(Segment{Mode: ModeNumeric, Data: data})
+}
+
+// AlphanumericSegment returns a ModeAlphanumeric segment.
+// It returns an error if data includes characters other than [0-9A-Z $%*+\-./:].
+func AlphanumericSegment(data []byte) (Segment, error) {
+	return newSegment(Segment{Mode: ModeAlphanumeric, Data: data})
+}
+
+// BytesSegment returns a ModeBytes segment.
+func BytesSegment(data []byte) Segment {
+	return Segment{Mode: ModeBytes, Data: data}
+}
+
+// KanjiSegment returns a ModeKanji segment.
+// It returns an error if data includes characters that are not in JIS X 0208.
+func KanjiSegment(data []byte) (Segment, error) {
+	return newSegment(Segment{Mode: ModeKanji, Data: data})
+}
+
+// HanziSegment returns a ModeHanzi segment.
+// It returns an error if data includes characters that are not in GB2312.
+func HanziSegment(data []byte) (Segment, error) {
+	return newSegment(Segment{Mode: ModeHanzi, Data: data})
+}
+
+// ECISegment returns a ModeECI segment that designates the character set.
+func ECISegment(eci ECI) (Segment, error) {
+	return newSegment(Segment{Mode: ModeECI, ECI: eci})
+}
+
+func newSegment(s Segment) (Segment, error) {
+	if err := s.validate(); err != nil {
+		return Segment{}, err
+	}
+	return s, nil
+}
+
+// ECI is an assignment number of ECI (Extended Channel Interpretation).
+type ECI int
+
+const (
+	ECIISO8859_1  ECI = 3
+	ECIISO8859_2  ECI = 4
+	ECIISO8859_3  ECI = 5
+	ECIISO8859_4  ECI = 6
+	ECIISO8859_5  ECI = 7
+	ECIISO8859_6  ECI = 8
+	ECIISO8859_7  ECI = 9
+	ECIISO8859_8  ECI = 10
+	ECIISO8859_9  ECI = 11
+	ECIISO8859_10 ECI = 12
+	ECIISO8859_11 ECI = 13
+	ECIISO8859_13 ECI = 15
+	ECIISO8859_14 ECI = 16
+	ECIISO8859_15 ECI = 17
+	ECIISO8859_16 ECI = 18
+	ECIShiftJIS   ECI = 20
+	ECIUTF16BE    ECI = 25
+	ECIUTF8       ECI = 26
+	ECIASCII      ECI = 27
+
+	eciMax ECI = 999999
+)
+
+// IsValid returns true if the assignment number can be encoded.
+func (eci ECI) IsValid() bool {
+	return 0 <= eci && eci <= eciMax
+}
+
+// Text returns the data of qr as a UTF-8 string.
+// ModeBytes segments are converted from the character set designated by the preceding ModeECI segment.
+// If no ECI is designated, they are interpreted as UTF-8 if valid, otherwise as ISO/IEC 8859-1.
+// In FNC1 modes, "%" in ModeAlphanumeric segments is converted into GS (0x1D), and "%%" is into "%".
+func (qr *QRCode) Text() (string, error) {
+	var buf strings.Builder
+	eci := ECI(-1)
+	var fnc1 bool
+	for _, s := range qr.Segments {
+		switch s.Mode {
+		case ModeECI:
+			eci = s.ECI
+		case ModeFNC1_1, ModeFNC1_2:
+			fnc1 = true
+		case ModeAlphanumeric:
+			if !fnc1 {
+				buf.Write(s.Data)
+				continue
+			}
+			for i := 0; i < len(s.Data); i++ {
+				switch {
+				case s.Data[i] != '%':
+					buf.WriteByte(s.Data[i])
+				case i+1 < len(s.Data) && s.Data[i+1] == '%':
+					buf.WriteByte('%')
+					i++
+				default:
+					buf.WriteByte(gs1.GS)
+				}
+			}
+		case ModeBytes:
+			cs := eci
+			if cs < 0 {
+				if utf8.Valid(s.Data) {
+					buf.Write(s.Data)
+					continue
+				}
+				cs = ECIISO8859_1
+			}
+			text, err := charset.Decode(int(cs), s.Data)
+			if err != nil {
+				return "", err
+			}
+			buf.WriteString(text)
+		default:
+			// the data of the other modes are ASCII or UTF-8.
+			buf.Write(s.Data)
+		}
+	}
+	return buf.String(), nil
+}
+
+// ShiftJIS returns the data of qr in Shift_JIS.
+// ModeKanji segments are converted into Shift_JIS, and the others are returned as-is.
+func (qr *QRCode) ShiftJIS() ([]byte, error) {
+	var buf []byte
+	for _, s := range qr.Segments {
+		switch s.Mode {
+		case ModeNumeric, ModeAlphanumeric, ModeBytes:
+			buf = append(buf, s.Data...)
+		case ModeKanji:
+			sjis, err := bitstream.KanjiToShiftJIS(s.Data)
+			if err != nil {
+				return nil, err
+			}
+			buf = append(buf, sjis...)
+		}
+	}
+	return buf, nil
+}
+
+// GS1 returns the GS1 element strings in qr.
+// It returns an error if qr is not a GS1 QR code, which starts with ModeFNC1_1.
+func (qr *QRCode) GS1() ([]gs1.Element, error) {
+	var isGS1 bool
+	for _, s := range qr.Segments {
+		if s.Mode != ModeECI {
+			isGS1 = s.Mode == ModeFNC1_1
+			break
+		}
+	}
+	if !isGS1 {
+		return nil, errors.New("qrcode: not a GS1 QR code")
+	}
+
+	text, err := qr.Text()
+	if err != nil {
+		return nil, err
+	}
+	return gs1.Parse(text)
 }
 
 func round(x float64) int {
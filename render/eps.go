@@ -0,0 +1,45 @@
+package render
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/shogo82148/qrcode/bitmap"
+)
+
+// EPS writes img in the Encapsulated PostScript format.
+// The size of the image is exactly the module size times the number of modules.
+// PostScript has no transparency, so the alpha channel of the colors is ignored,
+// except that a fully transparent background is left unpainted.
+// A nil opts means the default options.
+func EPS(w io.Writer, img *bitmap.Image, opts *Options) error {
+	bw := bufio.NewWriter(w)
+	quietZone := opts.quietZone()
+	scale := opts.moduleSize()
+	width, height := size(img, quietZone)
+	W, H := float64(width)*scale, float64(height)*scale
+
+	bw.WriteString("%!PS-Adobe-3.0 EPSF-3.0\n")
+	fmt.Fprintf(bw, "%%%%BoundingBox: 0 0 %d %d\n", int(math.Ceil(W)), int(math.Ceil(H)))
+	fmt.Fprintf(bw, "%%%%HiResBoundingBox: 0 0 %s %s\n", formatFloat(W), formatFloat(H))
+	bw.WriteString("%%Creator: github.com/shogo82148/qrcode\n")
+	bw.WriteString("%%Pages: 1\n")
+	bw.WriteString("%%EndComments\n")
+
+	bw.WriteString("gsave\n")
+	bw.WriteString("/m {moveto} bind def /l {lineto} bind def /h {closepath} bind def\n")
+	if bg := opts.background(); !isTransparent(bg) {
+		fmt.Fprintf(bw, "%s setrgbcolor 0 0 %s %s rectfill\n", formatRGB(bg), formatFloat(W), formatFloat(H))
+	}
+	fmt.Fprintf(bw, "%s %s scale\n", formatFloat(scale), formatFloat(scale))
+	fmt.Fprintf(bw, "%s setrgbcolor\n", formatRGB(opts.foreground()))
+	bw.WriteString("newpath\n")
+	writePolygons(bw, img, quietZone)
+	bw.WriteString("fill\n")
+	bw.WriteString("grestore\n")
+	bw.WriteString("showpage\n")
+	bw.WriteString("%%EOF\n")
+	return bw.Flush()
+}
@@ -0,0 +1,47 @@
+package render
+
+import (
+	"bytes"
+	"image/color"
+	"testing"
+)
+
+func TestEPS(t *testing.T) {
+	img := newBitmap(
+		"###",
+		"#.#",
+		"###",
+	)
+
+	var buf bytes.Buffer
+	err := EPS(&buf, img, &Options{
+		QuietZone:  1,
+		ModuleSize: 2.5,
+		Foreground: color.NRGBA{0x00, 0x33, 0x66, 0xff},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `%!PS-Adobe-3.0 EPSF-3.0
+%%BoundingBox: 0 0 13 13
+%%HiResBoundingBox: 0 0 12.5 12.5
+%%Creator: github.com/shogo82148/qrcode
+%%Pages: 1
+%%EndComments
+gsave
+/m {moveto} bind def /l {lineto} bind def /h {closepath} bind def
+1 1 1 setrgbcolor 0 0 12.5 12.5 rectfill
+2.5 2.5 scale
+0 0.2 0.4 setrgbcolor
+newpath
+1 4 m 4 4 l 4 1 l 1 1 l h
+2 3 m 2 2 l 3 2 l 3 3 l h
+fill
+grestore
+showpage
+%%EOF
+`
+	if buf.String() != want {
+		t.Errorf("got %s, want %s", buf.String(), want)
+	}
+}
@@ -0,0 +1,104 @@
+package render
+
+import (
+	"image"
+	"math/bits"
+
+	"github.com/shogo82148/qrcode/bitmap"
+)
+
+// directions of the edges.
+const (
+	dirRight = iota
+	dirDown
+	dirLeft
+	dirUp
+)
+
+var dirVectors = [4]image.Point{
+	dirRight: {1, 0},
+	dirDown:  {0, 1},
+	dirLeft:  {-1, 0},
+	dirUp:    {0, -1},
+}
+
+// outlines traces the boundaries of the dark modules of img.
+// Each outline is a closed polygon given by its corners, relative to img.Bounds().Min.
+// The outer boundaries go clockwise and the holes go counterclockwise,
+// so the outlines can be filled with either the nonzero or the even-odd rule.
+func outlines(img *bitmap.Image) [][]image.Point {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	dark := func(x, y int) bool {
+		if x < 0 || y < 0 || x >= w || y >= h {
+			return false
+		}
+		return bool(img.BinaryAt(bounds.Min.X+x, bounds.Min.Y+y))
+	}
+
+	// edges[y*(w+1)+x] is the set of the edges starting at the grid point (x, y).
+	// The dark modules are always on the right side of the edges.
+	stride := w + 1
+	edges := make([]uint8, stride*(h+1))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if !dark(x, y) {
+				continue
+			}
+			if !dark(x, y-1) {
+				edges[y*stride+x] |= 1 << dirRight
+			}
+			if !dark(x+1, y) {
+				edges[y*stride+x+1] |= 1 << dirDown
+			}
+			if !dark(x, y+1) {
+				edges[(y+1)*stride+x+1] |= 1 << dirLeft
+			}
+			if !dark(x-1, y) {
+				edges[(y+1)*stride+x] |= 1 << dirUp
+			}
+		}
+	}
+
+	var ret [][]image.Point
+	for i := range edges {
+		for edges[i] != 0 {
+			// the first point in the raster order is at the top-left corner of its outline.
+			start := image.Pt(i%stride, i/stride)
+			p, dir := start, bits.TrailingZeros8(edges[i])
+			var path []image.Point
+			for {
+				edges[p.Y*stride+p.X] &^= 1 << dir
+				path = append(path, p)
+				for {
+					p = p.Add(dirVectors[dir])
+					if p == start {
+						break
+					}
+					next, ok := nextDir(edges[p.Y*stride+p.X], dir)
+					if !ok || next != dir {
+						dir = next
+						break
+					}
+					edges[p.Y*stride+p.X] &^= 1 << dir
+				}
+				if p == start {
+					break
+				}
+			}
+			ret = append(ret, path)
+		}
+	}
+	return ret
+}
+
+// nextDir chooses the edge that follows the edge in the direction dir.
+// If two edges meet at a corner, it turns right, so the modules touching at their corners are kept apart.
+func nextDir(edges uint8, dir int) (int, bool) {
+	for _, d := range [...]int{(dir + 1) % 4, dir, (dir + 3) % 4} {
+		if edges&(1<<d) != 0 {
+			return d, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,111 @@
+package render
+
+import (
+	"image"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/shogo82148/qrcode/bitmap"
+)
+
+func newBitmap(rows ...string) *bitmap.Image {
+	img := bitmap.New(image.Rect(0, 0, len(rows[0]), len(rows)))
+	for y, row := range rows {
+		for x, c := range row {
+			img.SetBinary(x, y, c == '#')
+		}
+	}
+	return img
+}
+
+func TestOutlines(t *testing.T) {
+	tests := []struct {
+		name string
+		img  *bitmap.Image
+		want [][]image.Point
+	}{
+		{
+			name: "single module",
+			img:  newBitmap("#"),
+			want: [][]image.Point{{{0, 0}, {1, 0}, {1, 1}, {0, 1}}},
+		},
+		{
+			name: "merged modules",
+			img: newBitmap(
+				"##",
+				"#.",
+			),
+			want: [][]image.Point{{{0, 0}, {2, 0}, {2, 1}, {1, 1}, {1, 2}, {0, 2}}},
+		},
+		{
+			name: "hole",
+			img: newBitmap(
+				"###",
+				"#.#",
+				"###",
+			),
+			want: [][]image.Point{
+				{{0, 0}, {3, 0}, {3, 3}, {0, 3}},
+				{{1, 1}, {1, 2}, {2, 2}, {2, 1}},
+			},
+		},
+		{
+			name: "touching corners",
+			img: newBitmap(
+				"#.",
+				".#",
+			),
+			want: [][]image.Point{
+				{{0, 0}, {1, 0}, {1, 1}, {0, 1}},
+				{{1, 1}, {2, 1}, {2, 2}, {1, 2}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := outlines(tt.img)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOutlines_Fill(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 100; n++ {
+		w, h := r.Intn(20)+1, r.Intn(20)+1
+		img := bitmap.New(image.Rect(0, 0, w, h))
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				img.SetBinary(x, y, r.Intn(2) == 0)
+			}
+		}
+
+		// fill the outlines with the nonzero rule, sampling the centers of the modules.
+		paths := outlines(img)
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				var winding int
+				for _, path := range paths {
+					for i, p := range path {
+						q := path[(i+1)%len(path)]
+						// count the vertical edges on the left of (x+0.5, y+0.5).
+						if p.X != q.X || p.X > x {
+							continue
+						}
+						if p.Y <= y && y < q.Y {
+							winding--
+						} else if q.Y <= y && y < p.Y {
+							winding++
+						}
+					}
+				}
+				if got, want := winding != 0, bool(img.BinaryAt(x, y)); got != want {
+					t.Fatalf("%d: (%d, %d): got %t, want %t", n, x, y, got, want)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package render
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+
+	"github.com/shogo82148/qrcode/bitmap"
+)
+
+// PDF writes img as a single-page PDF document.
+// The size of the page is exactly the module size times the number of modules.
+// The alpha channel of the colors is ignored,
+// except that a fully transparent background is left unpainted.
+// A nil opts means the default options.
+func PDF(w io.Writer, img *bitmap.Image, opts *Options) error {
+	quietZone := opts.quietZone()
+	scale := opts.moduleSize()
+	width, height := size(img, quietZone)
+	W, H := formatFloat(float64(width)*scale), formatFloat(float64(height)*scale)
+
+	// build the content stream.
+	var content bytes.Buffer
+	zw := zlib.NewWriter(&content)
+	bw := bufio.NewWriter(zw)
+	bw.WriteString("q\n")
+	if bg := opts.background(); !isTransparent(bg) {
+		fmt.Fprintf(bw, "%s rg 0 0 %s %s re f\n", formatRGB(bg), W, H)
+	}
+	fmt.Fprintf(bw, "%s 0 0 %s 0 0 cm\n", formatFloat(scale), formatFloat(scale))
+	fmt.Fprintf(bw, "%s rg\n", formatRGB(opts.foreground()))
+	writePolygons(bw, img, quietZone)
+	bw.WriteString("f\n")
+	bw.WriteString("Q\n")
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	// build the document.
+	var doc bytes.Buffer
+	var offsets []int
+	object := func(format string, args ...any) {
+		offsets = append(offsets, doc.Len())
+		fmt.Fprintf(&doc, "%d 0 obj\n", len(offsets))
+		fmt.Fprintf(&doc, format, args...)
+		doc.WriteString("\nendobj\n")
+	}
+	doc.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object("<< /Type /Pages /Kids [3 0 R] /Count 1 >>")
+	object("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << >> /Contents 4 0 R >>", W, H)
+	object("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", content.Len(), content.Bytes())
+
+	xref := doc.Len()
+	fmt.Fprintf(&doc, "xref\n0 %d\n", len(offsets)+1)
+	doc.WriteString("0000000000 65535 f \n")
+	for _, offset := range offsets {
+		fmt.Fprintf(&doc, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&doc, "trailer\n<< /Size %d /Root 1 0 R >>\n", len(offsets)+1)
+	fmt.Fprintf(&doc, "startxref\n%d\n%%%%EOF\n", xref)
+
+	_, err := doc.WriteTo(w)
+	return err
+}
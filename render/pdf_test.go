@@ -0,0 +1,78 @@
+package render
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image/color"
+	"io"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestPDF(t *testing.T) {
+	img := newBitmap(
+		"###",
+		"#.#",
+		"###",
+	)
+
+	var buf bytes.Buffer
+	err := PDF(&buf, img, &Options{
+		QuietZone:  1,
+		ModuleSize: 2.5,
+		Background: color.Transparent,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := buf.Bytes()
+
+	if !bytes.Contains(doc, []byte("/MediaBox [0 0 12.5 12.5]")) {
+		t.Errorf("unexpected media box: %q", doc)
+	}
+
+	// the cross-reference table must point to the objects.
+	m := regexp.MustCompile(`startxref\n(\d+)\n%%EOF\n$`).FindSubmatch(doc)
+	if m == nil {
+		t.Fatalf("startxref not found: %q", doc)
+	}
+	xref, _ := strconv.Atoi(string(m[1]))
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(doc[xref:], -1)
+	if len(entries) != 4 {
+		t.Fatalf("unexpected number of objects: %d", len(entries))
+	}
+	for i, entry := range entries {
+		offset, _ := strconv.Atoi(string(entry[1]))
+		want := fmt.Sprintf("%d 0 obj\n", i+1)
+		if !bytes.HasPrefix(doc[offset:], []byte(want)) {
+			t.Errorf("object %d is not at %d", i+1, offset)
+		}
+	}
+
+	// check the content stream.
+	m = regexp.MustCompile(`(?s)stream\n(.*)\nendstream`).FindSubmatch(doc)
+	if m == nil {
+		t.Fatalf("stream not found: %q", doc)
+	}
+	r, err := zlib.NewReader(bytes.NewReader(m[1]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `q
+2.5 0 0 2.5 0 0 cm
+0 0 0 rg
+1 4 m 4 4 l 4 1 l 1 1 l h
+2 3 m 2 2 l 3 2 l 3 3 l h
+f
+Q
+`
+	if string(content) != want {
+		t.Errorf("got %s, want %s", content, want)
+	}
+}
@@ -0,0 +1,104 @@
+package render
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/shogo82148/qrcode/bitmap"
+)
+
+// Raster draws img as a paletted image.
+// The index 0 of the palette is the background, and the index 1 is the foreground.
+// A nil opts means the default options.
+func Raster(img *bitmap.Image, opts *Options) (*image.Paletted, error) {
+	quietZone := opts.quietZone()
+	width, height := size(img, quietZone)
+
+	// cols[X] and rows[Y] are the coordinates of the module at the pixel (X, Y), relative to img.Bounds().Min.
+	var cols, rows []int
+	if W, H, ok := opts.imageSize(); ok {
+		if W < width || H < height {
+			return nil, errors.New("render: image size is smaller than the number of modules")
+		}
+		if opts.integerScale() {
+			scale := W / width
+			if s := H / height; s < scale {
+				scale = s
+			}
+			cols = snapModules(W, width, scale, quietZone)
+			rows = snapModules(H, height, scale, quietZone)
+		} else {
+			cols = spreadModules(W, width, quietZone)
+			rows = spreadModules(H, height, quietZone)
+		}
+	} else {
+		scale := opts.moduleSize()
+		cols = scaleModules(width, scale, quietZone)
+		rows = scaleModules(height, scale, quietZone)
+	}
+
+	palette := color.Palette{
+		opts.background(), opts.foreground(),
+	}
+	W, H := len(cols), len(rows)
+	ret := image.NewPaletted(image.Rect(0, 0, W, H), palette)
+
+	// fill the first line of each row of modules by the spans of the modules,
+	// and copy it to the other lines.
+	bounds := img.Bounds()
+	for Y := 0; Y < H; Y++ {
+		line := ret.Pix[Y*ret.Stride : Y*ret.Stride+W]
+		if Y > 0 && rows[Y] == rows[Y-1] {
+			copy(line, ret.Pix[(Y-1)*ret.Stride:])
+			continue
+		}
+		for X := 0; X < W; {
+			var c uint8
+			if img.BinaryAt(bounds.Min.X+cols[X], bounds.Min.Y+rows[Y]) {
+				c = 1
+			}
+			for x := cols[X]; X < W && cols[X] == x; X++ {
+				line[X] = c
+			}
+		}
+	}
+	return ret, nil
+}
+
+// scaleModules maps n modules scaled by the float scale into pixels.
+func scaleModules(n int, scale float64, quietZone int) []int {
+	N := int(math.Ceil(float64(n) * scale))
+	offset := float64(quietZone) * scale
+	ret := make([]int, N)
+	for X := range ret {
+		ret[X] = int(math.Floor((float64(X) - offset) / scale))
+	}
+	return ret
+}
+
+// spreadModules maps n modules into exactly N pixels.
+// The leftover pixels are spread evenly.
+func spreadModules(N, n, quietZone int) []int {
+	ret := make([]int, N)
+	for X := range ret {
+		ret[X] = X*n/N - quietZone
+	}
+	return ret
+}
+
+// snapModules maps n modules of the integer scale into exactly N pixels.
+// The leftover pixels are added to the quiet zone on both sides.
+func snapModules(N, n, scale, quietZone int) []int {
+	padding := (N - n*scale) / 2
+	ret := make([]int, N)
+	for X := range ret {
+		if X < padding {
+			ret[X] = -1 - quietZone
+			continue
+		}
+		ret[X] = (X-padding)/scale - quietZone
+	}
+	return ret
+}
@@ -0,0 +1,126 @@
+package render
+
+import (
+	"image/color"
+	"reflect"
+	"testing"
+
+	"github.com/shogo82148/qrcode/bitmap"
+)
+
+// moduleWidths returns the widths in pixels of the runs of the same color in the first row of the modules of img.
+func moduleWidths(t *testing.T, img *bitmap.Image, opts *Options) []int {
+	t.Helper()
+	ret, err := Raster(img, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var widths []int
+	var last uint8
+	Y := ret.Rect.Dy() * 3 / 8
+	for X := 0; X < ret.Rect.Dx(); X++ {
+		c := ret.ColorIndexAt(X, Y)
+		if X == 0 || c != last {
+			widths = append(widths, 0)
+		}
+		widths[len(widths)-1]++
+		last = c
+	}
+	return widths
+}
+
+func TestRaster(t *testing.T) {
+	img := newBitmap(
+		"#.#.#",
+		"#####",
+	)
+
+	tests := []struct {
+		name string
+		opts *Options
+		want []int
+	}{
+		{
+			name: "module size",
+			opts: &Options{QuietZone: 1, ModuleSize: 3},
+			want: []int{3, 3, 3, 3, 3, 3, 3},
+		},
+		{
+			name: "exact size",
+			opts: &Options{QuietZone: 1, Width: 24, Height: 24},
+			want: []int{4, 3, 4, 3, 4, 3, 3},
+		},
+		{
+			name: "integer scale",
+			opts: &Options{QuietZone: 1, Width: 24, Height: 24, IntegerScale: true},
+			want: []int{4, 3, 3, 3, 3, 3, 5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := moduleWidths(t, img, tt.opts)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	ret, err := Raster(img, &Options{Width: 30, Height: 12})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret.Rect.Dx() != 30 || ret.Rect.Dy() != 12 {
+		t.Errorf("unexpected size: %v", ret.Rect)
+	}
+
+	// the image must have a pixel per module at least.
+	for _, opts := range []*Options{
+		{QuietZone: 1, Width: 6, Height: 24},
+		{QuietZone: 1, Width: 24, Height: 3},
+		{QuietZone: 1, Width: 6, Height: 24, IntegerScale: true},
+	} {
+		if _, err := Raster(img, opts); err == nil {
+			t.Errorf("%+v: want error, but not", opts)
+		}
+	}
+}
+
+func TestRaster_Colors(t *testing.T) {
+	img := newBitmap(
+		"#.",
+		".#",
+	)
+	darkBlue := color.NRGBA{0x00, 0x20, 0x60, 0xff}
+
+	tests := []struct {
+		name           string
+		fg, bg         color.Color
+		wantFG, wantBG color.Color
+	}{
+		{"default", nil, nil, color.Black, color.White},
+		{"custom", darkBlue, color.Transparent, darkBlue, color.Transparent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ret, err := Raster(img, &Options{QuietZone: 1, Foreground: tt.fg, Background: tt.bg})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, p := range []struct {
+				x, y int
+				want color.Color
+			}{
+				{0, 0, tt.wantBG}, // quiet zone
+				{1, 1, tt.wantFG},
+				{2, 1, tt.wantBG},
+				{2, 2, tt.wantFG},
+				{3, 3, tt.wantBG}, // quiet zone
+			} {
+				got := color.NRGBAModel.Convert(ret.At(p.x, p.y))
+				if want := color.NRGBAModel.Convert(p.want); got != want {
+					t.Errorf("(%d, %d): got %v, want %v", p.x, p.y, got, want)
+				}
+			}
+		})
+	}
+}
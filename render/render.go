@@ -0,0 +1,177 @@
+// Package render draws the bitmaps of QR codes, Micro QR codes and rMQR codes in raster and vector formats and on terminals.
+package render
+
+import (
+	"bufio"
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+
+	"github.com/shogo82148/qrcode/bitmap"
+)
+
+// Millimeter is the length of a millimeter in points.
+const Millimeter = 72 / 25.4
+
+// Options are the options for the renderers.
+// The zero value of each field except QuietZone means its default.
+type Options struct {
+	// QuietZone is the width of the margin around the symbol in modules.
+	QuietZone int
+
+	// ModuleSize is the size of a module.
+	// The unit is pixels in Raster and SVG, and points in EPS and PDF. The default is 1.
+	// Multiply by Millimeter to give the size in millimeters.
+	ModuleSize float64
+
+	// Foreground is the color of the dark modules. The default is black.
+	Foreground color.Color
+
+	// Background is the color of the light modules and the quiet zone. The default is white.
+	// A fully transparent color leaves the background unpainted.
+	Background color.Color
+
+	// Scalable makes SVG omit the width and the height, so the image fits its container.
+	// Only the viewBox is set.
+	Scalable bool
+
+	// Invert makes Text reverse the video with the ANSI escape sequence,
+	// for the terminals that draw light text on a dark background.
+	Invert bool
+
+	// ASCII makes Text draw a module with two ASCII characters, instead of the half blocks.
+	ASCII bool
+
+	// Width and Height are the exact size of the image in pixels for Raster.
+	// If both are positive, ModuleSize is ignored,
+	// and the leftover pixels are spread evenly over the modules,
+	// so the sizes of the modules differ by one pixel at most.
+	// Raster returns an error if they are smaller than the number of modules including the quiet zone.
+	Width, Height int
+
+	// IntegerScale makes Raster use the same integer size for all modules with Width and Height,
+	// and pad the quiet zone with the leftover pixels instead.
+	IntegerScale bool
+}
+
+func (opts *Options) moduleSize() float64 {
+	if opts == nil || opts.ModuleSize <= 0 {
+		return 1
+	}
+	return opts.ModuleSize
+}
+
+func (opts *Options) quietZone() int {
+	if opts == nil || opts.QuietZone < 0 {
+		return 0
+	}
+	return opts.QuietZone
+}
+
+func (opts *Options) foreground() color.Color {
+	if opts == nil || opts.Foreground == nil {
+		return color.Black
+	}
+	return opts.Foreground
+}
+
+func (opts *Options) background() color.Color {
+	if opts == nil || opts.Background == nil {
+		return color.White
+	}
+	return opts.Background
+}
+
+func (opts *Options) scalable() bool {
+	return opts != nil && opts.Scalable
+}
+
+func (opts *Options) imageSize() (int, int, bool) {
+	if opts == nil || opts.Width <= 0 || opts.Height <= 0 {
+		return 0, 0, false
+	}
+	return opts.Width, opts.Height, true
+}
+
+func (opts *Options) integerScale() bool {
+	return opts != nil && opts.IntegerScale
+}
+
+func (opts *Options) invert() bool {
+	return opts != nil && opts.Invert
+}
+
+func (opts *Options) ascii() bool {
+	return opts != nil && opts.ASCII
+}
+
+// size returns the size of the image including the quiet zone in modules.
+func size(img *bitmap.Image, quietZone int) (int, int) {
+	bounds := img.Bounds()
+	return bounds.Dx() + quietZone*2, bounds.Dy() + quietZone*2
+}
+
+// isTransparent reports whether c is fully transparent.
+func isTransparent(c color.Color) bool {
+	_, _, _, a := c.RGBA()
+	return a == 0
+}
+
+// writePolygons writes the outlines of the dark modules of img as the path operators of PDF.
+// The origin is at the bottom-left corner of the quiet zone, and the unit is a module.
+func writePolygons(w *bufio.Writer, img *bitmap.Image, quietZone int) {
+	_, height := size(img, quietZone)
+	for _, path := range outlines(img) {
+		for i, p := range path {
+			op := "l"
+			if i == 0 {
+				op = "m"
+			}
+			fmt.Fprintf(w, "%d %d %s ", p.X+quietZone, height-p.Y-quietZone, op)
+		}
+		w.WriteString("h\n")
+	}
+}
+
+// formatRGB formats c as the operands of the color operators of PostScript and PDF.
+// The alpha channel is ignored.
+func formatRGB(c color.Color) string {
+	nrgba := color.NRGBAModel.Convert(c).(color.NRGBA)
+	return formatFloat(math.Round(float64(nrgba.R)/0xff*1000)/1000) + " " +
+		formatFloat(math.Round(float64(nrgba.G)/0xff*1000)/1000) + " " +
+		formatFloat(math.Round(float64(nrgba.B)/0xff*1000)/1000)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// MinContrast is the minimum contrast between the foreground and the background for scanners.
+// It corresponds to the symbol contrast grade C of ISO/IEC 15415.
+const MinContrast = 0.4
+
+// Contrast returns the difference of the relative luminances between fg and bg, from 0 to 1.
+// bg is composited over white, and fg is composited over bg, as printed on white paper.
+func Contrast(fg, bg color.Color) float64 {
+	bgLum := luminance(bg, 1)
+	fgLum := luminance(fg, bgLum)
+	return math.Abs(bgLum - fgLum)
+}
+
+// luminance returns the relative luminance of c composited over the gray of the luminance base.
+func luminance(c color.Color, base float64) float64 {
+	nrgba := color.NRGBA64Model.Convert(c).(color.NRGBA64)
+	alpha := float64(nrgba.A) / 0xffff
+	lum := 0.2126*linearize(nrgba.R) + 0.7152*linearize(nrgba.G) + 0.0722*linearize(nrgba.B)
+	return lum*alpha + base*(1-alpha)
+}
+
+// linearize converts the sRGB component v into the linear light.
+func linearize(v uint16) float64 {
+	f := float64(v) / 0xffff
+	if f <= 0.04045 {
+		return f / 12.92
+	}
+	return math.Pow((f+0.055)/1.055, 2.4)
+}
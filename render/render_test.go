@@ -0,0 +1,28 @@
+package render
+
+import (
+	"image/color"
+	"math"
+	"testing"
+)
+
+func TestContrast(t *testing.T) {
+	tests := []struct {
+		fg, bg color.Color
+		want   float64
+	}{
+		{color.Black, color.White, 1},
+		{color.White, color.Black, 1},
+		{color.Black, color.Transparent, 1},
+		{color.Black, color.Black, 0},
+		{color.Gray{0x80}, color.White, 0.784},
+		{color.NRGBA{0x00, 0x00, 0x00, 0x80}, color.White, 0.502},
+		{color.NRGBA{0x00, 0x20, 0x60, 0xff}, color.Transparent, 0.982},
+	}
+	for _, tt := range tests {
+		got := Contrast(tt.fg, tt.bg)
+		if math.Abs(got-tt.want) > 0.001 {
+			t.Errorf("Contrast(%v, %v): got %f, want %f", tt.fg, tt.bg, got, tt.want)
+		}
+	}
+}
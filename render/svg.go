@@ -0,0 +1,64 @@
+package render
+
+import (
+	"bufio"
+	"fmt"
+	"image/color"
+	"io"
+	"math"
+
+	"github.com/shogo82148/qrcode/bitmap"
+)
+
+// SVG writes img in the SVG format.
+// The dark modules are merged into outlines, instead of drawing one rectangle per module.
+// A nil opts means the default options.
+func SVG(w io.Writer, img *bitmap.Image, opts *Options) error {
+	bw := bufio.NewWriter(w)
+	quietZone := opts.quietZone()
+	width, height := size(img, quietZone)
+
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" version="1.1" viewBox="0 0 %d %d"`, width, height)
+	if !opts.scalable() {
+		scale := opts.moduleSize()
+		fmt.Fprintf(bw, ` width="%s" height="%s"`, formatFloat(float64(width)*scale), formatFloat(float64(height)*scale))
+	}
+	bw.WriteString(` shape-rendering="crispEdges">` + "\n")
+
+	if bg := opts.background(); !isTransparent(bg) {
+		fmt.Fprintf(bw, `<rect width="%d" height="%d"%s/>`+"\n", width, height, svgFill(bg))
+	}
+
+	bw.WriteString(`<path d="`)
+	for _, path := range outlines(img) {
+		for i, p := range path {
+			x, y := p.X+quietZone, p.Y+quietZone
+			switch {
+			case i == 0:
+				fmt.Fprintf(bw, "M%d %d", x, y)
+			case p.Y == path[i-1].Y:
+				fmt.Fprintf(bw, "H%d", x)
+			default:
+				fmt.Fprintf(bw, "V%d", y)
+			}
+		}
+		bw.WriteString("Z")
+	}
+	fmt.Fprintf(bw, `"%s/>`+"\n", svgFill(opts.foreground()))
+
+	bw.WriteString("</svg>\n")
+	return bw.Flush()
+}
+
+// svgFill returns the fill attributes for c.
+func svgFill(c color.Color) string {
+	nrgba := color.NRGBAModel.Convert(c).(color.NRGBA)
+	if nrgba.A == 0 {
+		return ` fill="none"`
+	}
+	fill := fmt.Sprintf(` fill="#%02x%02x%02x"`, nrgba.R, nrgba.G, nrgba.B)
+	if nrgba.A != 0xff {
+		fill += ` fill-opacity="` + formatFloat(math.Round(float64(nrgba.A)/0xff*1000)/1000) + `"`
+	}
+	return fill
+}
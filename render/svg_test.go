@@ -0,0 +1,45 @@
+package render
+
+import (
+	"bytes"
+	"image/color"
+	"testing"
+)
+
+func TestSVG(t *testing.T) {
+	img := newBitmap(
+		"###",
+		"#.#",
+		"###",
+	)
+
+	var buf bytes.Buffer
+	if err := SVG(&buf, img, &Options{QuietZone: 1, ModuleSize: 2.5}); err != nil {
+		t.Fatal(err)
+	}
+	want := `<svg xmlns="http://www.w3.org/2000/svg" version="1.1" viewBox="0 0 5 5" width="12.5" height="12.5" shape-rendering="crispEdges">
+<rect width="5" height="5" fill="#ffffff"/>
+<path d="M1 1H4V4H1ZM2 2V3H3V2Z" fill="#000000"/>
+</svg>
+`
+	if buf.String() != want {
+		t.Errorf("got %s, want %s", buf.String(), want)
+	}
+
+	buf.Reset()
+	err := SVG(&buf, img, &Options{
+		Foreground: color.NRGBA{0x00, 0x20, 0x60, 0x80},
+		Background: color.Transparent,
+		Scalable:   true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = `<svg xmlns="http://www.w3.org/2000/svg" version="1.1" viewBox="0 0 3 3" shape-rendering="crispEdges">
+<path d="M0 0H3V3H0ZM1 1V2H2V1Z" fill="#002060" fill-opacity="0.502"/>
+</svg>
+`
+	if buf.String() != want {
+		t.Errorf("got %s, want %s", buf.String(), want)
+	}
+}
@@ -0,0 +1,60 @@
+package render
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/shogo82148/qrcode/bitmap"
+)
+
+// the half blocks indexed by the top module and the bottom module.
+var halfBlocks = [2][2]string{
+	{" ", "▄"},
+	{"▀", "█"},
+}
+
+// Text writes img as text for terminals.
+// It draws two rows of modules per line with the half blocks,
+// or one row per line with two ASCII characters per module if opts.ASCII is true.
+// The dark modules are drawn with the characters, so the terminal must draw dark text on a light background,
+// or opts.Invert must be true.
+// A nil opts means the default options.
+func Text(w io.Writer, img *bitmap.Image, opts *Options) error {
+	bw := bufio.NewWriter(w)
+	quietZone := opts.quietZone()
+	width, height := size(img, quietZone)
+	bounds := img.Bounds()
+	dark := func(x, y int) int {
+		// BinaryAt returns White out of bounds, so the quiet zone is light.
+		if img.BinaryAt(bounds.Min.X+x-quietZone, bounds.Min.Y+y-quietZone) {
+			return 1
+		}
+		return 0
+	}
+
+	step := 2
+	if opts.ascii() {
+		step = 1
+	}
+	for y := 0; y < height; y += step {
+		if opts.invert() {
+			bw.WriteString("\x1b[7m")
+		}
+		for x := 0; x < width; x++ {
+			if opts.ascii() {
+				if dark(x, y) == 1 {
+					bw.WriteString("##")
+				} else {
+					bw.WriteString("  ")
+				}
+				continue
+			}
+			bw.WriteString(halfBlocks[dark(x, y)][dark(x, y+1)])
+		}
+		if opts.invert() {
+			bw.WriteString("\x1b[0m")
+		}
+		bw.WriteString("\n")
+	}
+	return bw.Flush()
+}
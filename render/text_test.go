@@ -0,0 +1,57 @@
+package render
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestText(t *testing.T) {
+	img := newBitmap(
+		"##.",
+		"#.#",
+		".##",
+	)
+	tests := []struct {
+		name string
+		opts *Options
+		want string
+	}{
+		{
+			name: "half blocks",
+			opts: nil,
+			want: "█▀▄\n" +
+				" ▀▀\n",
+		},
+		{
+			name: "quiet zone",
+			opts: &Options{QuietZone: 1},
+			want: " ▄▄  \n" +
+				" ▀▄█ \n" +
+				"     \n",
+		},
+		{
+			name: "invert",
+			opts: &Options{Invert: true},
+			want: "\x1b[7m█▀▄\x1b[0m\n" +
+				"\x1b[7m ▀▀\x1b[0m\n",
+		},
+		{
+			name: "ascii",
+			opts: &Options{ASCII: true},
+			want: "####  \n" +
+				"##  ##\n" +
+				"  ####\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Text(&buf, img, tt.opts); err != nil {
+				t.Fatal(err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("got %q, want %q", buf.String(), tt.want)
+			}
+		})
+	}
+}
@@ -4,24 +4,152 @@ package rmqr
 import (
 	"errors"
 	"fmt"
+	"image"
 	"io"
 	"math/bits"
 
 	"github.com/shogo82148/qrcode/bitmap"
 	internalbitmap "github.com/shogo82148/qrcode/internal/bitmap"
 	"github.com/shogo82148/qrcode/internal/bitstream"
+	"github.com/shogo82148/qrcode/internal/detector"
 	"github.com/shogo82148/qrcode/internal/reedsolomon"
 )
 
+// DecodeInfo is the detail of decoding a rMQR code.
+type DecodeInfo struct {
+	// Transform is the transform that was applied to the image to decode it.
+	Transform bitmap.Transform
+
+	// FormatCopy is the copy of the format information that was used.
+	// 1 is the copy around the finder pattern,
+	// and 2 is the copy around the sub-finder pattern.
+	FormatCopy int
+
+	// FormatDistance is the Hamming distance between the format information
+	// and the nearest valid codeword, i.e. the number of bit errors.
+	FormatDistance int
+
+	// Corrected is the number of corrected codewords in each block.
+	Corrected []int
+}
+
+// Decode decodes a rMQR code from the image.
+// Unlike DecodeBitmap, img can be an arbitrary image such as a photograph.
+func Decode(img image.Image) (*QRCode, error) {
+	for _, binarize := range [...]func(image.Image) *bitmap.Image{bitmap.BinarizeHybrid, bitmap.Binarize} {
+		binimg := binarize(img)
+
+		// try light-on-dark symbols too.
+		for _, t := range [...]bitmap.Transform{{}, {Invert: true}} {
+			if qr, ok := decode(t.Apply(binimg)); ok {
+				return qr, nil
+			}
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func decode(binimg *bitmap.Image) (*QRCode, bool) {
+	for _, candidates := range detector.DetectRMQR(binimg, symbolSize) {
+		for _, result := range candidates {
+			if qr, err := DecodeBitmap(result.Bitmap); err == nil {
+				return qr, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// symbolSize reads the format information, and returns the size of the symbol.
+func symbolSize(s *detector.Sampler) (width, height int, ok bool) {
+	version, _, distance := decodeFormat0(readFormat(s.Module) ^ 0b011111101010110010)
+	if distance > 3 {
+		return 0, 0, false
+	}
+	return version.Width(), version.Height(), true
+}
+
+// Symbol is a rMQR code found in an image.
+type Symbol struct {
+	QRCode *QRCode
+
+	// Corners are the corners of the symbol in the image.
+	// The order is top-left, top-right, bottom-right and bottom-left.
+	Corners [4]image.Point
+}
+
+// DecodeAll decodes all rMQR codes in the image.
+func DecodeAll(img image.Image) []*Symbol {
+	binimg := bitmap.BinarizeHybrid(img)
+	var found []*detector.Result
+	var symbols []*Symbol
+LOOP:
+	for _, candidates := range detector.DetectRMQR(binimg, symbolSize) {
+		for _, result := range candidates {
+			for _, f := range found {
+				if f.Overlaps(result) {
+					continue LOOP
+				}
+			}
+			qr, err := DecodeBitmap(result.Bitmap)
+			if err != nil {
+				continue
+			}
+			found = append(found, result)
+			symbols = append(symbols, &Symbol{
+				QRCode:  qr,
+				Corners: result.Polygon(),
+			})
+			continue LOOP
+		}
+	}
+	return symbols
+}
+
+// DecodeBitmap decodes a rMQR code from the bitmap.
+// img must be exactly one pixel per module without quiet zone.
 func DecodeBitmap(img *bitmap.Image) (*QRCode, error) {
+	qr, _, err := DecodeBitmapWithInfo(img)
+	return qr, err
+}
+
+// DecodeBitmapWithInfo is like DecodeBitmap, but it also returns the detail of decoding.
+// It tries the rotated, mirrored and inverted images of img, and reports the used one in DecodeInfo.Transform.
+func DecodeBitmapWithInfo(img *bitmap.Image) (*QRCode, *DecodeInfo, error) {
+	var firstErr error
+	for _, t := range bitmap.Transforms() {
+		timg := t.Apply(img)
+		if bounds := timg.Bounds(); bounds.Dx() <= bounds.Dy() {
+			// rMQR codes are always wider than they are tall.
+			continue
+		}
+		qr, info, err := decodeBitmap(timg)
+		if err == nil {
+			info.Transform = t
+			return qr, info, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	if firstErr == nil {
+		return nil, nil, errors.New("rmqr: rMQR code must be wider than it is tall")
+	}
+	return nil, nil, firstErr
+}
+
+func decodeBitmap(img *bitmap.Image) (*QRCode, *DecodeInfo, error) {
 	binimg := internalbitmap.Import(img)
 	bounds := img.Bounds()
 	w := bounds.Dx() - 1
 	h := bounds.Dy() - 1
 
-	version, level, err := decodeFormat(binimg)
+	version, level, formatCopy, formatDistance, err := decodeFormat(binimg)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	if bounds.Dx() != version.Width() || bounds.Dy() != version.Height() {
+		return nil, nil, fmt.Errorf("rmqr: invalid size for version %s: %dx%d", version, bounds.Dx(), bounds.Dy())
 	}
 	used := usedList[version]
 	binimg.Mask(binimg, used, precomputedMask)
@@ -64,19 +192,41 @@ func DecodeBitmap(img *bitmap.Image) (*QRCode, error) {
 
 	// error correction
 	var result []byte
-	for _, blk := range blocks {
+	corrected := make([]int, 0, len(blocks))
+	for i, blk := range blocks {
 		data := append(blk.data, blk.correction...)
-		if err := reedsolomon.Decode(data, 2); err != nil {
-			return nil, err
+		n, err := reedsolomon.Decode(data, len(blk.correction))
+		if err != nil || n > blk.maxError {
+			return nil, nil, &TooManyErrorsError{Block: i}
 		}
+		corrected = append(corrected, n)
 		result = append(result, data[:len(blk.data)]...)
 	}
 
 	// decode segments
 	capacity := capacityTable[version][level]
-	stream := bitstream.NewBuffer(result[:capacity.Data])
+	segments, err := decodeSegments(capacity.BitLength, bitstream.NewBuffer(result[:capacity.Data]))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	qr := &QRCode{
+		Version:  version,
+		Level:    level,
+		Segments: segments,
+	}
+	info := &DecodeInfo{
+		FormatCopy:     formatCopy,
+		FormatDistance: formatDistance,
+		Corrected:      corrected,
+	}
+	return qr, info, nil
+}
+
+// decodeSegments reads the segments from the data codewords until the terminator.
+// bitLength is the lengths of the character count indicators for each mode.
+func decodeSegments(bitLength [5]int, stream *bitstream.Buffer) ([]Segment, error) {
 	segments := make([]Segment, 0)
-	bitLength := capacity.BitLength
 LOOP:
 	for {
 		mode, err := stream.ReadBits(3)
@@ -111,59 +261,68 @@ LOOP:
 		case ModeTerminated:
 			break LOOP
 		default:
-			return nil, fmt.Errorf("rmqr: unknown mode: %d", mode)
+			return nil, &InvalidModeError{Mode: Mode(mode)}
 		}
 	}
-
-	return &QRCode{
-		Version:  version,
-		Level:    level,
-		Segments: segments,
-	}, nil
+	return segments, nil
 }
 
-func decodeFormat(img *internalbitmap.Image) (Version, Level, error) {
+// decodeFormat reads both copies of the format information,
+// and returns the one nearest to a valid codeword.
+// formatCopy is 1 for the copy around the finder pattern,
+// and 2 for the copy around the sub-finder pattern.
+func decodeFormat(img *internalbitmap.Image) (version Version, level Level, formatCopy, distance int, err error) {
 	bounds := img.Rect
 	w := bounds.Dx() - 1
 	h := bounds.Dy() - 1
 
 	// search version info around finder pattern
-	var rawVersion uint
-	for i := 0; i < 18; i++ {
-		if img.BinaryAt(8+i/5, 1+i%5) {
-			rawVersion |= 1 << i
-		}
-	}
-	version, level, ok := decodeFormat0(rawVersion ^ 0b011111101010110010)
-	if ok {
-		return version, level, nil
-	}
+	rawVersion1 := readFormat(img.BinaryAt)
 
 	// search version info around sub-finder pattern
-	rawVersion = 0
+	var rawVersion2 uint
 	for i := 0; i < 15; i++ {
 		if img.BinaryAt(w-7+i/5, h-5+i%5) {
-			rawVersion |= 1 << i
+			rawVersion2 |= 1 << i
 		}
 	}
 	if img.BinaryAt(w-4, h-5) {
-		rawVersion |= 1 << 15
+		rawVersion2 |= 1 << 15
 	}
 	if img.BinaryAt(w-3, h-5) {
-		rawVersion |= 1 << 16
+		rawVersion2 |= 1 << 16
 	}
 	if img.BinaryAt(w-2, h-5) {
-		rawVersion |= 1 << 17
+		rawVersion2 |= 1 << 17
+	}
+
+	version, level, distance = decodeFormat0(rawVersion1 ^ 0b011111101010110010)
+	formatCopy = 1
+	if version2, level2, distance2 := decodeFormat0(rawVersion2 ^ 0b100000101001111011); distance2 < distance {
+		version, level, distance = version2, level2, distance2
+		formatCopy = 2
 	}
-	version, level, ok = decodeFormat0(rawVersion ^ 0b100000101001111011)
-	if ok {
-		return version, level, nil
+
+	// the format information can correct up to 3 bit errors.
+	if distance > 3 {
+		return 0, 0, 0, 0, ErrFormatNotFound
 	}
+	return version, level, formatCopy, distance, nil
+}
 
-	return 0, 0, errors.New("rmqr: rMRQ not found")
+// readFormat reads the format information around the finder pattern through at.
+func readFormat(at func(x, y int) bitmap.Color) uint {
+	var rawVersion uint
+	for i := 0; i < 18; i++ {
+		if at(8+i/5, 1+i%5) {
+			rawVersion |= 1 << i
+		}
+	}
+	return rawVersion
 }
 
-func decodeFormat0(data uint) (Version, Level, bool) {
+// decodeFormat0 returns the format nearest to data, and the Hamming distance between them.
+func decodeFormat0(data uint) (Version, Level, int) {
 	var idx, min int
 	min = bits.OnesCount(encodedVersion[0] ^ data)
 	for i, v := range encodedVersion {
@@ -173,10 +332,7 @@ func decodeFormat0(data uint) (Version, Level, bool) {
 			min = diff
 		}
 	}
-	if min >= 3 {
-		return 0, 0, false
-	}
-	return Version(idx & 0x1f), Level((idx >> 5) & 1), true
+	return Version(idx & 0x1f), Level((idx >> 5) & 1), min
 }
 
 func decodeFromBits(version Version, level Level, buf []byte) []block {
@@ -2,8 +2,11 @@ package rmqr
 
 import (
 	"image"
+	"image/color"
 	"image/png"
+	"math"
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/shogo82148/qrcode/bitmap"
@@ -450,3 +453,184 @@ func TestDecode12(t *testing.T) {
 		t.Errorf("unexpected data: got %q, want %q", string(seg.Data), want)
 	}
 }
+
+func TestDecodeBitmapWithInfo_Damaged(t *testing.T) {
+	qr, err := New([]byte("12345678901234567890"), WithLevel(LevelH))
+	if err != nil {
+		t.Fatal(err)
+	}
+	binimg, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// scratch the symbol.
+	for y := 2; y < 5; y++ {
+		for x := 12; x < 15; x++ {
+			binimg.SetBinary(x, y, !binimg.BinaryAt(x, y))
+		}
+	}
+
+	got, info, err := DecodeBitmapWithInfo(binimg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got.Segments[0].Data) != "12345678901234567890" {
+		t.Errorf("unexpected data: got %q, want %q", got.Segments[0].Data, "12345678901234567890")
+	}
+	var sum int
+	for _, n := range info.Corrected {
+		sum += n
+	}
+	if sum < 2 {
+		t.Errorf("want more than one corrected codeword, got %d", sum)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		version Version
+	}{
+		{"rmqr2.png", R15x59},
+		{"r7x43.png", R7x43},
+		{"r7x139.png", R7x139},
+		{"r9x43.png", R9x43},
+		{"r9x139.png", R9x139},
+		{"r11x27.png", R11x27},
+		{"r11x139.png", R11x139},
+		{"r15x43.png", R15x43},
+		{"r15x139.png", R15x139},
+		{"r17x43.png", R17x43},
+		{"r17x139.png", R17x139},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := os.Open("testdata/" + tt.name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Close()
+			img, err := png.Decode(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			qr, err := Decode(img)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if qr.Version != tt.version {
+				t.Errorf("unexpected version: got %d, want %d", qr.Version, tt.version)
+			}
+		})
+	}
+}
+
+func TestDecode_Rotate(t *testing.T) {
+	data := "Rectangular Micro QR Code (rMQR)"
+	img, err := Encode([]byte(data), WithModuleSize(6), WithLevel(LevelM))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, angle := range []float64{0, 10, 45, 80, 135, 200, 300} {
+		rotated := rotate(img, angle*math.Pi/180)
+		qr, err := Decode(rotated)
+		if err != nil {
+			t.Errorf("angle %v: %v", angle, err)
+			continue
+		}
+		if got := string(qr.Segments[0].Data); got != data {
+			t.Errorf("angle %v: unexpected data: got %q, want %q", angle, got, data)
+		}
+	}
+}
+
+// rotate rotates img by angle.
+func rotate(img image.Image, angle float64) image.Image {
+	bounds := img.Bounds()
+	size := int(float64(bounds.Dx()) * 1.2)
+	ret := image.NewGray(image.Rect(0, 0, size, size))
+	cx, cy := float64(size)/2, float64(size)/2
+	sx, sy := float64(bounds.Min.X+bounds.Max.X)/2, float64(bounds.Min.Y+bounds.Max.Y)/2
+	sin, cos := math.Sincos(angle)
+	for Y := 0; Y < size; Y++ {
+		for X := 0; X < size; X++ {
+			u, v := float64(X)-cx, float64(Y)-cy
+			x := cos*u + sin*v + sx
+			y := -sin*u + cos*v + sy
+			p := image.Pt(int(math.Floor(x)), int(math.Floor(y)))
+			if !p.In(bounds) {
+				ret.SetGray(X, Y, color.Gray{Y: 0xff})
+				continue
+			}
+			ret.Set(X, Y, img.At(p.X, p.Y))
+		}
+	}
+	return ret
+}
+
+func TestDecodeBitmapWithInfo_Transform(t *testing.T) {
+	qr, err := New([]byte("12345678901234567890"), WithLevel(LevelM))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tr := range bitmap.Transforms() {
+		img := tr.Apply(want)
+		got, info, err := DecodeBitmapWithInfo(img)
+		if err != nil {
+			t.Errorf("%+v: %v", tr, err)
+			continue
+		}
+		if string(got.Segments[0].Data) != "12345678901234567890" {
+			t.Errorf("%+v: unexpected data: got %q, want %q", tr, got.Segments[0].Data, "12345678901234567890")
+		}
+
+		// info.Transform restores the original image.
+		restored := info.Transform.Apply(img)
+		if !reflect.DeepEqual(restored, want) {
+			t.Errorf("%+v: unexpected transform: %+v", tr, info.Transform)
+		}
+	}
+}
+
+func TestDecodeBitmapWithInfo_FormatCopy(t *testing.T) {
+	qr, err := New([]byte("12345678901234567890"), WithLevel(LevelM))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := qr.EncodeToBitmap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := img.Bounds().Dx() - 1
+	h := img.Bounds().Dy() - 1
+
+	// 4 bit errors in the first copy, and 2 bit errors in the second copy.
+	for i := 0; i < 4; i++ {
+		img.SetBinary(8, 1+i, !img.BinaryAt(8, 1+i))
+	}
+	for i := 0; i < 2; i++ {
+		img.SetBinary(w-7, h-5+i, !img.BinaryAt(w-7, h-5+i))
+	}
+
+	got, info, err := DecodeBitmapWithInfo(img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Version != qr.Version || got.Level != qr.Level {
+		t.Errorf("unexpected format: got %v/%v, want %v/%v", got.Version, got.Level, qr.Version, qr.Level)
+	}
+	if info.FormatCopy != 2 {
+		t.Errorf("unexpected format copy: got %d, want 2", info.FormatCopy)
+	}
+	if info.FormatDistance != 2 {
+		t.Errorf("unexpected format distance: got %d, want 2", info.FormatDistance)
+	}
+}
@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"image"
 	"image/color"
+	"io"
 	"math"
 	"unicode/utf8"
 
 	"github.com/shogo82148/qrcode/bitmap"
 	"github.com/shogo82148/qrcode/internal/bitstream"
 	"github.com/shogo82148/qrcode/internal/reedsolomon"
+	"github.com/shogo82148/qrcode/render"
 )
 
 func New(data []byte, opts ...EncodeOptions) (*QRCode, error) {
@@ -19,11 +21,60 @@ func New(data []byte, opts ...EncodeOptions) (*QRCode, error) {
 	if !lv.IsValid() {
 		return nil, fmt.Errorf("qrcode: invalid level: %d", lv)
 	}
+	if myopts.Version != versionAuto && !myopts.Version.IsValid() {
+		return nil, fmt.Errorf("rmqr: invalid version: %d", myopts.Version)
+	}
+
+	var qr *QRCode
+	var err error
 	if myopts.Kanji {
-		return newFromKanji(lv, myopts.Priority, data)
+		qr, err = newFromKanji(lv, myopts.Priority, data, myopts.ShiftJIS)
 	} else {
-		return newQR(lv, myopts.Priority, data)
+		qr, err = newQR(lv, myopts.Priority, data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := selectVersion(qr, myopts); err != nil {
+		return nil, err
+	}
+	return qr, nil
+}
+
+// selectVersion changes the version of qr to satisfy WithVersion, WithMaxWidth and WithMaxHeight.
+func selectVersion(qr *QRCode, opts encodeOptions) error {
+	if opts.Version != versionAuto {
+		if !fits(qr.Level, opts.Version, qr.Segments) {
+			return newDataTooLargeError(qr.Level, opts.Version, qr.Segments)
+		}
+		qr.Version = opts.Version
+		return nil
+	}
+
+	inSize := func(version Version) bool {
+		return (opts.MaxWidth <= 0 || version.Width() <= opts.MaxWidth) &&
+			(opts.MaxHeight <= 0 || version.Height() <= opts.MaxHeight)
+	}
+	if inSize(qr.Version) {
+		return nil
+	}
+	largest := versionAuto
+	for _, version := range capacityOrder(opts.Priority) {
+		if !inSize(version) {
+			continue
+		}
+		if fits(qr.Level, version, qr.Segments) {
+			qr.Version = version
+			return nil
+		}
+		if largest == versionAuto || capacityTable[version][qr.Level].Data > capacityTable[largest][qr.Level].Data {
+			largest = version
+		}
+	}
+	if largest == versionAuto {
+		return fmt.Errorf("rmqr: no version fits in %dx%d", opts.MaxWidth, opts.MaxHeight)
 	}
+	return newDataTooLargeError(qr.Level, largest, qr.Segments)
 }
 
 func newQR(level Level, priority Priority, data []byte) (*QRCode, error) {
@@ -165,7 +216,8 @@ func newQR(level Level, priority Priority, data []byte) (*QRCode, error) {
 
 	version, ok := calcVersion(level, priority, segments)
 	if !ok {
-		return nil, errors.New("qrcode: data too large")
+		// R17x139 has the largest capacity.
+		return nil, newDataTooLargeError(level, R17x139, segments)
 	}
 
 	return &QRCode{
@@ -175,7 +227,7 @@ func newQR(level Level, priority Priority, data []byte) (*QRCode, error) {
 	}, nil
 }
 
-func newFromKanji(level Level, priority Priority, data []byte) (*QRCode, error) {
+func newFromKanji(level Level, priority Priority, data []byte, shiftJIS bool) (*QRCode, error) {
 	if len(data) == 0 {
 		return &QRCode{
 			Version: R7x43,
@@ -196,6 +248,9 @@ func newFromKanji(level Level, priority Priority, data []byte) (*QRCode, error)
 		cost     int // = bit length * 6
 		lastMode int
 		data     []byte
+
+		// text is the data in UTF-8 if data is Shift_JIS.
+		text []byte
 	}
 	states := make([][5]state, len(data)+1)
 	states[0][modeNumeric].cost = inf
@@ -203,6 +258,7 @@ func newFromKanji(level Level, priority Priority, data []byte) (*QRCode, error)
 	states[0][modeBytes].cost = inf
 	states[0][modeKanji].cost = inf
 
+	kanjiRunes, kanjiSizes := bitstream.KanjiRunes(data, shiftJIS)
 	for i := 0; i < len(data); i++ {
 		if i != 0 {
 			states[i][modeInit].cost = inf
@@ -283,8 +339,7 @@ func newFromKanji(level Level, priority Priority, data []byte) (*QRCode, error)
 		}
 
 		// kanji
-		r, size := utf8.DecodeRune(data[i:])
-		if r != utf8.RuneError && bitstream.IsKanji(r) && i+size < len(states) {
+		if size := kanjiSizes[i]; size > 0 && i+size < len(states) {
 			minCost := states[i][modeInit].cost + (4+12+13)*6
 			lastMode := modeInit
 			for mode := modeInit + 1; mode < modeMax; mode++ {
@@ -302,10 +357,16 @@ func newFromKanji(level Level, priority Priority, data []byte) (*QRCode, error)
 				states[i+j][modeKanji].cost = inf
 			}
 
+			var text []byte
+			if shiftJIS {
+				// the data of kanji mode segments are UTF-8.
+				text = utf8.AppendRune(nil, kanjiRunes[i])
+			}
 			states[i+size][modeKanji] = state{
 				cost:     minCost,
 				lastMode: lastMode,
 				data:     data[i : i+size],
+				text:     text,
 			}
 		} else if states[i+1][modeKanji].data == nil {
 			states[i+1][modeKanji] = state{
@@ -321,6 +382,12 @@ func newFromKanji(level Level, priority Priority, data []byte) (*QRCode, error)
 		mode int
 		data []byte
 	}
+	segmentData := func(s state) []byte {
+		if s.text != nil {
+			return s.text
+		}
+		return s.data
+	}
 	best := make([]elem, 0, len(data))
 	minCost := states[len(data)][modeNumeric].cost
 	bestMode := modeNumeric
@@ -332,7 +399,7 @@ func newFromKanji(level Level, priority Priority, data []byte) (*QRCode, error)
 	}
 	best = append(best, elem{
 		mode: bestMode,
-		data: states[len(data)][bestMode].data,
+		data: segmentData(states[len(data)][bestMode]),
 	})
 	for i := len(data); ; {
 		size := len(states[i][bestMode].data)
@@ -343,7 +410,7 @@ func newFromKanji(level Level, priority Priority, data []byte) (*QRCode, error)
 		i -= size
 		best = append(best, elem{
 			mode: bestMode,
-			data: states[i][bestMode].data,
+			data: segmentData(states[i][bestMode]),
 		})
 	}
 
@@ -368,7 +435,8 @@ func newFromKanji(level Level, priority Priority, data []byte) (*QRCode, error)
 
 	version, ok := calcVersion(level, priority, segments)
 	if !ok {
-		return nil, errors.New("qrcode: data too large")
+		// R17x139 has the largest capacity.
+		return nil, newDataTooLargeError(level, R17x139, segments)
 	}
 
 	return &QRCode{
@@ -382,38 +450,62 @@ func calcVersion(level Level, priority Priority, segments []Segment) (Version, b
 	if !level.IsValid() {
 		return 0, false
 	}
+	for _, version := range capacityOrder(priority) {
+		if fits(level, version, segments) {
+			return version, true
+		}
+	}
+	return 0, false
+}
 
-	var order []Version
+// capacityOrder returns the versions in the order of priority.
+func capacityOrder(priority Priority) []Version {
 	switch priority {
 	case PriorityArea:
-		order = capacityOrderArea
+		return capacityOrderArea
 	case PriorityHeight:
-		order = capacityOrderHeight
+		return capacityOrderHeight
 	case PriorityWidth:
-		order = capacityOrderWidth
-	default:
-		return 0, false
+		return capacityOrderWidth
 	}
+	return nil
+}
 
-LOOP:
-	for _, version := range order {
-		capacity := capacityTable[version][level].Data * 8
-		length := 0
-		for _, s := range segments {
-			l, ok := s.length(version, level)
-			if !ok {
-				continue LOOP
-			}
-			length += l
-			if length > capacity {
-				continue LOOP
-			}
+// fits reports whether the segments fit in the version.
+func fits(level Level, version Version, segments []Segment) bool {
+	capacity := capacityTable[version][level].Data * 8
+	length := 0
+	for _, s := range segments {
+		l, ok := s.length(version, level)
+		if !ok {
+			return false
 		}
-		if length <= capacity {
-			return version, true
+		length += l
+		if length > capacity {
+			return false
 		}
 	}
-	return 0, false
+	return true
+}
+
+// Validate checks that qr can be encoded as-is.
+// It reports an invalid version, level or segment, and data that don't fit in the version.
+func (qr *QRCode) Validate() error {
+	if !qr.Version.IsValid() {
+		return fmt.Errorf("rmqr: invalid version: %d", qr.Version)
+	}
+	if !qr.Level.IsValid() {
+		return fmt.Errorf("rmqr: invalid level: %d", qr.Level)
+	}
+	for i := range qr.Segments {
+		if err := qr.Segments[i].validate(); err != nil {
+			return err
+		}
+	}
+	if !fits(qr.Level, qr.Version, qr.Segments) {
+		return newDataTooLargeError(qr.Level, qr.Version, qr.Segments)
+	}
+	return nil
 }
 
 type EncodeOptions interface {
@@ -421,13 +513,26 @@ type EncodeOptions interface {
 }
 
 type encodeOptions struct {
-	QuiteZone  int
-	ModuleSize float64
-	Level      Level
-	Kanji      bool
-	Priority   Priority
+	QuiteZone    int
+	ModuleSize   float64
+	Level        Level
+	Kanji        bool
+	ShiftJIS     bool
+	Priority     Priority
+	Version      Version
+	MaxWidth     int
+	MaxHeight    int
+	Foreground   color.Color
+	Background   color.Color
+	Scalable     bool
+	Width        int
+	Height       int
+	IntegerScale bool
 }
 
+// versionAuto means choosing the version automatically.
+const versionAuto Version = -1
+
 func newEncodeOptions(opts ...EncodeOptions) encodeOptions {
 	myopts := encodeOptions{
 		QuiteZone:  2,
@@ -435,6 +540,9 @@ func newEncodeOptions(opts ...EncodeOptions) encodeOptions {
 		Level:      LevelM,
 		Kanji:      true,
 		Priority:   PriorityArea,
+		Version:    versionAuto,
+		Foreground: color.Black,
+		Background: color.White,
 	}
 	for _, o := range opts {
 		o.apply(&myopts)
@@ -500,6 +608,138 @@ func WithPriority(priority Priority) EncodeOptions {
 	return withPriority(priority)
 }
 
+type withVersion Version
+
+func (opt withVersion) apply(opts *encodeOptions) {
+	opts.Version = Version(opt)
+}
+
+// WithVersion fixes the version of rMQR code.
+// New returns an error if the data does not fit in the version.
+func WithVersion(version Version) EncodeOptions {
+	return withVersion(version)
+}
+
+type withMaxWidth int
+
+func (opt withMaxWidth) apply(opts *encodeOptions) {
+	opts.MaxWidth = int(opt)
+}
+
+// WithMaxWidth limits the width of rMQR code to width modules.
+// Zero means no limit.
+func WithMaxWidth(width int) EncodeOptions {
+	return withMaxWidth(width)
+}
+
+type withMaxHeight int
+
+func (opt withMaxHeight) apply(opts *encodeOptions) {
+	opts.MaxHeight = int(opt)
+}
+
+// WithMaxHeight limits the height of rMQR code to height modules.
+// Zero means no limit.
+func WithMaxHeight(height int) EncodeOptions {
+	return withMaxHeight(height)
+}
+
+type withShiftJIS bool
+
+func (opt withShiftJIS) apply(opts *encodeOptions) {
+	opts.ShiftJIS = bool(opt)
+}
+
+// WithShiftJIS makes WithKanji(true) treat the data as Shift_JIS instead of UTF-8.
+// The double-byte codes are packed in the kanji mode, and the other bytes are kept as-is.
+func WithShiftJIS(use bool) EncodeOptions {
+	return withShiftJIS(use)
+}
+
+type withForeground struct{ c color.Color }
+
+func (opt withForeground) apply(opts *encodeOptions) {
+	opts.Foreground = opt.c
+}
+
+// WithForeground sets the color of the dark modules in Encode and EncodeSVG.
+// The default is black.
+func WithForeground(c color.Color) EncodeOptions {
+	return withForeground{c}
+}
+
+type withBackground struct{ c color.Color }
+
+func (opt withBackground) apply(opts *encodeOptions) {
+	opts.Background = opt.c
+}
+
+// WithBackground sets the color of the light modules and the quiet zone in Encode and EncodeSVG.
+// The default is white, and a fully transparent color leaves the background unpainted.
+// Encode and EncodeSVG return ErrLowContrast if the colors are too close for scanners.
+func WithBackground(c color.Color) EncodeOptions {
+	return withBackground{c}
+}
+
+type withScalable bool
+
+func (opt withScalable) apply(opts *encodeOptions) {
+	opts.Scalable = bool(opt)
+}
+
+// WithScalable makes EncodeSVG omit the width and the height of the image.
+// Only the viewBox is set, so the image fits its container.
+func WithScalable(use bool) EncodeOptions {
+	return withScalable(use)
+}
+
+type withImageSize struct{ width, height int }
+
+func (opt withImageSize) apply(opts *encodeOptions) {
+	opts.Width = opt.width
+	opts.Height = opt.height
+}
+
+// WithImageSize makes Encode draw the image in exactly width x height pixels, instead of WithModuleSize.
+// The leftover pixels are spread evenly over the modules, so the sizes of the modules differ by one pixel at most.
+// Encode returns an error if the image is smaller than the number of modules including the quiet zone.
+func WithImageSize(width, height int) EncodeOptions {
+	return withImageSize{width, height}
+}
+
+type withIntegerScale bool
+
+func (opt withIntegerScale) apply(opts *encodeOptions) {
+	opts.IntegerScale = bool(opt)
+}
+
+// WithIntegerScale makes WithImageSize use the same integer size for all modules,
+// and pad the quiet zone with the leftover pixels.
+func WithIntegerScale(use bool) EncodeOptions {
+	return withIntegerScale(use)
+}
+
+// checkContrast checks that scanners can tell the foreground from the background.
+func (opts *encodeOptions) checkContrast() error {
+	if render.Contrast(opts.Foreground, opts.Background) < render.MinContrast {
+		return ErrLowContrast
+	}
+	return nil
+}
+
+func (opts *encodeOptions) renderOptions() *render.Options {
+	return &render.Options{
+		QuietZone:    opts.QuiteZone,
+		ModuleSize:   opts.ModuleSize,
+		Foreground:   opts.Foreground,
+		Background:   opts.Background,
+		Scalable:     opts.Scalable,
+		Width:        opts.Width,
+		Height:       opts.Height,
+		IntegerScale: opts.IntegerScale,
+	}
+}
+
 func Encode(data []byte, opts ...EncodeOptions) (image.Image, error) {
 	qr, err := New(data, opts...)
 	if err != nil {
@@ -517,45 +757,46 @@ func (qr *QRCode) Encode(opts ...EncodeOptions) (image.Image, error) {
 	}
 
 	myopts := newEncodeOptions(opts...)
+	if err := myopts.checkContrast(); err != nil {
+		return nil, err
+	}
 
 	binimg, err := qr.EncodeToBitmap()
 	if err != nil {
 		return nil, err
 	}
 
-	w := binimg.Bounds().Dx() + myopts.QuiteZone*2
-	W := int(math.Ceil(float64(w) * myopts.ModuleSize))
-	h := binimg.Bounds().Dy() + myopts.QuiteZone*2
-	H := int(math.Ceil(float64(h) * myopts.ModuleSize))
-	scale := myopts.ModuleSize
-	dX := float64(myopts.QuiteZone) * scale
-	dY := float64(myopts.QuiteZone) * scale
-
-	// create new paletted image
-	palette := color.Palette{
-		color.White, color.Black,
-	}
-	white := uint8(0)
-	black := uint8(1)
-	bounds := image.Rect(0, 0, W, H)
-	img := image.NewPaletted(bounds, palette)
-
-	// convert bitmap to image
-	for Y := bounds.Min.Y; Y < bounds.Max.Y; Y++ {
-		y := int(math.Floor((float64(Y) - dY) / scale))
-		for X := bounds.Min.X; X < bounds.Max.X; X++ {
-			x := int(math.Floor((float64(X) - dX) / scale))
-			c := binimg.BinaryAt(x, y)
-			if c {
-				img.SetColorIndex(X, Y, black)
-			} else {
-				img.SetColorIndex(X, Y, white)
-			}
-		}
+	img, err := render.Raster(binimg, myopts.renderOptions())
+	if err != nil {
+		return nil, err
 	}
 	return img, nil
 }
 
+// EncodeSVG encodes data into rMQR code, and writes it to w in the SVG format.
+func EncodeSVG(w io.Writer, data []byte, opts ...EncodeOptions) error {
+	qr, err := New(data, opts...)
+	if err != nil {
+		return err
+	}
+	return qr.EncodeSVG(w, opts...)
+}
+
+// EncodeSVG writes qr to w in the SVG format.
+// The dark modules are merged into outlines.
+func (qr *QRCode) EncodeSVG(w io.Writer, opts ...EncodeOptions) error {
+	myopts := newEncodeOptions(opts...)
+	if err := myopts.checkContrast(); err != nil {
+		return err
+	}
+
+	binimg, err := qr.EncodeToBitmap()
+	if err != nil {
+		return err
+	}
+	return render.SVG(w, binimg, myopts.renderOptions())
+}
+
 func (qr *QRCode) EncodeToBitmap() (*bitmap.Image, error) {
 	if !qr.Version.IsValid() {
 		return nil, errors.New("qrcode: invalid version")
@@ -691,7 +932,12 @@ func (qr *QRCode) encodeSegments(buf *bitstream.Buffer) error {
 	}
 	l := buf.Len()
 	if l > capacity.Data*8 {
-		return errors.New("qrcode: data is too large")
+		return &DataTooLargeError{
+			Version:   qr.Version,
+			Level:     qr.Level,
+			Required:  l,
+			Available: capacity.Data * 8,
+		}
 	}
 
 	// terminate pattern
@@ -715,7 +961,37 @@ func (qr *QRCode) encodeSegments(buf *bitstream.Buffer) error {
 	return nil
 }
 
+// validate checks that the data of s can be encoded in the mode.
+func (s *Segment) validate() error {
+	switch s.Mode {
+	case ModeNumeric:
+		for i, ch := range s.Data {
+			if !bitstream.IsNumeric(ch) {
+				return &InvalidCharacterError{Mode: s.Mode, Offset: i}
+			}
+		}
+	case ModeAlphanumeric:
+		for i, ch := range s.Data {
+			if !bitstream.IsAlphanumeric(ch) {
+				return &InvalidCharacterError{Mode: s.Mode, Offset: i}
+			}
+		}
+	case ModeKanji:
+		for i, r := range string(s.Data) {
+			if !bitstream.IsKanji(r) {
+				return &InvalidCharacterError{Mode: s.Mode, Offset: i}
+			}
+		}
+	case ModeBytes:
+	default:
+		return fmt.Errorf("rmqr: unknown mode: %s", s.Mode)
+	}
+	return nil
+}
+
 // length returns the length of s in bits.
+// It returns false if s can't be encoded in the version,
+// but the length is still available if only the character count overflows.
 func (s *Segment) length(version Version, level Level) (int, bool) {
 	if int(version) >= len(capacityTable) {
 		return 0, false
@@ -729,9 +1005,7 @@ func (s *Segment) length(version Version, level Level) (int, bool) {
 	switch s.Mode {
 	case ModeNumeric:
 		n := capacity.BitLength[ModeNumeric]
-		if len(s.Data) >= 1<<n {
-			return 0, false
-		}
+		ok := len(s.Data) < 1<<n
 		m := 10 * (len(s.Data) / 3)
 		switch len(s.Data) % 3 {
 		case 1:
@@ -739,37 +1013,35 @@ func (s *Segment) length(version Version, level Level) (int, bool) {
 		case 2:
 			n += 7
 		}
-		return 3 + n + m, true
+		return 3 + n + m, ok
 	case ModeAlphanumeric:
 		n := capacity.BitLength[ModeAlphanumeric]
-		if len(s.Data) >= 1<<n {
-			return 0, false
-		}
+		ok := len(s.Data) < 1<<n
 		m := 11 * (len(s.Data) / 2)
 		if len(s.Data)%2 != 0 {
 			m += 6
 		}
-		return 3 + n + m, true
+		return 3 + n + m, ok
 	case ModeBytes:
 		n := capacity.BitLength[ModeBytes]
-		if len(s.Data) >= 1<<n {
-			return 0, false
-		}
+		ok := len(s.Data) < 1<<n
 		m := len(s.Data) * 8
-		return 3 + n + m, true
+		return 3 + n + m, ok
 	case ModeKanji:
 		n := capacity.BitLength[ModeKanji]
-		if len(s.Data) >= 1<<n {
-			return 0, false
-		}
-		m := len(s.Data) * 13
-		return 3 + n + m, true
+		count := utf8.RuneCount(s.Data)
+		ok := count < 1<<n
+		m := count * 13
+		return 3 + n + m, ok
 	default:
 		return 0, false
 	}
 }
 
 func (s *Segment) encode(bitLength [5]int, buf *bitstream.Buffer) error {
+	if err := s.validate(); err != nil {
+		return err
+	}
 	switch s.Mode {
 	case ModeNumeric:
 		return s.encodeNumber(bitLength[ModeNumeric], buf)
@@ -830,15 +1102,15 @@ func (s *Segment) encodeBytes(n int, buf *bitstream.Buffer) error {
 }
 
 func (s *Segment) encodeKanji(n int, buf *bitstream.Buffer) error {
-	if len(s.Data) >= 1<<n {
-		return fmt.Errorf("rmqr: data is too long for bytes: %d", len(s.Data))
+	count := utf8.RuneCount(s.Data)
+	if count >= 1<<n {
+		return fmt.Errorf("rmqr: data is too long for kanji mode: %d", count)
 	}
 
 	// mode
 	buf.WriteBitsLSB(uint64(ModeKanji), 3)
 
 	// data length
-	count := utf8.RuneCount(s.Data)
 	buf.WriteBitsLSB(uint64(count), n)
 
 	// data
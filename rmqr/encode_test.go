@@ -2,6 +2,11 @@ package rmqr
 
 import (
 	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"io"
+	"strings"
 	"testing"
 )
 
@@ -68,6 +73,139 @@ func TestNew3(t *testing.T) {
 	}
 }
 
+func TestNew_WithVersion(t *testing.T) {
+	qr, err := New([]byte("123456789012"), WithLevel(LevelM), WithVersion(R11x27))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qr.Version != R11x27 {
+		t.Errorf("unexpected version: got %v, want %v", qr.Version, R11x27)
+	}
+
+	// R7x43-H can hold only 5 digits.
+	_, err = New([]byte("123456789012"), WithLevel(LevelH), WithVersion(R7x43))
+	if err == nil {
+		t.Error("want error, but not")
+	}
+}
+
+func TestNew_WithMaxWidth(t *testing.T) {
+	qr, err := New([]byte("000A0a0000Aa"), WithLevel(LevelM), WithKanji(false), WithMaxWidth(59))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// R7x77 is chosen without the limit.
+	if qr.Version != R9x59 {
+		t.Errorf("unexpected version: got %v, want %v", qr.Version, R9x59)
+	}
+
+	_, err = New([]byte("000A0a0000Aa"), WithLevel(LevelM), WithKanji(false), WithMaxWidth(27), WithMaxHeight(11))
+	if err == nil {
+		t.Error("want error, but not")
+	}
+}
+
+func TestNew_WithMaxHeight(t *testing.T) {
+	qr, err := New([]byte("000A0a0000Aa"), WithLevel(LevelM), WithKanji(false), WithPriority(PriorityWidth), WithMaxHeight(9))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// R11x43 is chosen without the limit.
+	if qr.Version != R9x59 {
+		t.Errorf("unexpected version: got %v, want %v", qr.Version, R9x59)
+	}
+}
+
+func TestNew_WithShiftJIS(t *testing.T) {
+	data := []byte("\x88\x9f\x88\x9f") // "亜亜" in Shift_JIS
+	qr, err := New(data, WithLevel(LevelM), WithKanji(true), WithShiftJIS(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(qr.Segments) != 1 {
+		t.Fatalf("unexpected the length of segment: got %d, want %d", len(qr.Segments), 1)
+	}
+	if qr.Segments[0].Mode != ModeKanji {
+		t.Errorf("got %v, want %v", qr.Segments[0].Mode, ModeKanji)
+	}
+	if !bytes.Equal(qr.Segments[0].Data, []byte("亜亜")) {
+		t.Errorf("got %q, want %q", qr.Segments[0].Data, "亜亜")
+	}
+
+	got, err := qr.ShiftJIS()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("got %x, want %x", got, data)
+	}
+}
+
+func TestNew_KanjiLength(t *testing.T) {
+	// the character count of the kanji mode is the number of characters, not the bytes in UTF-8.
+	seg := Segment{Mode: ModeKanji, Data: []byte("点茗")}
+	n := capacityTable[R7x43][LevelM].BitLength[ModeKanji]
+	if got, ok := seg.length(R7x43, LevelM); !ok || got != 3+n+2*13 {
+		t.Errorf("unexpected length: got %d, want %d", got, 3+n+2*13)
+	}
+
+	qr, err := New([]byte("点茗"), WithLevel(LevelM), WithKanji(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qr.Version != R7x43 {
+		t.Errorf("unexpected version: got %s, want %s", qr.Version, R7x43)
+	}
+}
+
+func TestSegmentConstructors(t *testing.T) {
+	if _, err := NumericSegment([]byte("0123")); err != nil {
+		t.Error(err)
+	}
+	if _, err := NumericSegment([]byte("012A")); err == nil {
+		t.Error("want error, but not")
+	}
+	if _, err := AlphanumericSegment([]byte("AC-42")); err != nil {
+		t.Error(err)
+	}
+	if _, err := AlphanumericSegment([]byte("ac-42")); err == nil {
+		t.Error("want error, but not")
+	}
+	if _, err := KanjiSegment([]byte("点茗")); err != nil {
+		t.Error(err)
+	}
+	if _, err := KanjiSegment([]byte("点A")); err == nil {
+		t.Error("want error, but not")
+	}
+}
+
+func TestQRCode_Validate(t *testing.T) {
+	kanji, err := KanjiSegment([]byte("点"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	qr := &QRCode{
+		Version:  R7x43,
+		Level:    LevelM,
+		Segments: []Segment{kanji},
+	}
+	if err := qr.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := []*QRCode{
+		{Version: -1, Level: LevelM, Segments: []Segment{kanji}},
+		{Version: R7x43, Level: 4, Segments: []Segment{kanji}},
+		{Version: R7x43, Level: LevelM, Segments: []Segment{{Mode: ModeKanji, Data: []byte("A")}}},
+		{Version: R7x43, Level: LevelM, Segments: []Segment{BytesSegment(make([]byte, 10))}},
+	}
+	for i, tt := range tests {
+		if err := tt.Validate(); err == nil {
+			t.Errorf("%d: want error, but not", i)
+		}
+	}
+}
+
 func TestEncodeToBitmap1(t *testing.T) {
 	qr := &QRCode{
 		Version: R15x59,
@@ -502,3 +640,104 @@ func TestEncodeToBitmap13(t *testing.T) {
 		t.Errorf("got %08b, want %08b", got, want)
 	}
 }
+
+func TestEncodeSVG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := EncodeSVG(&buf, []byte("HELLO"), WithModuleSize(2)); err != nil {
+		t.Fatal(err)
+	}
+
+	// R7x43 has 43x7 modules, and the quiet zone is 2 modules.
+	// the outline of the finder pattern comes first.
+	want := `<svg xmlns="http://www.w3.org/2000/svg" version="1.1" viewBox="0 0 47 11" width="94" height="22" shape-rendering="crispEdges">
+<rect width="47" height="11" fill="#ffffff"/>
+<path d="M2 2H9V9H2Z`
+	if !strings.HasPrefix(buf.String(), want) {
+		t.Errorf("unexpected svg: %s", buf.String())
+	}
+}
+
+func TestQRCode_Encode_WithColors(t *testing.T) {
+	qr, err := New([]byte("HELLO"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	darkBlue := color.NRGBA{0x00, 0x20, 0x60, 0xff}
+	img, err := qr.Encode(WithForeground(darkBlue), WithBackground(color.Transparent))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the quiet zone of rMQR codes is 2 modules, and the finder pattern starts at (2, 2) with a light ring inside.
+	if _, _, _, a := img.At(1, 2).RGBA(); a != 0 {
+		t.Errorf("the quiet zone must be transparent: got %v", img.At(1, 2))
+	}
+	if got := color.NRGBAModel.Convert(img.At(2, 2)); got != darkBlue {
+		t.Errorf("unexpected foreground: got %v, want %v", got, darkBlue)
+	}
+	if _, _, _, a := img.At(3, 3).RGBA(); a != 0 {
+		t.Errorf("the light modules must be transparent: got %v", img.At(3, 3))
+	}
+
+	_, err = qr.Encode(WithForeground(color.Gray{0xe0}), WithBackground(color.White))
+	if !errors.Is(err, ErrLowContrast) {
+		t.Errorf("want ErrLowContrast, got %v", err)
+	}
+	err = qr.EncodeSVG(io.Discard, WithForeground(color.Gray{0xe0}), WithBackground(color.White))
+	if !errors.Is(err, ErrLowContrast) {
+		t.Errorf("want ErrLowContrast, got %v", err)
+	}
+}
+
+func TestQRCode_Encode_WithImageSize(t *testing.T) {
+	qr, err := New([]byte("HELLO"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dark := func(img image.Image, x, y int) bool {
+		return color.GrayModel.Convert(img.At(x, y)).(color.Gray).Y < 0x80
+	}
+
+	// R7x43 has 47x11 modules with the quiet zone of 2 modules.
+	// With the integer scale, a module is 6 pixels, and the leftover 18x4 pixels are split into both sides.
+	tests := []struct {
+		integer bool
+		x, y    int
+	}{
+		{false, 13, 13},
+		{true, 21, 14},
+	}
+	for _, tt := range tests {
+		img, err := qr.Encode(WithImageSize(300, 70), WithIntegerScale(tt.integer))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if img.Bounds().Dx() != 300 || img.Bounds().Dy() != 70 {
+			t.Errorf("unexpected size: %v", img.Bounds())
+		}
+		if !dark(img, tt.x, tt.y) || dark(img, tt.x-1, tt.y) || dark(img, tt.x, tt.y-1) {
+			t.Errorf("integer scale %v: the finder pattern doesn't start at (%d, %d)", tt.integer, tt.x, tt.y)
+		}
+
+		got, err := Decode(img)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got.Segments[0].Data, []byte("HELLO")) {
+			t.Errorf("unexpected data: %q", got.Segments[0].Data)
+		}
+	}
+
+	// the image must have a pixel per module at least.
+	for i, opt := range []EncodeOptions{
+		WithImageSize(46, 11),
+		WithImageSize(47, 10),
+	} {
+		if _, err := qr.Encode(opt); err == nil {
+			t.Errorf("%d: want error, but not", i)
+		}
+	}
+	if _, err := qr.Encode(WithImageSize(47, 11)); err != nil {
+		t.Error(err)
+	}
+}
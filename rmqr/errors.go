@@ -0,0 +1,118 @@
+package rmqr
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrDataTooLarge means that the data don't fit in the symbol.
+	// The details are available as *DataTooLargeError.
+	ErrDataTooLarge = errors.New("rmqr: data too large")
+
+	// ErrInvalidCharacter means that the data of a segment include a character that can't be encoded in its mode.
+	// The details are available as *InvalidCharacterError.
+	ErrInvalidCharacter = errors.New("rmqr: invalid character")
+
+	// ErrNotFound means that Decode can't find any rMQR code in the image.
+	ErrNotFound = errors.New("rmqr: rMQR code not found")
+
+	// ErrFormatNotFound means that the format information can't be read from the symbol.
+	ErrFormatNotFound = errors.New("rmqr: format information not found")
+
+	// ErrTooManyErrors means that a block has more errors than the error correction can correct.
+	// The details are available as *TooManyErrorsError.
+	ErrTooManyErrors = errors.New("rmqr: too many errors")
+
+	// ErrInvalidMode means that the symbol includes an unknown mode indicator.
+	// The details are available as *InvalidModeError.
+	ErrInvalidMode = errors.New("rmqr: invalid mode")
+
+	// ErrLowContrast means that the foreground and background colors are too close for scanners.
+	ErrLowContrast = errors.New("rmqr: low contrast between the foreground and the background")
+)
+
+// DataTooLargeError is the error for data that don't fit in the symbol.
+type DataTooLargeError struct {
+	// Version and Level are of the largest symbol that was tried.
+	Version Version
+	Level   Level
+
+	// Required is the length of the data in bits.
+	Required int
+
+	// Available is the capacity of the symbol in bits.
+	Available int
+}
+
+func (e *DataTooLargeError) Error() string {
+	return fmt.Sprintf("rmqr: data too large for version %s-%s: %d bits required, %d bits available", e.Version, e.Level, e.Required, e.Available)
+}
+
+// Is reports whether target is ErrDataTooLarge.
+func (e *DataTooLargeError) Is(target error) bool {
+	return target == ErrDataTooLarge
+}
+
+// newDataTooLargeError returns the error for the segments that don't fit in the version.
+func newDataTooLargeError(level Level, version Version, segments []Segment) *DataTooLargeError {
+	var required int
+	for i := range segments {
+		// the length is available even if the character count overflows.
+		l, _ := segments[i].length(version, level)
+		required += l
+	}
+	return &DataTooLargeError{
+		Version:   version,
+		Level:     level,
+		Required:  required,
+		Available: capacityTable[version][level].Data * 8,
+	}
+}
+
+// InvalidCharacterError is the error for a character that can't be encoded in the mode.
+type InvalidCharacterError struct {
+	Mode Mode
+
+	// Offset is the position of the character in the Data of the segment, in bytes.
+	Offset int
+}
+
+func (e *InvalidCharacterError) Error() string {
+	return fmt.Sprintf("rmqr: invalid character in %s mode at offset %d", e.Mode, e.Offset)
+}
+
+// Is reports whether target is ErrInvalidCharacter.
+func (e *InvalidCharacterError) Is(target error) bool {
+	return target == ErrInvalidCharacter
+}
+
+// TooManyErrorsError is the error for a block that can't be corrected.
+type TooManyErrorsError struct {
+	// Block is the index of the block.
+	Block int
+}
+
+func (e *TooManyErrorsError) Error() string {
+	return fmt.Sprintf("rmqr: too many errors in block %d", e.Block)
+}
+
+// Is reports whether target is ErrTooManyErrors.
+func (e *TooManyErrorsError) Is(target error) bool {
+	return target == ErrTooManyErrors
+}
+
+// InvalidModeError is the error for an unknown mode indicator in the symbol.
+type InvalidModeError struct {
+	// Mode is the value of the mode indicator.
+	Mode Mode
+}
+
+func (e *InvalidModeError) Error() string {
+	return fmt.Sprintf("rmqr: invalid mode indicator %d", int(e.Mode))
+}
+
+// Is reports whether target is ErrInvalidMode.
+func (e *InvalidModeError) Is(target error) bool {
+	return target == ErrInvalidMode
+}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+
+	"github.com/shogo82148/qrcode/internal/bitstream"
 )
 
 type QRCode struct {
@@ -146,6 +148,55 @@ type Segment struct {
 	Data []byte
 }
 
+// NumericSegment returns a ModeNumeric segment.
+// It returns an error if data includes characters other than [0-9].
+func NumericSegment(data []byte) (Segment, error) {
+	return newSegment(Segment{Mode: ModeNumeric, Data: data})
+}
+
+// AlphanumericSegment returns a ModeAlphanumeric segment.
+// It returns an error if data includes characters other than [0-9A-Z $%*+\-./:].
+func AlphanumericSegment(data []byte) (Segment, error) {
+	return newSegment(Segment{Mode: ModeAlphanumeric, Data: data})
+}
+
+// BytesSegment returns a ModeBytes segment.
+func BytesSegment(data []byte) Segment {
+	return Segment{Mode: ModeBytes, Data: data}
+}
+
+// KanjiSegment returns a ModeKanji segment.
+// It returns an error if data includes characters that are not in JIS X 0208.
+func KanjiSegment(data []byte) (Segment, error) {
+	return newSegment(Segment{Mode: ModeKanji, Data: data})
+}
+
+func newSegment(s Segment) (Segment, error) {
+	if err := s.validate(); err != nil {
+		return Segment{}, err
+	}
+	return s, nil
+}
+
+// ShiftJIS returns the data of qr in Shift_JIS.
+// ModeKanji segments are converted into Shift_JIS, and the others are returned as-is.
+func (qr *QRCode) ShiftJIS() ([]byte, error) {
+	var buf []byte
+	for _, s := range qr.Segments {
+		switch s.Mode {
+		case ModeNumeric, ModeAlphanumeric, ModeBytes:
+			buf = append(buf, s.Data...)
+		case ModeKanji:
+			sjis, err := bitstream.KanjiToShiftJIS(s.Data)
+			if err != nil {
+				return nil, err
+			}
+			buf = append(buf, sjis...)
+		}
+	}
+	return buf, nil
+}
+
 func round(x float64) int {
 	return int(math.Round(x))
 }